@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	instances "github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/types"
+	"github.com/backtesting-org/kronos-cli/internal/services/remote"
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+)
+
+type AlertsCommandResult struct {
+	fx.Out
+	AlertsCommand *cobra.Command `name:"alerts"`
+}
+
+// NewAlertsCommand creates the alerts command for notifying when running strategies need attention
+func NewAlertsCommand(handler instances.AlertsHandler) AlertsCommandResult {
+	cmd := &cobra.Command{
+		Use:   "alerts",
+		Short: "Get notified when running strategies need attention",
+		Long: `Alert rules are read from ~/.kronos/alerts.yml:
+
+  interval: 30s        # how often rules are evaluated
+  repeat_after: 1h     # re-send alerts still firing after this long
+  send_resolved: true  # also notify when an alert stops firing
+
+  rules:
+    - name: drawdown
+      kind: drawdown         # drawdown, no_trades, health, latency, crashed or position
+      max_drawdown: 500
+      severity: critical
+    - name: quiet
+      kind: no_trades
+      quiet_for: 30m
+      strategies: [momentum]
+      sinks: [ops]
+
+  sinks:
+    - name: ops
+      type: webhook          # webhook, smtp, desktop or command
+      url: https://hooks.example.com/kronos
+    - name: me
+      type: command
+      command: notify-me "$KRONOS_ALERT_TITLE"
+
+  silences:
+    - rules: [quiet]
+      schedule:              # outside market hours
+        timezone: America/New_York
+        rules:
+          - start: "0 16 * * mon-fri"
+            stop: "30 9 * * mon-fri"
+
+Drawdowns are measured from the highest PnL seen since the watcher started.`,
+	}
+	cmd.PersistentFlags().StringP("file", "f", "", "Alerts file (default ~/.kronos/alerts.yml)")
+
+	watchCmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Evaluate the rules until interrupted and send notifications",
+		Args:  cobra.NoArgs,
+		RunE:  handler.Watch,
+		// Crashes are read from this machine's audit log
+		Annotations: map[string]string{remote.Annotation: remote.Local},
+	}
+
+	checkCmd := &cobra.Command{
+		Use:         "check",
+		Short:       "Print the alerts firing now without sending them; fails if any fire",
+		Args:        cobra.NoArgs,
+		RunE:        handler.Check,
+		Annotations: map[string]string{remote.Annotation: remote.Local},
+	}
+
+	testCmd := &cobra.Command{
+		Use:         "test [sink...]",
+		Short:       "Send a test alert through the named sinks, or every sink",
+		RunE:        handler.Test,
+		Annotations: map[string]string{remote.Annotation: remote.Local},
+	}
+
+	cmd.AddCommand(watchCmd)
+	cmd.AddCommand(checkCmd)
+	cmd.AddCommand(testCmd)
+
+	return AlertsCommandResult{
+		AlertsCommand: cmd,
+	}
+}
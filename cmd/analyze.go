@@ -2,6 +2,7 @@ package cmd
 
 import (
 	backtesting "github.com/backtesting-org/kronos-cli/internal/handlers/strategies/backtest/types"
+	"github.com/backtesting-org/kronos-cli/internal/services/remote"
 	"github.com/spf13/cobra"
 	"go.uber.org/fx"
 )
@@ -15,11 +16,16 @@ type AnalyzeCommandResult struct {
 func NewAnalyzeCommand(handler backtesting.AnalyzeHandler) AnalyzeCommandResult {
 	cmd := &cobra.Command{
 		Use:   "analyze",
-		Short: "Analyze backtest results",
+		Short: "Analyze backtest results or the recorded history of a live strategy",
 		RunE:  handler.Handle,
+		// Results are read from this machine whatever node commands point at
+		Annotations: map[string]string{remote.Annotation: remote.Local},
 	}
 
 	cmd.Flags().String("path", "./results", "Path to results directory")
+	cmd.Flags().String("live", "", "Analyze the metrics history recorded by this live strategy instead")
+	cmd.Flags().Duration("since", 0, "Only analyze live history from this far back (default everything recorded)")
+	cmd.Flags().StringP("output", "o", "table", "Output format for live analysis: table, json or csv")
 
 	return AnalyzeCommandResult{
 		AnalyzeCommand: cmd,
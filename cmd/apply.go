@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	instances "github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/types"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/apply"
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+)
+
+type ApplyCommandResult struct {
+	fx.Out
+	ApplyCommand *cobra.Command `name:"apply"`
+}
+
+// NewApplyCommand creates the apply command for converging running instances on a declared set
+func NewApplyCommand(handler instances.ApplyHandler) ApplyCommandResult {
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Start, stop and restart instances to match a desired state file",
+		Long: `Apply declares which strategy instances should be running, with which parameters:
+
+  instances:
+    - strategy: momentum
+      parameters:
+        lookback: 20
+    - name: momentum-fast
+      strategy: momentum
+      parameters:
+        lookback: 5
+
+Running instances not in the file are stopped, declared ones that aren't running are started,
+and running ones whose parameters or config.yml changed are restarted. Parameters are written
+into the strategy's config.yml. An instance named differently from its strategy is a variant:
+strategies/<name>/ is created with links to the strategy's code and its own config.yml.
+
+Use --dry-run to print the plan without changing anything.`,
+		Args: cobra.NoArgs,
+		RunE: handler.Handle,
+		// Failed steps are reported in the plan table
+		SilenceUsage: true,
+	}
+	cmd.Flags().StringP("file", "f", apply.DefaultFile, "Desired state file")
+	cmd.Flags().Bool("dry-run", false, "Print the plan without applying it")
+	cmd.Flags().String("mode", "", "What stopped instances do with open orders and positions: leave, cancel-orders or flatten (default from config)")
+	cmd.Flags().Duration("drain-timeout", 0, "How long to wait for the exchange to confirm each drain (default from config)")
+
+	return ApplyCommandResult{
+		ApplyCommand: cmd,
+	}
+}
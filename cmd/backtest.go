@@ -2,6 +2,7 @@ package cmd
 
 import (
 	backtesting "github.com/backtesting-org/kronos-cli/internal/handlers/strategies/backtest/types"
+	"github.com/backtesting-org/kronos-cli/internal/services/remote"
 	"github.com/spf13/cobra"
 	"go.uber.org/fx"
 )
@@ -17,6 +18,8 @@ func NewBacktestCommand(handler backtesting.BacktestHandler) BacktestCommandResu
 		Use:   "backtest",
 		Short: "Run backtests",
 		RunE:  handler.Handle,
+		// Backtests run on this machine whatever node commands point at
+		Annotations: map[string]string{remote.Annotation: remote.Local},
 	}
 
 	cmd.Flags().String("config", "", "Path to backtest config file (for CLI mode)")
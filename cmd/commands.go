@@ -9,9 +9,20 @@ import (
 type Commands struct {
 	Init *cobra.Command
 	//Live     *cobra.Command
-	Backtest *cobra.Command
-	Analyze  *cobra.Command
-	Version  *cobra.Command
+	Backtest  *cobra.Command
+	Analyze   *cobra.Command
+	Version   *cobra.Command
+	Instances *cobra.Command
+	Events    *cobra.Command
+	Preflight *cobra.Command
+	Panic     *cobra.Command
+	Deploy    *cobra.Command
+	Apply     *cobra.Command
+	Serve     *cobra.Command
+	Context   *cobra.Command
+	Monitor   *cobra.Command
+	Exporter  *cobra.Command
+	Alerts    *cobra.Command
 }
 
 // CommandParams uses fx.In to inject named commands
@@ -19,9 +30,20 @@ type CommandParams struct {
 	fx.In
 	Init *cobra.Command `name:"init"`
 	//Live     *cobra.Command `name:"live"`
-	Backtest *cobra.Command `name:"backtest"`
-	Analyze  *cobra.Command `name:"analyze"`
-	Version  *cobra.Command `name:"version"`
+	Backtest  *cobra.Command `name:"backtest"`
+	Analyze   *cobra.Command `name:"analyze"`
+	Version   *cobra.Command `name:"version"`
+	Instances *cobra.Command `name:"instances"`
+	Events    *cobra.Command `name:"events"`
+	Preflight *cobra.Command `name:"preflight"`
+	Panic     *cobra.Command `name:"panic"`
+	Deploy    *cobra.Command `name:"deploy"`
+	Apply     *cobra.Command `name:"apply"`
+	Serve     *cobra.Command `name:"serve"`
+	Context   *cobra.Command `name:"context"`
+	Monitor   *cobra.Command `name:"monitor"`
+	Exporter  *cobra.Command `name:"exporter"`
+	Alerts    *cobra.Command `name:"alerts"`
 }
 
 // NewCommands assembles all commands (created by individual providers)
@@ -29,8 +51,19 @@ func NewCommands(params CommandParams) *Commands {
 	return &Commands{
 		Init: params.Init,
 		//Live:     params.Live,
-		Backtest: params.Backtest,
-		Analyze:  params.Analyze,
-		Version:  params.Version,
+		Backtest:  params.Backtest,
+		Analyze:   params.Analyze,
+		Version:   params.Version,
+		Instances: params.Instances,
+		Events:    params.Events,
+		Preflight: params.Preflight,
+		Panic:     params.Panic,
+		Deploy:    params.Deploy,
+		Apply:     params.Apply,
+		Serve:     params.Serve,
+		Context:   params.Context,
+		Monitor:   params.Monitor,
+		Exporter:  params.Exporter,
+		Alerts:    params.Alerts,
 	}
 }
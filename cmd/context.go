@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	instances "github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/types"
+	"github.com/backtesting-org/kronos-cli/internal/services/remote"
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+)
+
+type ContextCommandResult struct {
+	fx.Out
+	ContextCommand *cobra.Command `name:"context"`
+}
+
+// NewContextCommand creates the context command for naming remote kronos nodes and switching between them
+func NewContextCommand(handler instances.ContextHandler) ContextCommandResult {
+	// Contexts are this machine's own settings, whatever node commands point at
+	local := map[string]string{remote.Annotation: remote.Local}
+
+	cmd := &cobra.Command{
+		Use:   "context",
+		Short: "Name remote kronos nodes and choose which one commands act on",
+		Long: `Contexts are kronos nodes running 'kronos serve', kept in ~/.kronos/contexts.yml.
+Commands that support it act on the current context; --context <name> or --host <name|host:port>
+override it for one command. The context named local is always this machine.`,
+		Annotations: local,
+	}
+
+	listCmd := &cobra.Command{
+		Use:         "list",
+		Short:       "List contexts, marking the current one",
+		Args:        cobra.NoArgs,
+		RunE:        handler.List,
+		Annotations: local,
+	}
+
+	useCmd := &cobra.Command{
+		Use:         "use <name>",
+		Short:       "Make a context the default for commands ('local' for this machine)",
+		Args:        cobra.ExactArgs(1),
+		RunE:        handler.Use,
+		Annotations: local,
+	}
+
+	addCmd := &cobra.Command{
+		Use:         "add <name>",
+		Short:       "Add a node, checking that it is reachable and accepts the token",
+		Args:        cobra.ExactArgs(1),
+		RunE:        handler.Add,
+		Annotations: local,
+	}
+	addCmd.Flags().String("host", "", "Address of the node, host:port")
+	addCmd.Flags().String("token", "", "Token printed by 'kronos serve' on the node")
+	addCmd.Flags().String("token-file", "", "File holding the token, instead of --token")
+	addCmd.Flags().String("fingerprint", "", "Certificate fingerprint printed by 'kronos serve', to pin a self-signed certificate")
+	addCmd.Flags().String("ca-file", "", "CA certificate the node's certificate is signed by")
+	_ = addCmd.MarkFlagRequired("host")
+	addCmd.MarkFlagsMutuallyExclusive("token", "token-file")
+	addCmd.MarkFlagsOneRequired("token", "token-file")
+	addCmd.MarkFlagsMutuallyExclusive("fingerprint", "ca-file")
+
+	removeCmd := &cobra.Command{
+		Use:         "remove <name>",
+		Short:       "Remove a context",
+		Args:        cobra.ExactArgs(1),
+		RunE:        handler.Remove,
+		Annotations: local,
+	}
+
+	cmd.AddCommand(listCmd)
+	cmd.AddCommand(useCmd)
+	cmd.AddCommand(addCmd)
+	cmd.AddCommand(removeCmd)
+
+	return ContextCommandResult{
+		ContextCommand: cmd,
+	}
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	instances "github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/types"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/deploy"
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+)
+
+type DeployCommandResult struct {
+	fx.Out
+	DeployCommand *cobra.Command `name:"deploy"`
+}
+
+// NewDeployCommand creates the deploy command for starting and stopping groups of strategies together
+func NewDeployCommand(handler instances.DeployHandler) DeployCommandResult {
+	cmd := &cobra.Command{
+		Use:   "deploy",
+		Short: "Start and stop groups of strategies together",
+		Long: `Deployments are groups of strategies described in deployments.yml in the project root:
+
+  groups:
+    market-making:
+      ready_timeout: 1m
+      strategies:
+        - strategy: hedger
+        - strategy: maker
+          after: [hedger]
+
+A strategy listed after others is started once they are running and healthy, and stopped
+before them.`,
+	}
+	cmd.PersistentFlags().StringP("file", "f", deploy.DefaultFile, "Deployments file")
+
+	upCmd := &cobra.Command{
+		Use:   "up <deployment>",
+		Short: "Start the strategies of a deployment that aren't running, in order",
+		Args:  cobra.ExactArgs(1),
+		RunE:  handler.Up,
+	}
+
+	downCmd := &cobra.Command{
+		Use:   "down <deployment>",
+		Short: "Stop the running strategies of a deployment, in reverse order",
+		Args:  cobra.ExactArgs(1),
+		RunE:  handler.Down,
+	}
+	downCmd.Flags().String("mode", "", "What to do with open orders and positions: leave, cancel-orders or flatten (default from config)")
+	downCmd.Flags().Duration("drain-timeout", 0, "How long to wait for the exchange to confirm each drain (default from config)")
+
+	statusCmd := &cobra.Command{
+		Use:   "status [deployment]",
+		Short: "Show the state of each strategy of a deployment, or of every deployment",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  handler.Status,
+	}
+
+	cmd.AddCommand(upCmd)
+	cmd.AddCommand(downCmd)
+	cmd.AddCommand(statusCmd)
+
+	return DeployCommandResult{
+		DeployCommand: cmd,
+	}
+}
@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	instances "github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/types"
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+)
+
+type EventsCommandResult struct {
+	fx.Out
+	EventsCommand *cobra.Command `name:"events"`
+}
+
+// NewEventsCommand creates the events command for reading the lifecycle audit log
+func NewEventsCommand(handler instances.EventsHandler) EventsCommandResult {
+	cmd := &cobra.Command{
+		Use:   "events",
+		Short: "Show the lifecycle audit log of live strategy instances",
+		Long: `Every start, stop, kill, crash, restart, promotion and config change of a live strategy is
+recorded with its time, the actor that caused it (tui, cli, daemon or auto-restart), the instance
+and the reason. Events from every kronos process end up in the same log.
+
+Use --follow to keep printing new events as they are recorded.`,
+		Args: cobra.NoArgs,
+		RunE: handler.Handle,
+	}
+	cmd.Flags().BoolP("follow", "f", false, "Keep printing new events until interrupted")
+	cmd.Flags().String("strategy", "", "Only show events of this strategy")
+	cmd.Flags().Duration("since", 0, "Only show events recorded within this long ago, e.g. 1h")
+	cmd.Flags().Int("limit", 50, "Number of past events to show (0 for all)")
+
+	return EventsCommandResult{
+		EventsCommand: cmd,
+	}
+}
@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	instances "github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/types"
+	"github.com/backtesting-org/kronos-cli/internal/services/monitoring/exporter"
+	"github.com/backtesting-org/kronos-cli/internal/services/remote"
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+)
+
+type ExporterCommandResult struct {
+	fx.Out
+	ExporterCommand *cobra.Command `name:"exporter"`
+}
+
+// NewExporterCommand creates the exporter command serving the metrics of running instances to Prometheus
+func NewExporterCommand(handler instances.ExporterHandler) ExporterCommandResult {
+	cmd := &cobra.Command{
+		Use:   "exporter",
+		Short: "Serve the metrics of running instances for Prometheus to scrape",
+		Long: `Serve the PnL, signal metrics, execution timing and health of every running instance on
+/metrics in the Prometheus text format, or OpenMetrics for scrapers that ask for it.
+
+Instances are discovered on every scrape, so strategies started later are picked up without a
+restart. Samples are labeled by instance and strategy, and by exchange and asset where they apply:
+
+  scrape_configs:
+    - job_name: kronos
+      static_configs:
+        - targets: ['localhost:9464']
+
+The exporter only reads from instances; it has no endpoints that change them.`,
+		Args: cobra.NoArgs,
+		RunE: handler.Handle,
+		// Instances are scraped on the machine the exporter runs on
+		Annotations: map[string]string{remote.Annotation: remote.Local},
+	}
+	cmd.Flags().String("listen", exporter.DefaultListen, "Address to serve metrics on")
+
+	return ExporterCommandResult{
+		ExporterCommand: cmd,
+	}
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"github.com/backtesting-org/kronos-cli/internal/services/remote"
 	setup "github.com/backtesting-org/kronos-cli/internal/setup/types"
 	"github.com/spf13/cobra"
 	"go.uber.org/fx"
@@ -18,6 +19,8 @@ func NewInitCommand(handler setup.InitHandler) InitCommandResult {
 			Use:   "init <name>",
 			Short: "Create a new Kronos project",
 			RunE:  handler.Handle,
+			// Projects are created on this machine whatever node commands point at
+			Annotations: map[string]string{remote.Annotation: remote.Local},
 		},
 	}
 }
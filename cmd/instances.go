@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	instances "github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/types"
+	"github.com/backtesting-org/kronos-cli/internal/services/remote"
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+)
+
+type InstancesCommandResult struct {
+	fx.Out
+	InstancesCommand *cobra.Command `name:"instances"`
+}
+
+// NewInstancesCommand creates the instances command for managing live strategy instances
+func NewInstancesCommand(stopHandler instances.StopHandler, reloadHandler instances.ReloadHandler,
+	historyHandler instances.HistoryHandler, scheduleHandler instances.ScheduleHandler,
+	listHandler instances.ListHandler,
+) InstancesCommandResult {
+	cmd := &cobra.Command{
+		Use:   "instances",
+		Short: "Manage live strategy instances",
+	}
+
+	stopCmd := &cobra.Command{
+		Use:   "stop <strategy>",
+		Short: "Gracefully stop a running strategy and show its final state",
+		Long: `Stop a running strategy. The strategy stops generating signals, then drains according to
+the stop mode before exiting:
+
+  leave          leave open orders and positions as they are
+  cancel-orders  cancel open orders, keep positions
+  flatten        cancel open orders and close positions at market
+
+Defaults come from the stop block in ~/.kronos/config.yml.`,
+		Args:        cobra.ExactArgs(1),
+		RunE:        stopHandler.Handle,
+		Annotations: map[string]string{remote.Annotation: remote.Capable},
+	}
+	stopCmd.Flags().String("mode", "", "What to do with open orders and positions: leave, cancel-orders or flatten (default from config)")
+	stopCmd.Flags().Duration("drain-timeout", 0, "How long to wait for the exchange to confirm the drain (default from config)")
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List running strategy instances and whether they answer health checks",
+		Long: `List the instances the supervisor tracks and any strategy with a monitoring socket.
+With --host or --context, the instances of that node are listed.`,
+		Args:        cobra.NoArgs,
+		RunE:        listHandler.Handle,
+		Annotations: map[string]string{remote.Annotation: remote.Capable},
+	}
+	listCmd.Flags().Bool("all", false, "Include stopped and crashed instances")
+
+	reloadCmd := &cobra.Command{
+		Use:   "reload <strategy>",
+		Short: "Hot-swap a running strategy with a freshly compiled build",
+		Long: `Recompile a running strategy and swap it in without leaving its positions unmanaged.
+
+The new build starts on standby next to the running instance, connects and warms up with its
+signals held back. The running instance then stops trading and exits, leaving its orders and
+positions in place, and the new instance takes them over. If the new build fails to load, start or pass health checks, the
+previous build is restored and restarted.`,
+		Args: cobra.ExactArgs(1),
+		RunE: reloadHandler.Handle,
+	}
+	reloadCmd.Flags().Duration("ready-timeout", 0, "How long the new build may take to load and connect on standby (default from config)")
+	reloadCmd.Flags().Duration("health-timeout", 0, "How long the new build must stay healthy before the swap is final (default from config)")
+
+	historyCmd := &cobra.Command{
+		Use:   "history [strategy]",
+		Short: "Show archived sessions of live strategy instances",
+		Long: `Every session is archived when its instance stops or crashes, with its start and stop times,
+exit reason, restarts, final PnL, fees and trade count and the hash of the build it ran.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: historyHandler.Handle,
+	}
+	historyCmd.Flags().Int("limit", 20, "Number of sessions to show, newest first (0 for all)")
+	historyCmd.Flags().Duration("since", 0, "Only show sessions that ended within this long ago, e.g. 24h")
+	historyCmd.Flags().String("session", "", "Show the details of a single session by ID")
+
+	scheduleCmd := &cobra.Command{
+		Use:   "schedule",
+		Short: "Show strategy trading windows and their next transitions",
+		Long: `Strategies with a schedule block in their config.yml are started when a trading window opens and
+gracefully stopped when it closes, while the TUI or this command with --run is open. Only one kronos
+process applies schedules at a time.`,
+		Args: cobra.NoArgs,
+		RunE: scheduleHandler.Handle,
+	}
+	scheduleCmd.Flags().Bool("run", false, "Keep applying schedules in the foreground until interrupted")
+
+	cmd.AddCommand(listCmd)
+	cmd.AddCommand(stopCmd)
+	cmd.AddCommand(reloadCmd)
+	cmd.AddCommand(historyCmd)
+	cmd.AddCommand(scheduleCmd)
+
+	return InstancesCommandResult{
+		InstancesCommand: cmd,
+	}
+}
@@ -13,6 +13,17 @@ var Module = fx.Module("commands",
 		NewBacktestCommand,
 		NewAnalyzeCommand,
 		NewVersionCommand,
+		NewInstancesCommand,
+		NewEventsCommand,
+		NewPreflightCommand,
+		NewPanicCommand,
+		NewDeployCommand,
+		NewApplyCommand,
+		NewServeCommand,
+		NewContextCommand,
+		NewMonitorCommand,
+		NewExporterCommand,
+		NewAlertsCommand,
 		NewRunStrategyCommand,
 		NewCommands,
 	),
@@ -34,5 +45,16 @@ func registerCommands(p registerCommandsParams) {
 	p.Root.Cmd.AddCommand(p.Cmds.Backtest)
 	p.Root.Cmd.AddCommand(p.Cmds.Analyze)
 	p.Root.Cmd.AddCommand(p.Cmds.Version)
+	p.Root.Cmd.AddCommand(p.Cmds.Instances)
+	p.Root.Cmd.AddCommand(p.Cmds.Events)
+	p.Root.Cmd.AddCommand(p.Cmds.Preflight)
+	p.Root.Cmd.AddCommand(p.Cmds.Panic)
+	p.Root.Cmd.AddCommand(p.Cmds.Deploy)
+	p.Root.Cmd.AddCommand(p.Cmds.Apply)
+	p.Root.Cmd.AddCommand(p.Cmds.Serve)
+	p.Root.Cmd.AddCommand(p.Cmds.Context)
+	p.Root.Cmd.AddCommand(p.Cmds.Monitor)
+	p.Root.Cmd.AddCommand(p.Cmds.Exporter)
+	p.Root.Cmd.AddCommand(p.Cmds.Alerts)
 	p.Root.Cmd.AddCommand(p.RunStrategy.Cmd)
 }
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	instances "github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/types"
+	"github.com/backtesting-org/kronos-cli/internal/services/monitoring/report"
+	"github.com/backtesting-org/kronos-cli/internal/services/remote"
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+)
+
+type MonitorCommandResult struct {
+	fx.Out
+	MonitorCommand *cobra.Command `name:"monitor"`
+}
+
+// NewMonitorCommand creates the monitor command for printing the views of a running instance without the TUI
+func NewMonitorCommand(handler instances.MonitorHandler) MonitorCommandResult {
+	cmd := &cobra.Command{
+		Use:   "monitor",
+		Short: "Print the views of a running strategy instance for scripts and cron jobs",
+		Long: `Print the same views the monitor shows, without the TUI:
+
+  kronos monitor pnl momentum -o json | jq .total_pnl
+  kronos monitor health momentum || alert "momentum is down"
+  kronos monitor trades momentum -o csv --watch 10s >> trades.csv
+
+With --watch the view is printed again every interval until interrupted. JSON is then written
+one object per line with the time it was taken, and CSV gets a TIME column and a single header.
+Without --watch, health exits with an error if the instance is unhealthy.`,
+	}
+	cmd.PersistentFlags().StringP("output", "o", string(report.FormatTable), "Output format: table, json or csv")
+	cmd.PersistentFlags().Duration("watch", 0, "Print the view again every interval, e.g. 5s, until interrupted")
+
+	view := func(use, short string, run func(*cobra.Command, []string) error) *cobra.Command {
+		sub := &cobra.Command{
+			Use:         use + " <instance>",
+			Short:       short,
+			Args:        cobra.ExactArgs(1),
+			RunE:        run,
+			Annotations: map[string]string{remote.Annotation: remote.Capable},
+		}
+		cmd.AddCommand(sub)
+		return sub
+	}
+
+	view("pnl", "Print realized, unrealized and total PnL", handler.PnL)
+	view("positions", "Print open orders", handler.Positions)
+	tradesCmd := view("trades", "Print recent trades", handler.Trades)
+	tradesCmd.Flags().Int("limit", 20, "Number of recent trades to print")
+	orderbookCmd := view("orderbook", "Print the orderbook of an asset", handler.Orderbook)
+	orderbookCmd.Flags().String("asset", "", "Asset to print (default is the first the strategy trades)")
+	orderbookCmd.Flags().String("exchange", "", "Exchange of the asset (default is the asset's exchange)")
+	orderbookCmd.Flags().Int("depth", 10, "Levels to print on each side (0 for all)")
+	view("metrics", "Print signal counts, latency and PnL metrics", handler.Metrics)
+	view("health", "Print whether the instance answers its health check", handler.Health)
+	view("profiling", "Print execution timing statistics", handler.Profiling)
+
+	return MonitorCommandResult{
+		MonitorCommand: cmd,
+	}
+}
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	instances "github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/types"
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+)
+
+type PanicCommandResult struct {
+	fx.Out
+	PanicCommand *cobra.Command `name:"panic"`
+}
+
+// NewPanicCommand creates the panic command for stopping all live trading at once
+func NewPanicCommand(handler instances.PanicHandler) PanicCommandResult {
+	cmd := &cobra.Command{
+		Use:   "panic",
+		Short: "Emergency stop: stop every live strategy at once",
+		Long: `Stops every running strategy instance in parallel. Each strategy is asked to drain with the
+given mode; one that doesn't stop within the drain timeout (plus a few seconds) is killed. Standby
+instances are killed straight away.
+
+With --mode cancel-orders or flatten, kronos then connects to every enabled exchange in kronos.yml
+itself and cancels open orders, and for flatten closes positions at market, so strategies that were
+killed or unreachable are covered too. Run it from the project root for the exchange sweep.
+
+A summary of every instance and exchange is printed at the end. It exits non-zero if anything
+couldn't be stopped or cleaned up.`,
+		Args: cobra.NoArgs,
+		RunE: handler.Handle,
+		// Problems are listed in the report, not a usage mistake
+		SilenceUsage: true,
+	}
+	cmd.Flags().String("mode", "leave", "What to do with open orders and positions: leave, cancel-orders or flatten")
+	cmd.Flags().Duration("drain-timeout", 0, "How long each strategy and the exchange sweep may take to drain (default from config)")
+	cmd.Flags().BoolP("yes", "y", false, "Don't ask for confirmation")
+
+	return PanicCommandResult{
+		PanicCommand: cmd,
+	}
+}
@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	instances "github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/types"
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+)
+
+type PreflightCommandResult struct {
+	fx.Out
+	PreflightCommand *cobra.Command `name:"preflight"`
+}
+
+// NewPreflightCommand creates the preflight command for checking a strategy before it goes live
+func NewPreflightCommand(handler instances.PreflightHandler) PreflightCommandResult {
+	cmd := &cobra.Command{
+		Use:   "preflight <strategy>",
+		Short: "Check that a strategy is ready to go live",
+		Long: `Runs the checks that gate starting a live strategy and prints them as a checklist:
+
+  - no instance of the strategy is already running
+  - exchange credentials are present and well-formed
+  - the traded assets exist on each exchange
+  - risk limits are set in the strategy's config.yml
+  - the strategy compiles against the SDK version of this kronos
+  - the plugin loads and exports NewStrategy
+  - the local clock is in sync
+
+Run it from the project root. It exits non-zero if any check fails.`,
+		Args: cobra.ExactArgs(1),
+		RunE: handler.Handle,
+		// A failed check is reported by the checklist, not a usage mistake
+		SilenceUsage: true,
+	}
+
+	return PreflightCommandResult{
+		PreflightCommand: cmd,
+	}
+}
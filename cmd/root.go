@@ -1,7 +1,11 @@
 package cmd
 
 import (
+	"fmt"
+
 	core "github.com/backtesting-org/kronos-cli/internal/handlers"
+	"github.com/backtesting-org/kronos-cli/internal/services/remote"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
 	"github.com/spf13/cobra"
 )
 
@@ -11,7 +15,7 @@ type RootCommand struct {
 }
 
 // NewRootCommand creates the root command
-func NewRootCommand(handler core.RootHandler) *RootCommand {
+func NewRootCommand(handler core.RootHandler, events live.EventBus, target *remote.Target) *RootCommand {
 	cmd := &cobra.Command{
 		Use:   "kronos",
 		Short: "Kronos - Trading infrastructure platform",
@@ -33,9 +37,68 @@ Examples:
   kronos live --cli --strategy arbitrage --exchange binance    Run live via CLI
   kronos live                    Run live via TUI`,
 		RunE: handler.Handle,
+		// Lifecycle events are attributed to whoever drives this process, and commands
+		// act on the node chosen with --context, --host or the current context
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			events.SetActor(commandActor(cmd))
+			return selectTarget(cmd, target)
+		},
 	}
 
 	cmd.PersistentFlags().Bool("cli", false, "Use CLI mode instead of interactive TUI")
+	cmd.PersistentFlags().String("context", "", "Act on this context's node instead of the current one ('local' for this machine)")
+	cmd.PersistentFlags().String("host", "", "Act on this node: a context name or host:port (token from $KRONOS_TOKEN)")
 
 	return &RootCommand{Cmd: cmd}
 }
+
+// commandActor tells which actor a command acts as: strategy processes act on their own,
+// the bare command opens the TUI unless --cli is given, everything else is the CLI
+func commandActor(cmd *cobra.Command) live.Actor {
+	if cmd.Name() == "run-strategy" {
+		return live.ActorDaemon
+	}
+	if !cmd.HasParent() {
+		if cli, _ := cmd.Flags().GetBool("cli"); !cli {
+			return live.ActorTUI
+		}
+	}
+	return live.ActorCLI
+}
+
+// selectTarget points the target at the node the command line asks for. Commands that aren't
+// marked as able to act on a remote node refuse to run while one is selected.
+func selectTarget(cmd *cobra.Command, target *remote.Target) error {
+	mode := cmd.Annotations[remote.Annotation]
+	if mode == remote.Local {
+		return nil
+	}
+
+	contextName, _ := cmd.Flags().GetString("context")
+	host, _ := cmd.Flags().GetString("host")
+
+	contexts, err := remote.LoadContexts(remote.DefaultContextsPath())
+	if err != nil {
+		return err
+	}
+	hc, err := contexts.Resolve(contextName, host)
+	if err != nil || hc == nil {
+		return err
+	}
+
+	if mode != remote.Capable {
+		name := hc.Name
+		if name == "" {
+			name = hc.Host
+		}
+		return fmt.Errorf("'%s' only acts on this machine but commands point at %s; pass --context local to run it here",
+			cmd.CommandPath(), name)
+	}
+
+	node, err := remote.Dial(hc)
+	if err != nil {
+		return err
+	}
+	target.Use(node)
+	return nil
+}
@@ -1,24 +1,33 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/signal"
 	"path/filepath"
 	"syscall"
+	"time"
 
+	"github.com/backtesting-org/kronos-cli/internal/services/live/logs"
+	"github.com/backtesting-org/kronos-cli/internal/services/remote"
 	"github.com/backtesting-org/kronos-cli/pkg/live"
 	"github.com/spf13/cobra"
 )
 
+// logRotationInterval is how often the running strategy checks its own log files for rotation
+const logRotationInterval = time.Minute
+
 type RunStrategyCommand struct {
 	Cmd     *cobra.Command
 	runtime live.Runtime
+	config  *live.SupervisorConfig
 }
 
-func NewRunStrategyCommand(rt live.Runtime) *RunStrategyCommand {
+func NewRunStrategyCommand(rt live.Runtime, cfg *live.SupervisorConfig) *RunStrategyCommand {
 	rsc := &RunStrategyCommand{
 		runtime: rt,
+		config:  cfg,
 	}
 
 	rsc.Cmd = &cobra.Command{
@@ -27,25 +36,37 @@ func NewRunStrategyCommand(rt live.Runtime) *RunStrategyCommand {
 		Short:  "Run a live trading strategy instance (internal command)",
 		Long:   `Internal command used to run a strategy instance in a separate process.`,
 		RunE:   rsc.run,
+		// The strategy process runs where it was spawned, never against the user's current context
+		Annotations: map[string]string{remote.Annotation: remote.Local},
 	}
 
 	rsc.Cmd.Flags().String("strategy", "", "Strategy name (required)")
 	_ = rsc.Cmd.MarkFlagRequired("strategy")
+	rsc.Cmd.Flags().String("project-dir", "", "Project root when running from a dedicated work directory")
+	rsc.Cmd.Flags().Bool("standby", false, "Start the strategy with its signals held back and wait to take over from the running instance (used by reload)")
+	rsc.Cmd.Flags().Bool("check-plugin", false, "Only load the strategy plugin and exit (used by preflight)")
 
 	return rsc
 }
 
 func (rsc *RunStrategyCommand) run(cmd *cobra.Command, _ []string) error {
 	strategyName, _ := cmd.Flags().GetString("strategy")
+	projectDir, _ := cmd.Flags().GetString("project-dir")
+	standby, _ := cmd.Flags().GetBool("standby")
 
-	// Build strategy directory path using convention: ./strategies/{strategy-name}
-	strategyDir := filepath.Join("strategies", strategyName)
+	// Build strategy directory path using convention: <project>/strategies/{strategy-name}
+	// projectDir is empty unless the spawner moved us into a dedicated work directory
+	strategyDir := filepath.Join(projectDir, "strategies", strategyName)
 
 	// Check if strategy directory exists
 	if _, err := os.Stat(strategyDir); os.IsNotExist(err) {
 		return fmt.Errorf("strategy directory not found: %s", strategyDir)
 	}
 
+	if check, _ := cmd.Flags().GetBool("check-plugin"); check {
+		return rsc.runtime.CheckPlugin(strategyDir)
+	}
+
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
 
@@ -54,13 +75,27 @@ func (rsc *RunStrategyCommand) run(cmd *cobra.Command, _ []string) error {
 		fmt.Println("\n\n🛑 Received shutdown signal, stopping strategy...")
 	}()
 
+	// Our stdout/stderr are the instance log files opened by the spawner - rotate them in place
+	rotateCtx, stopRotation := context.WithCancel(context.Background())
+	defer stopRotation()
+	go logs.NewRotator(rsc.config.Logs).Run(rotateCtx, logRotationInterval,
+		filepath.Join(projectDir, logs.InstanceLogPath(strategyName, logs.StreamStdout)),
+		filepath.Join(projectDir, logs.InstanceLogPath(strategyName, logs.StreamStderr)),
+	)
+
 	// Start runtime - it will load config.yml from strategy dir and exchanges.yml from project root
 	fmt.Printf("🚀 Starting live trading\n")
 	fmt.Printf("   Strategy: %s\n", strategyName)
 	fmt.Printf("   Path: %s\n", strategyDir)
 	fmt.Println("\nPress Ctrl+C to stop...")
 
-	if err := rsc.runtime.Run(strategyDir); err != nil {
+	run := rsc.runtime.Run
+	if standby {
+		fmt.Println("   Mode: standby (waiting to take over)")
+		run = rsc.runtime.RunStandby
+	}
+
+	if err := run(strategyDir); err != nil {
 		return fmt.Errorf("runtime error: %w", err)
 	}
 
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	instances "github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/types"
+	"github.com/backtesting-org/kronos-cli/internal/services/remote"
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+)
+
+type ServeCommandResult struct {
+	fx.Out
+	ServeCommand *cobra.Command `name:"serve"`
+}
+
+// NewServeCommand creates the serve command exposing this node's instances to other machines
+func NewServeCommand(handler instances.ServeHandler) ServeCommandResult {
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Let other machines list, monitor and stop this node's instances over TLS",
+		Long: `Serve this node's instances on a TCP port, encrypted with TLS and authenticated with a
+bearer token. Other machines point commands at it with --host, --context or 'kronos context use'.
+
+Without a certificate configured under remote: in ~/.kronos/config.yml, a self-signed one is
+generated in ~/.kronos/remote and its fingerprint printed for clients to pin. The token is
+generated in ~/.kronos/remote/token unless remote.token_file points elsewhere.
+
+Remote clients can list, query and stop instances; strategies are started on the node itself.`,
+		Args: cobra.NoArgs,
+		RunE: handler.Handle,
+		// Serving always exposes this machine, whatever node commands point at
+		Annotations: map[string]string{remote.Annotation: remote.Local},
+	}
+	cmd.Flags().String("listen", "", "Address to listen on, e.g. 0.0.0.0:7443 (default from config)")
+
+	return ServeCommandResult{
+		ServeCommand: cmd,
+	}
+}
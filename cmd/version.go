@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"github.com/backtesting-org/kronos-cli/internal/services/remote"
+	"github.com/backtesting-org/kronos-cli/internal/version"
 	"github.com/spf13/cobra"
 	"go.uber.org/fx"
 )
@@ -17,8 +19,9 @@ func NewVersionCommand() VersionCommandResult {
 			Use:   "version",
 			Short: "Show version information",
 			Run: func(cmd *cobra.Command, args []string) {
-				cmd.Println("Kronos CLI v0.1.0")
+				cmd.Println("Kronos CLI " + version.Version)
 			},
+			Annotations: map[string]string{remote.Annotation: remote.Local},
 		},
 	}
 }
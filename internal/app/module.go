@@ -4,9 +4,11 @@ import (
 	"github.com/backtesting-org/kronos-cli/internal/handlers"
 	"github.com/backtesting-org/kronos-cli/internal/handlers/strategies"
 	"github.com/backtesting-org/kronos-cli/internal/handlers/strategies/backtest"
+	"github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances"
 	"github.com/backtesting-org/kronos-cli/internal/handlers/strategies/live"
 	"github.com/backtesting-org/kronos-cli/internal/router"
 	"github.com/backtesting-org/kronos-cli/internal/services/compile"
+	"github.com/backtesting-org/kronos-cli/internal/services/remote"
 	"github.com/backtesting-org/kronos-cli/internal/setup"
 	"go.uber.org/fx"
 )
@@ -14,10 +16,12 @@ import (
 // Module provides all application dependencies by composing domain modules
 var Module = fx.Options(
 	backtest.Module,
+	instances.Module,
 	setup.Module,
 	handlers.Module,
 	router.Module,
 	live.Module,
 	strategies.Module,
 	compile.Module,
+	remote.Module,
 )
@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"context"
+
 	"github.com/backtesting-org/kronos-cli/internal/handlers/settings"
 	"github.com/backtesting-org/kronos-cli/internal/handlers/strategies"
 	backtesting "github.com/backtesting-org/kronos-cli/internal/handlers/strategies/backtest/types"
@@ -8,6 +10,7 @@ import (
 	"github.com/backtesting-org/kronos-cli/internal/handlers/strategies/monitor"
 	"github.com/backtesting-org/kronos-cli/internal/router"
 	setup "github.com/backtesting-org/kronos-cli/internal/setup/types"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
 )
@@ -28,6 +31,7 @@ type rootHandler struct {
 	connectorFormFactory settings.ConnectorFormViewFactory
 	deleteConfirmFactory settings.DeleteConfirmViewFactory
 	router               router.Router
+	scheduler            live.Scheduler
 }
 
 func NewRootHandler(
@@ -41,6 +45,7 @@ func NewRootHandler(
 	connectorFormFactory settings.ConnectorFormViewFactory,
 	deleteConfirmFactory settings.DeleteConfirmViewFactory,
 	r router.Router,
+	scheduler live.Scheduler,
 ) RootHandler {
 	// Register ALL routes with the router at initialization
 	r.RegisterRoute(router.RouteMonitor, func() tea.Model {
@@ -78,6 +83,7 @@ func NewRootHandler(
 		connectorFormFactory: connectorFormFactory,
 		deleteConfirmFactory: deleteConfirmFactory,
 		router:               r,
+		scheduler:            scheduler,
 	}
 }
 
@@ -91,7 +97,7 @@ func (h *rootHandler) Handle(cmd *cobra.Command, args []string) error {
 	return h.runMainMenu(cmd)
 }
 
-func (h *rootHandler) runMainMenu(_ *cobra.Command) error {
+func (h *rootHandler) runMainMenu(cmd *cobra.Command) error {
 	m := mainMenuModel{
 		choices: []string{
 			"Strategies",
@@ -106,6 +112,13 @@ func (h *rootHandler) runMainMenu(_ *cobra.Command) error {
 	// Set main menu as the initial view in router
 	h.router.SetInitialView(m)
 
+	// Strategy schedules are kept while the TUI is open
+	ctx, cancel := context.WithCancel(cmd.Context())
+	defer cancel()
+	go func() {
+		_ = h.scheduler.Run(ctx)
+	}()
+
 	// Run the router ONCE - all navigation happens within this single program
 	p := tea.NewProgram(h.router, tea.WithAltScreen())
 	_, err := p.Run()
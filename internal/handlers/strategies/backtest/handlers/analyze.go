@@ -1,22 +1,34 @@
 package handlers
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/backtesting-org/kronos-cli/internal/handlers/strategies/backtest/types"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/series"
+	"github.com/backtesting-org/kronos-cli/internal/services/monitoring/report"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
 	"github.com/spf13/cobra"
 )
 
 // analyzeHandler handles the analyze command
 type analyzeHandler struct {
 	analyzeService types.AnalyzeService
+	series         live.SeriesStore
 }
 
-func NewAnalyzeHandler(analyzeService types.AnalyzeService) types.AnalyzeHandler {
+func NewAnalyzeHandler(analyzeService types.AnalyzeService, series live.SeriesStore) types.AnalyzeHandler {
 	return &analyzeHandler{
 		analyzeService: analyzeService,
+		series:         series,
 	}
 }
 
 func (h *analyzeHandler) Handle(cmd *cobra.Command, args []string) error {
+	if strategyName, _ := cmd.Flags().GetString("live"); strategyName != "" {
+		return h.analyzeLive(cmd, strategyName)
+	}
+
 	resultsPath, _ := cmd.Flags().GetString("path")
 	if resultsPath == "" {
 		resultsPath = "./results"
@@ -24,3 +36,43 @@ func (h *analyzeHandler) Handle(cmd *cobra.Command, args []string) error {
 
 	return h.analyzeService.AnalyzeResults(resultsPath)
 }
+
+// analyzeLive sums up the metrics history a live strategy recorded while it ran
+func (h *analyzeHandler) analyzeLive(cmd *cobra.Command, strategyName string) error {
+	output, _ := cmd.Flags().GetString("output")
+	format, err := report.ParseFormat(output)
+	if err != nil {
+		return err
+	}
+
+	var from time.Time
+	if since, _ := cmd.Flags().GetDuration("since"); since > 0 {
+		from = time.Now().Add(-since)
+	}
+
+	samples, err := h.series.Query(strategyName, from, time.Time{})
+	if err != nil {
+		return fmt.Errorf("failed to read metrics history: %w", err)
+	}
+
+	analysis := series.Analyze(strategyName, samples, time.Local)
+	if analysis == nil {
+		return fmt.Errorf("no metrics recorded for %s in that window, strategies record them while running live", strategyName)
+	}
+
+	now := time.Now()
+	writer := report.NewWriter(cmd.OutOrStdout(), format, false)
+	switch format {
+	case report.FormatJSON:
+		// The analysis carries its days
+		return writer.Write(report.Analysis(analysis), now)
+	case report.FormatCSV:
+		return writer.Write(report.Days(analysis.Days), now)
+	}
+
+	if err := writer.Write(report.Analysis(analysis), now); err != nil {
+		return err
+	}
+	fmt.Fprintln(cmd.OutOrStdout())
+	return writer.Write(report.Days(analysis.Days), now)
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"syscall"
+
+	"github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/types"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/alerts"
+	"github.com/backtesting-org/kronos-cli/internal/ui"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/spf13/cobra"
+)
+
+// alertsHandler handles the alerts commands
+type alertsHandler struct {
+	alerter live.Alerter
+}
+
+func NewAlertsHandler(alerter live.Alerter) types.AlertsHandler {
+	return &alertsHandler{
+		alerter: alerter,
+	}
+}
+
+func (h *alertsHandler) Watch(cmd *cobra.Command, args []string) error {
+	cfg, err := h.loadConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	ui.Info(fmt.Sprintf("Evaluating %d alert rules every %s, sending to %d sinks (Ctrl+C to stop)", len(cfg.Rules), cfg.Interval, len(cfg.Sinks)))
+
+	return h.alerter.Watch(ctx, cfg, func(alert *live.Alert, errs map[string]error) {
+		cmd.Println(ui.FormatAlert(alert))
+		for _, name := range sortedErrorKeys(errs) {
+			ui.Warning(fmt.Sprintf("Failed to send to %s: %v", name, errs[name]))
+		}
+	})
+}
+
+func (h *alertsHandler) Check(cmd *cobra.Command, args []string) error {
+	cfg, err := h.loadConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	firing, err := h.alerter.Check(cmd.Context(), cfg)
+	if err != nil {
+		return err
+	}
+	if len(firing) == 0 {
+		ui.Success(fmt.Sprintf("No alerts firing (%d rules checked)", len(cfg.Rules)))
+		return nil
+	}
+
+	for _, alert := range firing {
+		cmd.Println(ui.FormatAlert(alert))
+	}
+	return fmt.Errorf("%d alerts firing", len(firing))
+}
+
+func (h *alertsHandler) Test(cmd *cobra.Command, args []string) error {
+	cfg, err := h.loadConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	results, err := h.alerter.Test(cmd.Context(), cfg, args)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, name := range sortedErrorKeys(results) {
+		if results[name] != nil {
+			ui.Error(fmt.Sprintf("%s: %v", name, results[name]))
+			failed++
+			continue
+		}
+		ui.Success(fmt.Sprintf("%s: sent", name))
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d sinks failed", failed, len(results))
+	}
+	return nil
+}
+
+func (h *alertsHandler) loadConfig(cmd *cobra.Command) (*live.AlertConfig, error) {
+	path, _ := cmd.Flags().GetString("file")
+	if path == "" {
+		path = alerts.DefaultPath()
+	}
+	return alerts.LoadConfig(path)
+}
+
+func sortedErrorKeys(errs map[string]error) []string {
+	names := make([]string, 0, len(errs))
+	for name := range errs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
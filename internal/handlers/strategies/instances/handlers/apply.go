@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/types"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/apply"
+	"github.com/backtesting-org/kronos-cli/internal/ui"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/spf13/cobra"
+)
+
+// applyHandler handles the apply command
+type applyHandler struct {
+	applier live.Applier
+	config  *live.SupervisorConfig
+}
+
+func NewApplyHandler(applier live.Applier, config *live.SupervisorConfig) types.ApplyHandler {
+	return &applyHandler{
+		applier: applier,
+		config:  config,
+	}
+}
+
+func (h *applyHandler) Handle(cmd *cobra.Command, args []string) error {
+	file, _ := cmd.Flags().GetString("file")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	desired, err := apply.LoadDesiredState(file)
+	if err != nil {
+		return err
+	}
+
+	opts := h.config.Stop
+	if cmd.Flags().Changed("mode") {
+		modeFlag, _ := cmd.Flags().GetString("mode")
+		mode, err := live.ParseStopMode(modeFlag)
+		if err != nil {
+			return err
+		}
+		opts.Mode = mode
+	}
+	if cmd.Flags().Changed("drain-timeout") {
+		opts.DrainTimeout, _ = cmd.Flags().GetDuration("drain-timeout")
+	}
+
+	plan, err := h.applier.Plan(desired)
+	if err != nil {
+		return err
+	}
+
+	if dryRun || plan.Changes() == 0 {
+		ui.DisplayPlan(plan, false)
+		if plan.Changes() == 0 {
+			ui.Success("Running instances already match " + file)
+		}
+		return nil
+	}
+
+	ui.Info(fmt.Sprintf("Applying %d change(s) from %s...", plan.Changes(), file))
+
+	err = h.applier.Apply(cmd.Context(), plan, opts)
+	ui.DisplayPlan(plan, true)
+	return err
+}
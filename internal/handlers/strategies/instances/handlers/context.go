@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/types"
+	"github.com/backtesting-org/kronos-cli/internal/services/remote"
+	"github.com/backtesting-org/kronos-cli/internal/ui"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// contextVerifyTimeout bounds reaching a node when a context is added
+const contextVerifyTimeout = 10 * time.Second
+
+// contextHandler handles the context subcommands
+type contextHandler struct {
+	path string
+}
+
+func NewContextHandler() types.ContextHandler {
+	return &contextHandler{path: remote.DefaultContextsPath()}
+}
+
+func (h *contextHandler) List(cmd *cobra.Command, args []string) error {
+	contexts, err := remote.LoadContexts(h.path)
+	if err != nil {
+		return err
+	}
+
+	current := contexts.Current()
+	data := pterm.TableData{{"", "Name", "Host", "Verified by"}}
+	data = append(data, []string{marker(current == live.LocalContext), live.LocalContext, "this machine", "-"})
+	for _, hc := range contexts.List() {
+		data = append(data, []string{marker(current == hc.Name), hc.Name, hc.Host, verifiedBy(hc)})
+	}
+
+	_ = pterm.DefaultTable.WithHasHeader().WithData(data).Render()
+	return nil
+}
+
+func (h *contextHandler) Use(cmd *cobra.Command, args []string) error {
+	contexts, err := remote.LoadContexts(h.path)
+	if err != nil {
+		return err
+	}
+	if err := contexts.Use(args[0]); err != nil {
+		return err
+	}
+	if err := contexts.Save(); err != nil {
+		return err
+	}
+
+	ui.Success(fmt.Sprintf("Commands now act on %s", args[0]))
+	return nil
+}
+
+func (h *contextHandler) Add(cmd *cobra.Command, args []string) error {
+	hc := &live.HostContext{Name: args[0]}
+	hc.Host, _ = cmd.Flags().GetString("host")
+	hc.Token, _ = cmd.Flags().GetString("token")
+	hc.TokenFile, _ = cmd.Flags().GetString("token-file")
+	hc.Fingerprint, _ = cmd.Flags().GetString("fingerprint")
+	hc.CAFile, _ = cmd.Flags().GetString("ca-file")
+
+	contexts, err := remote.LoadContexts(h.path)
+	if err != nil {
+		return err
+	}
+	if err := contexts.Add(hc); err != nil {
+		return err
+	}
+
+	// Reaching the node now catches a wrong address, token or fingerprint before the first real command
+	node, err := remote.Dial(hc)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(cmd.Context(), contextVerifyTimeout)
+	defer cancel()
+	info, err := node.Info(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to verify %s: %w", hc.Name, err)
+	}
+
+	if err := contexts.Save(); err != nil {
+		return err
+	}
+
+	ui.Success(fmt.Sprintf("Added %s: %s running kronos %s", hc.Name, info.Name, info.Version))
+	ui.Info(fmt.Sprintf("Run 'kronos context use %s' to make it the default, or pass --context %s", hc.Name, hc.Name))
+	return nil
+}
+
+func (h *contextHandler) Remove(cmd *cobra.Command, args []string) error {
+	contexts, err := remote.LoadContexts(h.path)
+	if err != nil {
+		return err
+	}
+	if err := contexts.Remove(args[0]); err != nil {
+		return err
+	}
+	if err := contexts.Save(); err != nil {
+		return err
+	}
+
+	ui.Success(fmt.Sprintf("Removed %s", args[0]))
+	return nil
+}
+
+func marker(current bool) string {
+	if current {
+		return "*"
+	}
+	return ""
+}
+
+func verifiedBy(hc *live.HostContext) string {
+	switch {
+	case hc.Fingerprint != "":
+		return "pinned certificate"
+	case hc.CAFile != "":
+		return hc.CAFile
+	default:
+		return "system roots"
+	}
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/types"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/deploy"
+	"github.com/backtesting-org/kronos-cli/internal/ui"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/spf13/cobra"
+)
+
+// deployHandler handles the deploy up, down and status commands
+type deployHandler struct {
+	deployer live.Deployer
+	config   *live.SupervisorConfig
+}
+
+func NewDeployHandler(deployer live.Deployer, config *live.SupervisorConfig) types.DeployHandler {
+	return &deployHandler{
+		deployer: deployer,
+		config:   config,
+	}
+}
+
+func (h *deployHandler) Up(cmd *cobra.Command, args []string) error {
+	deployment, err := h.load(cmd, args[0])
+	if err != nil {
+		return err
+	}
+
+	ui.Info(fmt.Sprintf("Bringing up %s...", deployment.Name))
+
+	status, err := h.deployer.Up(cmd.Context(), deployment)
+	if status != nil {
+		ui.DisplayDeployment(status)
+	}
+	return err
+}
+
+func (h *deployHandler) Down(cmd *cobra.Command, args []string) error {
+	deployment, err := h.load(cmd, args[0])
+	if err != nil {
+		return err
+	}
+
+	opts := h.config.Stop
+	if cmd.Flags().Changed("mode") {
+		modeFlag, _ := cmd.Flags().GetString("mode")
+		mode, err := live.ParseStopMode(modeFlag)
+		if err != nil {
+			return err
+		}
+		opts.Mode = mode
+	}
+	if cmd.Flags().Changed("drain-timeout") {
+		opts.DrainTimeout, _ = cmd.Flags().GetDuration("drain-timeout")
+	}
+
+	ui.Info(fmt.Sprintf("Taking down %s (%s)...", deployment.Name, opts.Mode.Description()))
+
+	status, err := h.deployer.Down(cmd.Context(), deployment, opts)
+	if status != nil {
+		ui.DisplayDeployment(status)
+	}
+	return err
+}
+
+func (h *deployHandler) Status(cmd *cobra.Command, args []string) error {
+	file, _ := cmd.Flags().GetString("file")
+
+	// Without a name, every deployment in the file is shown
+	var deployments []*live.Deployment
+	if len(args) > 0 {
+		deployment, err := deploy.LoadDeployment(file, args[0])
+		if err != nil {
+			return err
+		}
+		deployments = append(deployments, deployment)
+	} else {
+		all, err := deploy.LoadDeployments(file)
+		if err != nil {
+			return err
+		}
+		for _, deployment := range all {
+			deployments = append(deployments, deployment)
+		}
+		sort.Slice(deployments, func(i, j int) bool { return deployments[i].Name < deployments[j].Name })
+	}
+
+	for _, deployment := range deployments {
+		status, err := h.deployer.Status(deployment)
+		if err != nil {
+			return err
+		}
+		ui.DisplayDeployment(status)
+	}
+	return nil
+}
+
+func (h *deployHandler) load(cmd *cobra.Command, name string) (*live.Deployment, error) {
+	file, _ := cmd.Flags().GetString("file")
+	return deploy.LoadDeployment(file, name)
+}
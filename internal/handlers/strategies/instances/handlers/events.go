@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/types"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/events"
+	"github.com/backtesting-org/kronos-cli/internal/ui"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/spf13/cobra"
+)
+
+// followInterval is how often --follow polls the audit log
+const followInterval = time.Second
+
+// eventsHandler handles the events command
+type eventsHandler struct {
+	state live.StateStore
+}
+
+func NewEventsHandler(state live.StateStore) types.EventsHandler {
+	return &eventsHandler{
+		state: state,
+	}
+}
+
+func (h *eventsHandler) Handle(cmd *cobra.Command, args []string) error {
+	filter := live.HistoryFilter{}
+	filter.StrategyName, _ = cmd.Flags().GetString("strategy")
+	if since, _ := cmd.Flags().GetDuration("since"); since > 0 {
+		filter.Since = time.Now().Add(-since)
+	}
+	filter.Limit, _ = cmd.Flags().GetInt("limit")
+
+	recorded, err := h.state.ListEvents(filter)
+	if err != nil {
+		return fmt.Errorf("failed to load events: %w", err)
+	}
+
+	follow, _ := cmd.Flags().GetBool("follow")
+	if len(recorded) == 0 && !follow {
+		ui.Info("No events recorded yet")
+		return nil
+	}
+
+	// Listed newest first, printed oldest first like a log
+	for i := len(recorded) - 1; i >= 0; i-- {
+		cmd.Println(ui.FormatEvent(recorded[i]))
+	}
+
+	if !follow {
+		return nil
+	}
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Carry on after the last event shown
+	since := time.Now()
+	if len(recorded) > 0 {
+		since = recorded[0].Time
+	}
+	filter.Limit = 0
+
+	stream, errs := events.Follow(ctx, h.state, filter, since, followInterval)
+	for {
+		select {
+		case event, ok := <-stream:
+			if !ok {
+				return nil
+			}
+			cmd.Println(ui.FormatEvent(event))
+		case err := <-errs:
+			ui.Warning(fmt.Sprintf("Failed to read events: %v", err))
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/types"
+	"github.com/backtesting-org/kronos-cli/internal/services/monitoring/exporter"
+	"github.com/backtesting-org/kronos-cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// exporterShutdownTimeout bounds waiting for scrapes in flight when the exporter is interrupted
+const exporterShutdownTimeout = 5 * time.Second
+
+// exporterHandler handles the exporter command
+type exporterHandler struct {
+	exporter *exporter.Exporter
+}
+
+func NewExporterHandler(exporter *exporter.Exporter) types.ExporterHandler {
+	return &exporterHandler{
+		exporter: exporter,
+	}
+}
+
+func (h *exporterHandler) Handle(cmd *cobra.Command, args []string) error {
+	listen, _ := cmd.Flags().GetString("listen")
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := h.exporter.Start(listen); err != nil {
+		return err
+	}
+
+	ui.Success(fmt.Sprintf("Exporting metrics of running instances on http://%s%s", h.exporter.Addr(), exporter.MetricsPath))
+	ui.Info("Press Ctrl+C to stop exporting (running strategies are left running)")
+
+	<-ctx.Done()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), exporterShutdownTimeout)
+	defer cancel()
+	return h.exporter.Stop(shutdownCtx)
+}
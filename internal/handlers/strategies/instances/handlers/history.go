@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/types"
+	"github.com/backtesting-org/kronos-cli/internal/ui"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/spf13/cobra"
+)
+
+// historyHandler handles the instances history command
+type historyHandler struct {
+	history live.HistoryStore
+}
+
+func NewHistoryHandler(history live.HistoryStore) types.HistoryHandler {
+	return &historyHandler{
+		history: history,
+	}
+}
+
+func (h *historyHandler) Handle(cmd *cobra.Command, args []string) error {
+	filter := live.HistoryFilter{}
+	if len(args) > 0 {
+		filter.StrategyName = args[0]
+	}
+	if since, _ := cmd.Flags().GetDuration("since"); since > 0 {
+		filter.Since = time.Now().Add(-since)
+	}
+
+	// A single session is looked up across the whole archive
+	id, _ := cmd.Flags().GetString("session")
+	if id == "" {
+		filter.Limit, _ = cmd.Flags().GetInt("limit")
+	}
+
+	sessions, err := h.history.List(filter)
+	if err != nil {
+		return fmt.Errorf("failed to load session history: %w", err)
+	}
+
+	if len(sessions) == 0 {
+		ui.Info("No archived sessions yet")
+		return nil
+	}
+
+	if id != "" {
+		for _, session := range sessions {
+			if session.ID == id {
+				cmd.Println(ui.RenderSession(session))
+				return nil
+			}
+		}
+		return fmt.Errorf("session not found: %s", id)
+	}
+
+	ui.DisplaySessionHistory(sessions)
+	return nil
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"sort"
+
+	"github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/types"
+	"github.com/backtesting-org/kronos-cli/internal/services/remote"
+	"github.com/backtesting-org/kronos-cli/internal/ui"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/spf13/cobra"
+)
+
+// listHandler handles the instances list command
+type listHandler struct {
+	manager live.InstanceManager
+	querier monitoring.ViewQuerier
+	target  *remote.Target
+}
+
+func NewListHandler(manager live.InstanceManager, querier monitoring.ViewQuerier, target *remote.Target) types.ListHandler {
+	return &listHandler{
+		manager: manager,
+		querier: querier,
+		target:  target,
+	}
+}
+
+func (h *listHandler) Handle(cmd *cobra.Command, args []string) error {
+	all, _ := cmd.Flags().GetBool("all")
+
+	tracked, err := h.manager.List("")
+	if err != nil {
+		return err
+	}
+	sockets, err := h.querier.ListInstances()
+	if err != nil {
+		return err
+	}
+
+	var instances []*live.Instance
+	seen := make(map[string]bool)
+	for _, instance := range tracked {
+		active := instance.Status == live.StatusRunning || instance.Status == live.StatusStandby || instance.Status == live.StatusRestarting
+		if !active && !all {
+			continue
+		}
+		instances = append(instances, instance)
+		if active {
+			seen[instance.StrategyName] = true
+		}
+	}
+
+	// Strategies started outside the supervisor's state still have a monitoring socket
+	for _, name := range sockets {
+		if !seen[name] {
+			instances = append(instances, &live.Instance{StrategyName: name, Status: live.StatusRunning})
+		}
+	}
+
+	sort.SliceStable(instances, func(i, j int) bool { return instances[i].StrategyName < instances[j].StrategyName })
+
+	healthy := make(map[string]bool)
+	for _, name := range sockets {
+		healthy[name] = h.querier.HealthCheck(name) == nil
+	}
+
+	node := "this machine"
+	if remoteNode := h.target.Node(); remoteNode != nil {
+		node = remoteNode.Name()
+	}
+
+	if len(instances) == 0 {
+		ui.Info("No instances on " + node)
+		return nil
+	}
+	ui.DisplayInstances(node, instances, healthy)
+	return nil
+}
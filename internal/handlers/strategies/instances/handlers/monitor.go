@@ -0,0 +1,174 @@
+package handlers
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/types"
+	"github.com/backtesting-org/kronos-cli/internal/services/monitoring/report"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/spf13/cobra"
+)
+
+// monitorHandler handles the headless monitor commands, which print the same views as the monitor TUI
+type monitorHandler struct {
+	querier monitoring.ViewQuerier
+}
+
+func NewMonitorHandler(querier monitoring.ViewQuerier) types.MonitorHandler {
+	return &monitorHandler{
+		querier: querier,
+	}
+}
+
+func (h *monitorHandler) PnL(cmd *cobra.Command, args []string) error {
+	instanceID := args[0]
+	return h.run(cmd, func() (report.Report, error) {
+		pnl, err := h.querier.QueryPnL(instanceID)
+		if err != nil {
+			return report.Report{}, fmt.Errorf("failed to query PnL of %s: %w", instanceID, err)
+		}
+		return report.PnL(pnl), nil
+	})
+}
+
+func (h *monitorHandler) Positions(cmd *cobra.Command, args []string) error {
+	instanceID := args[0]
+	return h.run(cmd, func() (report.Report, error) {
+		positions, err := h.querier.QueryPositions(instanceID)
+		if err != nil {
+			return report.Report{}, fmt.Errorf("failed to query positions of %s: %w", instanceID, err)
+		}
+		return report.Positions(positions), nil
+	})
+}
+
+func (h *monitorHandler) Trades(cmd *cobra.Command, args []string) error {
+	instanceID := args[0]
+	limit, _ := cmd.Flags().GetInt("limit")
+	return h.run(cmd, func() (report.Report, error) {
+		trades, err := h.querier.QueryRecentTrades(instanceID, limit)
+		if err != nil {
+			return report.Report{}, fmt.Errorf("failed to query trades of %s: %w", instanceID, err)
+		}
+		return report.Trades(trades), nil
+	})
+}
+
+func (h *monitorHandler) Orderbook(cmd *cobra.Command, args []string) error {
+	instanceID := args[0]
+	asset, _ := cmd.Flags().GetString("asset")
+	exchange, _ := cmd.Flags().GetString("exchange")
+	depth, _ := cmd.Flags().GetInt("depth")
+
+	if asset == "" {
+		// Default to the first asset the strategy trades, as the monitor does
+		assets, err := h.querier.QueryAvailableAssets(instanceID)
+		if err != nil {
+			return fmt.Errorf("failed to query assets of %s: %w", instanceID, err)
+		}
+		if len(assets) == 0 {
+			return fmt.Errorf("%s doesn't trade any assets yet, pass --asset", instanceID)
+		}
+		asset = assets[0].Asset
+		if exchange == "" {
+			exchange = assets[0].Exchange
+		}
+	}
+
+	return h.run(cmd, func() (report.Report, error) {
+		book, err := h.querier.QueryOrderbook(instanceID, asset, exchange)
+		if err != nil {
+			return report.Report{}, fmt.Errorf("failed to query %s orderbook of %s: %w", asset, instanceID, err)
+		}
+		return report.Orderbook(book, depth), nil
+	})
+}
+
+func (h *monitorHandler) Metrics(cmd *cobra.Command, args []string) error {
+	instanceID := args[0]
+	return h.run(cmd, func() (report.Report, error) {
+		metrics, err := h.querier.QueryMetrics(instanceID)
+		if err != nil {
+			return report.Report{}, fmt.Errorf("failed to query metrics of %s: %w", instanceID, err)
+		}
+		return report.Metrics(metrics), nil
+	})
+}
+
+// Health reports an unhealthy instance as a row rather than an error, but still fails
+// when not watching so scripts can check the exit status
+func (h *monitorHandler) Health(cmd *cobra.Command, args []string) error {
+	instanceID := args[0]
+	var healthErr error
+	err := h.run(cmd, func() (report.Report, error) {
+		healthErr = h.querier.HealthCheck(instanceID)
+		return report.Health(instanceID, healthErr), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if watch, _ := cmd.Flags().GetDuration("watch"); watch == 0 && healthErr != nil {
+		return fmt.Errorf("%s is unhealthy", instanceID)
+	}
+	return nil
+}
+
+func (h *monitorHandler) Profiling(cmd *cobra.Command, args []string) error {
+	instanceID := args[0]
+	return h.run(cmd, func() (report.Report, error) {
+		stats, err := h.querier.QueryProfilingStats(instanceID)
+		if err != nil {
+			return report.Report{}, fmt.Errorf("failed to query profiling stats of %s: %w", instanceID, err)
+		}
+		return report.Profiling(stats), nil
+	})
+}
+
+// run writes the report taken by query once, or every --watch interval until interrupted.
+// While watching, a failed query is reported on stderr and retried on the next tick.
+func (h *monitorHandler) run(cmd *cobra.Command, query func() (report.Report, error)) error {
+	outputFlag, _ := cmd.Flags().GetString("output")
+	format, err := report.ParseFormat(outputFlag)
+	if err != nil {
+		return err
+	}
+	watch, _ := cmd.Flags().GetDuration("watch")
+	if watch < 0 {
+		return fmt.Errorf("--watch must be positive")
+	}
+
+	writer := report.NewWriter(cmd.OutOrStdout(), format, watch > 0)
+
+	if watch == 0 {
+		snapshot, err := query()
+		if err != nil {
+			return err
+		}
+		return writer.Write(snapshot, time.Now())
+	}
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	ticker := time.NewTicker(watch)
+	defer ticker.Stop()
+
+	for {
+		if snapshot, err := query(); err != nil {
+			cmd.PrintErrln(err)
+		} else if err := writer.Write(snapshot, time.Now()); err != nil {
+			return fmt.Errorf("failed to write output: %w", err)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/types"
+	"github.com/backtesting-org/kronos-cli/internal/ui"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/spf13/cobra"
+)
+
+// panicHandler handles the panic command
+type panicHandler struct {
+	emergency live.EmergencyStop
+	config    *live.SupervisorConfig
+}
+
+func NewPanicHandler(emergency live.EmergencyStop, config *live.SupervisorConfig) types.PanicHandler {
+	return &panicHandler{
+		emergency: emergency,
+		config:    config,
+	}
+}
+
+func (h *panicHandler) Handle(cmd *cobra.Command, args []string) error {
+	opts := live.PanicOptions{
+		Mode:         live.StopModeLeave,
+		DrainTimeout: h.config.Stop.DrainTimeout,
+	}
+
+	if cmd.Flags().Changed("mode") {
+		modeFlag, _ := cmd.Flags().GetString("mode")
+		mode, err := live.ParseStopMode(modeFlag)
+		if err != nil {
+			return err
+		}
+		opts.Mode = mode
+	}
+	if cmd.Flags().Changed("drain-timeout") {
+		opts.DrainTimeout, _ = cmd.Flags().GetDuration("drain-timeout")
+	}
+
+	if yes, _ := cmd.Flags().GetBool("yes"); !yes {
+		if !ui.Confirm(fmt.Sprintf("Stop every live strategy (%s)?", opts.Mode.Description())) {
+			ui.Info("Cancelled")
+			return nil
+		}
+	}
+
+	ui.Warning(fmt.Sprintf("Stopping every instance (%s, drain timeout %s)...", opts.Mode.Description(), opts.DrainTimeout))
+
+	report := h.emergency.Panic(cmd.Context(), opts)
+	cmd.Println(ui.RenderPanicReport(report))
+
+	if !report.Clean() {
+		return fmt.Errorf("panic finished with problems")
+	}
+	return nil
+}
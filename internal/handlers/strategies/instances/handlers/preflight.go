@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/types"
+	"github.com/backtesting-org/kronos-cli/internal/ui"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/config"
+	"github.com/spf13/cobra"
+)
+
+// preflightHandler handles the preflight command
+type preflightHandler struct {
+	preflight  live.Preflight
+	strategies config.StrategyConfig
+}
+
+func NewPreflightHandler(preflight live.Preflight, strategies config.StrategyConfig) types.PreflightHandler {
+	return &preflightHandler{
+		preflight:  preflight,
+		strategies: strategies,
+	}
+}
+
+func (h *preflightHandler) Handle(cmd *cobra.Command, args []string) error {
+	strategies, err := h.strategies.FindStrategies()
+	if err != nil {
+		return fmt.Errorf("failed to find strategies: %w", err)
+	}
+
+	var strat *config.Strategy
+	for i := range strategies {
+		if strategies[i].Name == args[0] {
+			strat = &strategies[i]
+			break
+		}
+	}
+	if strat == nil {
+		return fmt.Errorf("strategy not found: %s", args[0])
+	}
+
+	report := h.preflight.Run(cmd.Context(), strat)
+	ui.DisplayPreflight(report)
+
+	if !report.Passed() {
+		return fmt.Errorf("pre-flight failed for %s", strat.Name)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/types"
+	"github.com/backtesting-org/kronos-cli/internal/ui"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/spf13/cobra"
+)
+
+// reloadHandler handles the instances reload command
+type reloadHandler struct {
+	reloader live.Reloader
+	config   *live.SupervisorConfig
+}
+
+func NewReloadHandler(reloader live.Reloader, config *live.SupervisorConfig) types.ReloadHandler {
+	return &reloadHandler{
+		reloader: reloader,
+		config:   config,
+	}
+}
+
+func (h *reloadHandler) Handle(cmd *cobra.Command, args []string) error {
+	strategyName := args[0]
+	opts := h.config.Reload
+
+	if cmd.Flags().Changed("ready-timeout") {
+		opts.ReadyTimeout, _ = cmd.Flags().GetDuration("ready-timeout")
+	}
+	if cmd.Flags().Changed("health-timeout") {
+		opts.HealthTimeout, _ = cmd.Flags().GetDuration("health-timeout")
+	}
+
+	ui.Info(fmt.Sprintf("Reloading %s...", strategyName))
+
+	result, err := h.reloader.Reload(cmd.Context(), strategyName, opts)
+	if result != nil {
+		cmd.Println(ui.RenderReloadResult(result))
+	}
+	return err
+}
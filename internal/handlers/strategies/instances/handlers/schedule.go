@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/types"
+	"github.com/backtesting-org/kronos-cli/internal/ui"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/spf13/cobra"
+)
+
+// scheduleHandler handles the instances schedule command
+type scheduleHandler struct {
+	scheduler live.Scheduler
+	events    live.EventBus
+}
+
+func NewScheduleHandler(scheduler live.Scheduler, events live.EventBus) types.ScheduleHandler {
+	return &scheduleHandler{
+		scheduler: scheduler,
+		events:    events,
+	}
+}
+
+func (h *scheduleHandler) Handle(cmd *cobra.Command, args []string) error {
+	statuses, err := h.scheduler.Status()
+	if err != nil {
+		return fmt.Errorf("failed to load schedules: %w", err)
+	}
+
+	if len(statuses) == 0 {
+		ui.Info("No strategy has a schedule block")
+		return nil
+	}
+	ui.DisplaySchedules(statuses)
+
+	if run, _ := cmd.Flags().GetBool("run"); !run {
+		return nil
+	}
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Show what the scheduler does as it happens
+	stream, cancel := h.events.Subscribe()
+	defer cancel()
+	go func() {
+		for event := range stream {
+			if event.Actor == live.ActorScheduler {
+				cmd.Println(ui.FormatEvent(&event))
+			}
+		}
+	}()
+
+	ui.Info("Applying schedules, press Ctrl+C to stop (running strategies are left running)")
+	return h.scheduler.Run(ctx)
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/types"
+	"github.com/backtesting-org/kronos-cli/internal/services/remote"
+	"github.com/backtesting-org/kronos-cli/internal/ui"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/spf13/cobra"
+)
+
+// serveShutdownTimeout bounds waiting for remote requests in flight when serve is interrupted
+const serveShutdownTimeout = 10 * time.Second
+
+// serveHandler handles the serve command
+type serveHandler struct {
+	server *remote.Server
+	config *live.SupervisorConfig
+}
+
+func NewServeHandler(server *remote.Server, config *live.SupervisorConfig) types.ServeHandler {
+	return &serveHandler{
+		server: server,
+		config: config,
+	}
+}
+
+func (h *serveHandler) Handle(cmd *cobra.Command, args []string) error {
+	cfg := h.config.Remote
+	if cmd.Flags().Changed("listen") {
+		cfg.Listen, _ = cmd.Flags().GetString("listen")
+	}
+	if cfg.Listen == "" {
+		return fmt.Errorf("no listen address: set remote.listen in ~/.kronos/config.yml or pass --listen")
+	}
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := h.server.Start(cfg); err != nil {
+		return err
+	}
+
+	ui.Success(fmt.Sprintf("Serving this node's instances on %s", h.server.Addr()))
+	cmd.Printf("  Certificate: %s\n", h.server.Fingerprint())
+	cmd.Printf("  Token:       %s\n\n", h.server.TokenFile())
+	cmd.Println("Point another machine at this node with:")
+	cmd.Printf("  kronos context add <name> --host <address>:<port> --fingerprint %s --token <token>\n\n", h.server.Fingerprint())
+	ui.Info("Press Ctrl+C to stop serving (running strategies are left running)")
+
+	<-ctx.Done()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), serveShutdownTimeout)
+	defer cancel()
+	return h.server.Stop(shutdownCtx)
+}
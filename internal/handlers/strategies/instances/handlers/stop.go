@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/types"
+	"github.com/backtesting-org/kronos-cli/internal/ui"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/spf13/cobra"
+)
+
+// stopHandler handles the instances stop command
+type stopHandler struct {
+	manager live.InstanceManager
+	config  *live.SupervisorConfig
+}
+
+func NewStopHandler(manager live.InstanceManager, config *live.SupervisorConfig) types.StopHandler {
+	return &stopHandler{
+		manager: manager,
+		config:  config,
+	}
+}
+
+func (h *stopHandler) Handle(cmd *cobra.Command, args []string) error {
+	strategyName := args[0]
+	opts := h.config.Stop
+
+	if cmd.Flags().Changed("mode") {
+		modeFlag, _ := cmd.Flags().GetString("mode")
+		mode, err := live.ParseStopMode(modeFlag)
+		if err != nil {
+			return err
+		}
+		opts.Mode = mode
+	}
+	if cmd.Flags().Changed("drain-timeout") {
+		opts.DrainTimeout, _ = cmd.Flags().GetDuration("drain-timeout")
+	}
+
+	ui.Info(fmt.Sprintf("Stopping %s (%s, drain timeout %s)...", strategyName, opts.Mode.Description(), opts.DrainTimeout))
+
+	result, err := h.manager.StopStrategy(cmd.Context(), strategyName, opts)
+	if err != nil {
+		return fmt.Errorf("failed to stop %s: %w", strategyName, err)
+	}
+
+	cmd.Println(ui.RenderStopResult(result))
+
+	if len(result.Errors) > 0 {
+		return fmt.Errorf("%s stopped with %d error(s)", strategyName, len(result.Errors))
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package instances
+
+import (
+	"github.com/backtesting-org/kronos-cli/internal/handlers/strategies/instances/handlers"
+	"go.uber.org/fx"
+)
+
+// Module provides the instance management command handlers
+var Module = fx.Module("instances",
+	fx.Provide(handlers.NewStopHandler),
+	fx.Provide(handlers.NewReloadHandler),
+	fx.Provide(handlers.NewHistoryHandler),
+	fx.Provide(handlers.NewEventsHandler),
+	fx.Provide(handlers.NewScheduleHandler),
+	fx.Provide(handlers.NewPreflightHandler),
+	fx.Provide(handlers.NewPanicHandler),
+	fx.Provide(handlers.NewDeployHandler),
+	fx.Provide(handlers.NewApplyHandler),
+	fx.Provide(handlers.NewListHandler),
+	fx.Provide(handlers.NewServeHandler),
+	fx.Provide(handlers.NewContextHandler),
+	fx.Provide(handlers.NewMonitorHandler),
+	fx.Provide(handlers.NewExporterHandler),
+	fx.Provide(handlers.NewAlertsHandler),
+)
@@ -0,0 +1,76 @@
+package types
+
+import "github.com/spf13/cobra"
+
+type StopHandler interface {
+	Handle(cmd *cobra.Command, args []string) error
+}
+
+type ReloadHandler interface {
+	Handle(cmd *cobra.Command, args []string) error
+}
+
+type HistoryHandler interface {
+	Handle(cmd *cobra.Command, args []string) error
+}
+
+type EventsHandler interface {
+	Handle(cmd *cobra.Command, args []string) error
+}
+
+type ScheduleHandler interface {
+	Handle(cmd *cobra.Command, args []string) error
+}
+
+type PreflightHandler interface {
+	Handle(cmd *cobra.Command, args []string) error
+}
+
+type PanicHandler interface {
+	Handle(cmd *cobra.Command, args []string) error
+}
+
+type DeployHandler interface {
+	Up(cmd *cobra.Command, args []string) error
+	Down(cmd *cobra.Command, args []string) error
+	Status(cmd *cobra.Command, args []string) error
+}
+
+type ApplyHandler interface {
+	Handle(cmd *cobra.Command, args []string) error
+}
+
+type ListHandler interface {
+	Handle(cmd *cobra.Command, args []string) error
+}
+
+type ServeHandler interface {
+	Handle(cmd *cobra.Command, args []string) error
+}
+
+type ContextHandler interface {
+	List(cmd *cobra.Command, args []string) error
+	Use(cmd *cobra.Command, args []string) error
+	Add(cmd *cobra.Command, args []string) error
+	Remove(cmd *cobra.Command, args []string) error
+}
+
+type MonitorHandler interface {
+	PnL(cmd *cobra.Command, args []string) error
+	Positions(cmd *cobra.Command, args []string) error
+	Trades(cmd *cobra.Command, args []string) error
+	Orderbook(cmd *cobra.Command, args []string) error
+	Metrics(cmd *cobra.Command, args []string) error
+	Health(cmd *cobra.Command, args []string) error
+	Profiling(cmd *cobra.Command, args []string) error
+}
+
+type ExporterHandler interface {
+	Handle(cmd *cobra.Command, args []string) error
+}
+
+type AlertsHandler interface {
+	Watch(cmd *cobra.Command, args []string) error
+	Check(cmd *cobra.Command, args []string) error
+	Test(cmd *cobra.Command, args []string) error
+}
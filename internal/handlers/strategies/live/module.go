@@ -2,8 +2,20 @@ package live
 
 import (
 	"github.com/backtesting-org/kronos-cli/internal/services/live"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/actions"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/alerts"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/apply"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/control"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/deploy"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/emergency"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/events"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/history"
 	"github.com/backtesting-org/kronos-cli/internal/services/live/manager"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/preflight"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/reload"
 	"github.com/backtesting-org/kronos-cli/internal/services/live/runtime"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/schedule"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/series"
 	"github.com/backtesting-org/kronos-cli/internal/services/monitoring"
 	"github.com/backtesting-org/kronos-sdk/kronos"
 	"github.com/backtesting-org/live-trading/pkg/connectors"
@@ -21,12 +33,49 @@ var Module = fx.Module("live",
 	// Monitoring - ViewRegistry for exposing runtime data
 	monitoring.Module,
 
+	// Control socket client for stopping and acting on running instances
+	control.Module,
+
+	// Archive of finished sessions
+	history.Module,
+
+	// Metrics history sampled by running strategies
+	series.Module,
+
+	// Lifecycle events and their audit log
+	events.Module,
+
 	// Instance manager for multi-instance tracking and spawning
 	manager.Module,
 
+	// Hot-swapping running strategies
+	reload.Module,
+
+	// Trading windows
+	schedule.Module,
+
+	// Checks run before a strategy goes live
+	preflight.Module,
+
+	// Stopping all trading at once
+	emergency.Module,
+
+	// Operator actions on running instances: cancelling orders, closing positions, pausing
+	actions.Module,
+
+	// Groups of strategies started and stopped together
+	deploy.Module,
+	apply.Module,
+
+	// Notifications when alert rules fire
+	alerts.Module,
+
 	// Runtime for strategy execution
 	runtime.Module,
 
+	// Journal of the signals the strategy generates; decorated here, not in runtime.Module, so it reaches the SDK executor
+	fx.Decorate(runtime.RecordSignals),
+
 	// Services
 	fx.Provide(live.NewLiveService),
 
@@ -0,0 +1,188 @@
+package monitor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/backtesting-org/kronos-cli/internal/ui"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// historyLimit caps how many sessions the history view loads
+	historyLimit = 200
+
+	// historyRows is how many sessions are listed at once; the list scrolls with the cursor
+	historyRows = 12
+)
+
+// historyListModel browses archived sessions of stopped and crashed instances
+type historyListModel struct {
+	ui.BaseModel
+	history  live.HistoryStore
+	strategy string // the strategy selected when the view was opened, used by the filter
+	filtered bool
+	sessions []*live.Session
+	cursor   int
+	loading  bool
+	err      error
+}
+
+// NewHistoryListModel creates the session history view. Passing a strategy lets [F] narrow the list down to it.
+func NewHistoryListModel(history live.HistoryStore, strategy string) tea.Model {
+	return &historyListModel{
+		BaseModel: ui.BaseModel{IsRoot: false},
+		history:   history,
+		strategy:  strategy,
+		loading:   true,
+	}
+}
+
+type historyLoadedMsg struct {
+	sessions []*live.Session
+	err      error
+}
+
+func (m *historyListModel) Init() tea.Cmd {
+	return m.loadHistory()
+}
+
+func (m *historyListModel) loadHistory() tea.Cmd {
+	filter := live.HistoryFilter{Limit: historyLimit}
+	if m.filtered {
+		filter.StrategyName = m.strategy
+	}
+	return func() tea.Msg {
+		sessions, err := m.history.List(filter)
+		return historyLoadedMsg{sessions: sessions, err: err}
+	}
+}
+
+func (m *historyListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case historyLoadedMsg:
+		m.loading = false
+		m.err = msg.err
+		m.sessions = msg.sessions
+		if m.cursor >= len(m.sessions) {
+			m.cursor = 0
+		}
+		return m, nil
+
+	case tea.KeyMsg:
+		if handled, cmd := m.BaseModel.HandleCommonKeys(msg); handled {
+			return m, cmd
+		}
+
+		switch msg.String() {
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.cursor < len(m.sessions)-1 {
+				m.cursor++
+			}
+		case "f":
+			if m.strategy != "" {
+				m.filtered = !m.filtered
+				m.loading = true
+				return m, m.loadHistory()
+			}
+		case "r":
+			m.loading = true
+			return m, m.loadHistory()
+		}
+	}
+
+	return m, nil
+}
+
+func (m *historyListModel) View() string {
+	var b strings.Builder
+
+	title := "SESSION HISTORY"
+	if m.filtered {
+		title = fmt.Sprintf("SESSION HISTORY - %s", m.strategy)
+	}
+	b.WriteString(ui.TitleStyle.Render(title))
+	b.WriteString("\n")
+
+	switch {
+	case m.loading:
+		b.WriteString(ui.SubtitleStyle.Render("Loading history..."))
+		b.WriteString("\n")
+	case m.err != nil:
+		b.WriteString(ui.StatusErrorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+		b.WriteString("\n")
+	case len(m.sessions) == 0:
+		b.WriteString(ui.SubtitleStyle.Render("No archived sessions yet"))
+		b.WriteString("\n")
+	default:
+		b.WriteString(m.renderTable())
+		b.WriteString("\n")
+		b.WriteString(ui.BoxStyle.Width(80).Render(ui.RenderSession(m.sessions[m.cursor])))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	help := "[↑↓] Navigate • [R] Refresh • [Q] Back"
+	if m.strategy != "" {
+		help = fmt.Sprintf("[↑↓] Navigate • [F] Only %s • [R] Refresh • [Q] Back", m.strategy)
+	}
+	b.WriteString(ui.HelpStyle.Render(help))
+
+	return b.String()
+}
+
+func (m *historyListModel) renderTable() string {
+	var b strings.Builder
+
+	header := fmt.Sprintf("  %-18s %-16s %-10s %-22s %-8s %s",
+		"STRATEGY", "STARTED", "DURATION", "OUTCOME", "TRADES", "PNL")
+	b.WriteString(TableHeaderStyle.Render(header))
+	b.WriteString("\n")
+	b.WriteString(lipgloss.NewStyle().Foreground(ui.ColorMuted).Render(strings.Repeat("─", 86)))
+	b.WriteString("\n")
+
+	start := 0
+	if m.cursor >= historyRows {
+		start = m.cursor - historyRows + 1
+	}
+	end := start + historyRows
+	if end > len(m.sessions) {
+		end = len(m.sessions)
+	}
+
+	for i := start; i < end; i++ {
+		session := m.sessions[i]
+		trades, pnl := "-", "-"
+		if session.Stats != nil {
+			trades = fmt.Sprintf("%d", session.Stats.TradeCount)
+			if session.Stats.PnL != nil {
+				value, _ := session.Stats.PnL.TotalPnL.Float64()
+				pnl = FormatPnL(value)
+			}
+		}
+
+		row := fmt.Sprintf("  %-18s %-16s %-10s %-22s %-8s %s",
+			session.StrategyName,
+			session.StartedAt.Local().Format("2006-01-02 15:04"),
+			ui.FormatSessionDuration(session.Duration()),
+			ui.SessionOutcome(session),
+			trades,
+			pnl,
+		)
+
+		if i == m.cursor {
+			b.WriteString(TableRowSelectedStyle.Render(row))
+		} else {
+			b.WriteString(TableRowStyle.Render(row))
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
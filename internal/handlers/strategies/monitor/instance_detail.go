@@ -6,6 +6,7 @@ import (
 
 	"github.com/backtesting-org/kronos-cli/internal/handlers/strategies/monitor/tabs"
 	"github.com/backtesting-org/kronos-cli/internal/ui"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -17,17 +18,22 @@ const (
 	TabOverview Tab = iota
 	TabPositions
 	TabOrderbook
+	TabOrders
 	TabTrades
+	TabSignals
 	TabPnL
 	TabProfiling
+	TabLogs
 )
 
-var tabNames = []string{"Overview", "Positions", "Orderbook", "Trades", "PnL", "Profiling"}
+var tabNames = []string{"Overview", "Positions", "Orderbook", "Orders", "Trades", "Signals", "PnL", "Profiling", "Logs"}
 
 // instanceDetailModel shows detailed view of a single instance
 type instanceDetailModel struct {
 	ui.BaseModel // Embed for common key handling
 	querier      monitoring.ViewQuerier
+	feed         *tabs.Feed
+	operator     *tabs.Operator
 	instanceID   string
 	activeTab    Tab
 	width        int
@@ -37,36 +43,62 @@ type instanceDetailModel struct {
 	overviewTab  *tabs.OverviewModel
 	positionsTab *tabs.PositionsModel
 	orderbookTab *tabs.OrderbookModel
+	ordersTab    *tabs.OrdersModel
 	tradesTab    *tabs.TradesModel
+	signalsTab   *tabs.SignalsModel
 	pnlTab       *tabs.PnLModel
 	profilingTab *tabs.ProfilingModel
+	logsTab      *tabs.LogsModel
 }
 
 // NewInstanceDetailModel creates a detail view for an instance
-func NewInstanceDetailModel(querier monitoring.ViewQuerier, instanceID string) tea.Model {
+// Positions, orderbook, trades and PnL are pushed by the instance when it streams, and polled otherwise.
+// Working orders and signals are kept by the instance's control socket, so they are polled.
+// The Positions, Orderbook and Orders tabs share an operator for trading actions on the instance.
+func NewInstanceDetailModel(
+	querier monitoring.ViewQuerier,
+	streamer live.ViewStreamer,
+	orders live.OrderQuerier,
+	signals live.SignalQuerier,
+	series live.SeriesStore,
+	trader live.Trader,
+	instanceID string,
+	logDir string,
+) tea.Model {
+	feed := tabs.NewFeed(streamer, instanceID)
+	operator := tabs.NewOperator(trader, querier, instanceID)
 	return &instanceDetailModel{
 		BaseModel:    ui.BaseModel{IsRoot: false},
 		querier:      querier,
+		feed:         feed,
+		operator:     operator,
 		instanceID:   instanceID,
 		activeTab:    TabOverview,
 		overviewTab:  tabs.NewOverviewModel(querier, instanceID),
-		positionsTab: tabs.NewPositionsModel(querier, instanceID),
-		orderbookTab: tabs.NewOrderbookModel(querier, instanceID),
-		tradesTab:    tabs.NewTradesModel(querier, instanceID),
-		pnlTab:       tabs.NewPnLModel(querier, instanceID),
+		positionsTab: tabs.NewPositionsModel(querier, feed, operator, instanceID),
+		orderbookTab: tabs.NewOrderbookModel(querier, feed, operator, instanceID),
+		ordersTab:    tabs.NewOrdersModel(orders, operator, instanceID),
+		tradesTab:    tabs.NewTradesModel(querier, feed, instanceID),
+		signalsTab:   tabs.NewSignalsModel(signals, instanceID),
+		pnlTab:       tabs.NewPnLModel(querier, feed, series, instanceID),
 		profilingTab: tabs.NewProfilingModel(querier, instanceID),
+		logsTab:      tabs.NewLogsModel(logDir),
 	}
 }
 
 func (m *instanceDetailModel) Init() tea.Cmd {
 	// Initialize all tabs
 	return tea.Batch(
+		m.operator.Init(),
 		m.overviewTab.Init(),
 		m.positionsTab.Init(),
 		m.orderbookTab.Init(),
+		m.ordersTab.Init(),
 		m.tradesTab.Init(),
+		m.signalsTab.Init(),
 		m.pnlTab.Init(),
 		m.profilingTab.Init(),
+		m.logsTab.Init(),
 	)
 }
 
@@ -77,10 +109,31 @@ func (m *instanceDetailModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
-		return m, nil
+		_, logsCmd := m.logsTab.Update(msg)
+		_, signalsCmd := m.signalsTab.Update(msg)
+		return m, tea.Batch(logsCmd, signalsCmd)
+	}
+
+	// Trading action dialogs take every key until they are closed, and the operator's replies are its own
+	if handled, cmd := m.operator.Update(msg); handled {
+		return m, cmd
+	}
 
+	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		// The logs and signals search prompts take all keys until they are closed
+		if m.activeTab == TabLogs && m.logsTab.Capturing() {
+			_, cmd := m.logsTab.Update(msg)
+			return m, cmd
+		}
+		if m.activeTab == TabSignals && m.signalsTab.Capturing() {
+			_, cmd := m.signalsTab.Update(msg)
+			return m, cmd
+		}
+
 		if handled, cmd := m.BaseModel.HandleCommonKeys(msg); handled {
+			// Leaving the view, the instance can stop pushing to it
+			m.feed.Close()
 			return m, cmd
 		}
 
@@ -92,7 +145,7 @@ func (m *instanceDetailModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 
 		case "right", "l":
-			if m.activeTab < TabProfiling {
+			if m.activeTab < TabLogs {
 				m.activeTab++
 			}
 			return m, nil
@@ -107,14 +160,23 @@ func (m *instanceDetailModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.activeTab = TabOrderbook
 			return m, nil
 		case "4":
-			m.activeTab = TabTrades
+			m.activeTab = TabOrders
 			return m, nil
 		case "5":
-			m.activeTab = TabPnL
+			m.activeTab = TabTrades
 			return m, nil
 		case "6":
+			m.activeTab = TabSignals
+			return m, nil
+		case "7":
+			m.activeTab = TabPnL
+			return m, nil
+		case "8":
 			m.activeTab = TabProfiling
 			return m, nil
+		case "9":
+			m.activeTab = TabLogs
+			return m, nil
 		}
 
 		// Forward key messages only to active tab
@@ -126,12 +188,18 @@ func (m *instanceDetailModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			_, cmd = m.positionsTab.Update(msg)
 		case TabOrderbook:
 			_, cmd = m.orderbookTab.Update(msg)
+		case TabOrders:
+			_, cmd = m.ordersTab.Update(msg)
 		case TabTrades:
 			_, cmd = m.tradesTab.Update(msg)
+		case TabSignals:
+			_, cmd = m.signalsTab.Update(msg)
 		case TabPnL:
 			_, cmd = m.pnlTab.Update(msg)
 		case TabProfiling:
 			_, cmd = m.profilingTab.Update(msg)
+		case TabLogs:
+			_, cmd = m.logsTab.Update(msg)
 		}
 		if cmd != nil {
 			cmds = append(cmds, cmd)
@@ -158,11 +226,21 @@ func (m *instanceDetailModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmds = append(cmds, cmd)
 	}
 
+	_, cmd = m.ordersTab.Update(msg)
+	if cmd != nil {
+		cmds = append(cmds, cmd)
+	}
+
 	_, cmd = m.tradesTab.Update(msg)
 	if cmd != nil {
 		cmds = append(cmds, cmd)
 	}
 
+	_, cmd = m.signalsTab.Update(msg)
+	if cmd != nil {
+		cmds = append(cmds, cmd)
+	}
+
 	_, cmd = m.pnlTab.Update(msg)
 	if cmd != nil {
 		cmds = append(cmds, cmd)
@@ -173,6 +251,11 @@ func (m *instanceDetailModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmds = append(cmds, cmd)
 	}
 
+	_, cmd = m.logsTab.Update(msg)
+	if cmd != nil {
+		cmds = append(cmds, cmd)
+	}
+
 	return m, tea.Batch(cmds...)
 }
 
@@ -195,19 +278,43 @@ func (m *instanceDetailModel) View() string {
 		b.WriteString(m.positionsTab.View())
 	case TabOrderbook:
 		b.WriteString(m.orderbookTab.View())
+	case TabOrders:
+		b.WriteString(m.ordersTab.View())
 	case TabTrades:
 		b.WriteString(m.tradesTab.View())
+	case TabSignals:
+		b.WriteString(m.signalsTab.View())
 	case TabPnL:
 		b.WriteString(m.pnlTab.View())
 	case TabProfiling:
 		b.WriteString(m.profilingTab.View())
+	case TabLogs:
+		b.WriteString(m.logsTab.View())
+	}
+
+	if view := m.operator.View(); view != "" {
+		b.WriteString("\n\n")
+		b.WriteString(view)
 	}
 
 	// Help
 	b.WriteString("\n\n")
-	helpText := "[←→] Switch Tab • [1-6] Jump to Tab • [R] Refresh • [Q] Back"
-	if m.activeTab == TabOrderbook {
-		helpText = "[←→] Switch Tab • [D] Toggle Depth • [R] Refresh • [Q] Back"
+	helpText := "[←→] Switch Tab • [1-9] Jump to Tab • [R] Refresh • [Q] Back"
+	switch {
+	case m.operator.Capturing():
+		helpText = m.operator.Help()
+	case m.activeTab == TabPositions:
+		helpText = "[←→] Switch Tab • [↑↓] Select • [C] Close Position • [X] Cancel Orders • [Space] Pause/Resume • [Q] Back"
+	case m.activeTab == TabOrderbook:
+		helpText = "[←→] Switch Tab • [D] Toggle Depth • [C] Close Position • [X] Cancel Orders • [Space] Pause/Resume • [Q] Back"
+	case m.activeTab == TabOrders:
+		helpText = "[←→] Switch Tab • [↑↓] Select • [X] Cancel Order • [Shift+X] Cancel Asset's Orders • [Space] Pause/Resume • [Q] Back"
+	case m.activeTab == TabSignals && m.signalsTab.Capturing():
+		helpText = "Type to search • [Enter] Apply • [Esc] Cancel"
+	case m.activeTab == TabSignals:
+		helpText = "[←→] Switch Tab • [↑↓/PgUp/PgDn] Select • [O] Outcome • [/] Search • [R] Refresh • [Q] Back"
+	case m.activeTab == TabPnL:
+		helpText = "[←→] Switch Tab • [C] Today/30 Days • [R] Refresh • [Q] Back"
 	}
 	b.WriteString(ui.HelpStyle.Render(helpText))
 
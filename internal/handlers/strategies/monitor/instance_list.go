@@ -1,11 +1,17 @@
 package monitor
 
 import (
+	"context"
 	"fmt"
+	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/backtesting-org/kronos-cli/internal/services/live/binary"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/logs"
 	"github.com/backtesting-org/kronos-cli/internal/ui"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -15,19 +21,41 @@ import (
 // InstanceInfo holds display data for a running instance
 type InstanceInfo struct {
 	ID       string
-	Status   string // running, warning, stopped, unknown
+	Status   string // running, warning, stopped, crashed, unknown
 	PID      int
 	Uptime   time.Duration
 	PnL24h   float64
 	Health   int // 0-5
 	HasError bool
+	Outdated bool   // started from a different kronos build than the one running the monitor
+	LogDir   string // where the instance writes its logs
+
+	// Set for crashed instances, which have no monitoring socket left
+	CrashReason live.CrashReason
+	Error       string
+
+	// Set for strategies with a schedule block
+	Schedule *live.ScheduleStatus
 }
 
 // instanceListModel displays all running strategy instances
 type instanceListModel struct {
 	ui.BaseModel      // Embed for common key handling
 	querier           monitoring.ViewQuerier
+	streamer          live.ViewStreamer
+	summaries         live.SummaryQuerier
+	portfolios        live.PortfolioQuerier
+	stateStore        live.StateStore
+	manager           live.InstanceManager
+	history           live.HistoryStore
+	series            live.SeriesStore
+	scheduler         live.Scheduler
+	emergency         live.EmergencyStop
+	trader            live.Trader
+	orders            live.OrderQuerier
+	signals           live.SignalQuerier
 	instances         []InstanceInfo
+	waiting           []*live.ScheduleStatus // scheduled strategies that aren't running
 	cursor            int
 	loading           bool
 	stopping          bool // true when stopping an instance
@@ -37,26 +65,72 @@ type instanceListModel struct {
 	height            int
 	showStopConfirm   bool
 	stopConfirmCursor int // 0 = no, 1 = yes (default to no for safety)
+	stopOptions       live.StopOptions
+	stopResult        *live.StopResult // shown until a key is pressed
+
+	showPanicConfirm   bool
+	panicConfirmCursor int // 0 = no, 1 = yes
+	panicOptions       live.PanicOptions
+	panicking          bool
+	panicReport        *live.PanicReport // shown until a key is pressed
 }
 
+// stopModes is the order the confirmation dialog cycles through
+var stopModes = []live.StopMode{live.StopModeLeave, live.StopModeCancelOrders, live.StopModeFlatten}
+
 // NewInstanceListModel creates a new instance list view
-func NewInstanceListModel(querier monitoring.ViewQuerier) tea.Model {
+func NewInstanceListModel(
+	querier monitoring.ViewQuerier,
+	streamer live.ViewStreamer,
+	summaries live.SummaryQuerier,
+	portfolios live.PortfolioQuerier,
+	stateStore live.StateStore,
+	manager live.InstanceManager,
+	history live.HistoryStore,
+	series live.SeriesStore,
+	scheduler live.Scheduler,
+	emergency live.EmergencyStop,
+	trader live.Trader,
+	orders live.OrderQuerier,
+	signals live.SignalQuerier,
+	cfg *live.SupervisorConfig,
+) tea.Model {
 	return &instanceListModel{
 		BaseModel:         ui.BaseModel{IsRoot: false}, // Let bubblon handle the stack
 		querier:           querier,
+		streamer:          streamer,
+		summaries:         summaries,
+		portfolios:        portfolios,
+		stateStore:        stateStore,
+		manager:           manager,
+		history:           history,
+		series:            series,
+		scheduler:         scheduler,
+		emergency:         emergency,
+		trader:            trader,
+		orders:            orders,
+		signals:           signals,
 		loading:           true,
 		stopConfirmCursor: 0, // Default to "No" for safety
+		stopOptions:       cfg.Stop,
+		panicOptions:      live.PanicOptions{Mode: live.StopModeLeave, DrainTimeout: cfg.Stop.DrainTimeout},
 	}
 }
 
 // Messages
 type instancesLoadedMsg struct {
 	instances []InstanceInfo
+	waiting   []*live.ScheduleStatus
 	err       error
 }
 
 type instanceStoppedMsg struct {
-	err error
+	result *live.StopResult
+	err    error
+}
+
+type panicDoneMsg struct {
+	report *live.PanicReport
 }
 
 type tickMsg time.Time
@@ -82,11 +156,31 @@ func (m *instanceListModel) spinnerTickCmd() tea.Cmd {
 }
 
 func (m *instanceListModel) stopInstance(strategyName string) tea.Cmd {
+	opts := m.stopOptions
 	return func() tea.Msg {
-		// Use HTTP-based shutdown instead of process signals
-		// This sends a POST /shutdown to the monitoring server running inside the process
-		err := m.querier.Shutdown(strategyName)
-		return instanceStoppedMsg{err: err}
+		// Drain through the strategy's control socket so we get its final state back
+		result, err := m.manager.StopStrategy(context.Background(), strategyName, opts)
+		if err == nil {
+			return instanceStoppedMsg{result: result}
+		}
+
+		// Strategies started without a control socket can still be shut down through monitoring,
+		// but they don't report what they left behind
+		if shutdownErr := m.querier.Shutdown(strategyName); shutdownErr != nil {
+			return instanceStoppedMsg{err: fmt.Errorf("%v; monitoring shutdown: %w", err, shutdownErr)}
+		}
+		return instanceStoppedMsg{result: &live.StopResult{
+			StrategyName: strategyName,
+			Errors:       []string{fmt.Sprintf("graceful stop unavailable (%v), stopped through monitoring", err)},
+		}}
+	}
+}
+
+// panicAll stops every instance; the emergency stop reports failures rather than returning them
+func (m *instanceListModel) panicAll() tea.Cmd {
+	opts := m.panicOptions
+	return func() tea.Msg {
+		return panicDoneMsg{report: m.emergency.Panic(context.Background(), opts)}
 	}
 }
 
@@ -97,27 +191,36 @@ func (m *instanceListModel) loadInstances() tea.Cmd {
 			return instancesLoadedMsg{err: err}
 		}
 
+		saved := m.loadSaved()
+		current, _ := binary.Current()
+
+		// Instances are queried all at once, each with its own timeout, so a hung one doesn't stall the list
+		summaries := m.summaries.QueryInstanceSummary(context.Background(), instanceIDs)
+
 		var instances []InstanceInfo
-		for _, id := range instanceIDs {
+		for _, summary := range summaries {
 			info := InstanceInfo{
-				ID:     id,
+				ID:     summary.InstanceID,
 				Status: "unknown",
+				LogDir: logs.InstanceLogDir(summary.InstanceID),
+			}
+
+			if inst := runningByStrategy(saved, summary.InstanceID); inst != nil {
+				info.LogDir = instanceLogDir(inst)
+				info.PID = inst.PID
+				info.Uptime = time.Since(inst.StartedAt)
+				info.Outdated = binary.Outdated(inst, current)
 			}
 
-			// Try to get metrics
-			metrics, err := m.querier.QueryMetrics(id)
-			if err == nil && metrics != nil {
-				info.Status = metrics.Status
+			if summary.Metrics != nil {
+				info.Status = summary.Metrics.Status
 			}
 
-			// Try to get PnL
-			pnl, err := m.querier.QueryPnL(id)
-			if err == nil && pnl != nil {
-				info.PnL24h, _ = pnl.TotalPnL.Float64()
+			if summary.PnL != nil {
+				info.PnL24h, _ = summary.PnL.TotalPnL.Float64()
 			}
 
-			// Health check
-			if err := m.querier.HealthCheck(id); err != nil {
+			if !summary.Healthy {
 				info.Health = 0
 				info.HasError = true
 				if info.Status == "unknown" {
@@ -133,8 +236,105 @@ func (m *instanceListModel) loadInstances() tea.Cmd {
 			instances = append(instances, info)
 		}
 
-		return instancesLoadedMsg{instances: instances}
+		instances = append(instances, crashedInstances(saved, instanceIDs)...)
+		waiting := attachSchedules(instances, m.loadSchedules())
+
+		return instancesLoadedMsg{instances: instances, waiting: waiting}
+	}
+}
+
+// loadSaved reads the supervisor's instance state; the monitor still works from sockets alone without it
+func (m *instanceListModel) loadSaved() []*live.Instance {
+	if m.stateStore == nil {
+		return nil
+	}
+
+	saved, err := m.stateStore.Load()
+	if err != nil {
+		return nil
+	}
+	return saved
+}
+
+// loadSchedules reads where scheduled strategies stand; the monitor works without them
+func (m *instanceListModel) loadSchedules() []*live.ScheduleStatus {
+	if m.scheduler == nil {
+		return nil
+	}
+
+	statuses, err := m.scheduler.Status()
+	if err != nil {
+		return nil
+	}
+	return statuses
+}
+
+// attachSchedules sets the schedule of listed instances and returns those of strategies that aren't listed
+func attachSchedules(instances []InstanceInfo, statuses []*live.ScheduleStatus) []*live.ScheduleStatus {
+	var waiting []*live.ScheduleStatus
+	for _, status := range statuses {
+		listed := false
+		for i := range instances {
+			if instances[i].ID == status.StrategyName {
+				instances[i].Schedule = status
+				listed = true
+			}
+		}
+		if !listed {
+			waiting = append(waiting, status)
+		}
 	}
+	return waiting
+}
+
+// runningByStrategy finds the supervisor's record of a running strategy
+func runningByStrategy(saved []*live.Instance, strategyName string) *live.Instance {
+	for _, inst := range saved {
+		if inst.StrategyName == strategyName && inst.Status == live.StatusRunning {
+			return inst
+		}
+	}
+	return nil
+}
+
+// instanceLogDir is where the spawner and the strategy's log rotator write an instance's logs: under the
+// project root it was started from, which isn't necessarily where the monitor runs
+func instanceLogDir(inst *live.Instance) string {
+	return filepath.Join(inst.FrameworkRoot, logs.InstanceLogDir(inst.StrategyName))
+}
+
+// crashedInstances returns crashed instances from the supervisor state that no longer have a socket
+func crashedInstances(saved []*live.Instance, liveIDs []string) []InstanceInfo {
+	seen := make(map[string]bool, len(liveIDs))
+	for _, id := range liveIDs {
+		seen[id] = true
+	}
+
+	// Keep only the latest crash per strategy
+	latest := make(map[string]*live.Instance)
+	for _, inst := range saved {
+		if inst.Status != live.StatusCrashed || seen[inst.StrategyName] {
+			continue
+		}
+		if prev, ok := latest[inst.StrategyName]; !ok || inst.StartedAt.After(prev.StartedAt) {
+			latest[inst.StrategyName] = inst
+		}
+	}
+
+	crashed := make([]InstanceInfo, 0, len(latest))
+	for name, inst := range latest {
+		crashed = append(crashed, InstanceInfo{
+			ID:          name,
+			Status:      "crashed",
+			HasError:    true,
+			LogDir:      instanceLogDir(inst),
+			CrashReason: inst.CrashReason,
+			Error:       inst.Error,
+		})
+	}
+	sort.Slice(crashed, func(i, j int) bool { return crashed[i].ID < crashed[j].ID })
+
+	return crashed
 }
 
 func (m *instanceListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -149,6 +349,7 @@ func (m *instanceListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.err = msg.err
 		if msg.err == nil {
 			m.instances = msg.instances
+			m.waiting = msg.waiting
 		}
 		return m, nil
 
@@ -161,14 +362,22 @@ func (m *instanceListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.loading = false
 			return m, nil
 		}
-		// Refresh list after successful stop
+		// Keep the final state on screen until a key is pressed, and refresh underneath
+		m.stopResult = msg.result
+		m.loading = true
+		m.err = nil
+		return m, m.loadInstances()
+
+	case panicDoneMsg:
+		m.panicking = false
+		m.panicReport = msg.report
 		m.loading = true
 		m.err = nil
 		return m, m.loadInstances()
 
 	case tickMsg:
 		// Don't refresh while stopping to avoid UI flicker
-		if !m.stopping {
+		if !m.stopping && !m.panicking {
 			return m, tea.Batch(
 				m.loadInstances(),
 				m.tickCmd(),
@@ -178,16 +387,47 @@ func (m *instanceListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case spinnerTickMsg:
 		// Advance spinner animation
-		if m.stopping {
+		if m.stopping || m.panicking {
 			m.spinnerFrame++
 			return m, m.spinnerTickCmd()
 		}
 		return m, nil
 
 	case tea.KeyMsg:
+		// Any key dismisses the stop result or panic report
+		if m.stopResult != nil || m.panicReport != nil {
+			m.stopResult = nil
+			m.panicReport = nil
+			return m, nil
+		}
+
+		if m.showPanicConfirm {
+			switch msg.String() {
+			case "m":
+				m.panicOptions.Mode = nextStopMode(m.panicOptions.Mode)
+			case "left", "h":
+				m.panicConfirmCursor = 0
+			case "right", "l", "tab":
+				m.panicConfirmCursor = 1
+			case "enter":
+				m.showPanicConfirm = false
+				if m.panicConfirmCursor == 1 {
+					m.panicking = true
+					m.spinnerFrame = 0
+					return m, tea.Batch(m.panicAll(), m.spinnerTickCmd())
+				}
+			case "q", "esc":
+				m.showPanicConfirm = false
+			}
+			return m, nil
+		}
+
 		// Handle stop confirmation dialog
 		if m.showStopConfirm {
 			switch msg.String() {
+			case "m":
+				m.stopOptions.Mode = nextStopMode(m.stopOptions.Mode)
+				return m, nil
 			case "left", "h":
 				m.stopConfirmCursor = 0 // No
 				return m, nil
@@ -216,7 +456,7 @@ func (m *instanceListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		// Don't allow navigation while stopping
-		if m.stopping {
+		if m.stopping || m.panicking {
 			return m, nil
 		}
 
@@ -242,7 +482,7 @@ func (m *instanceListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "enter":
 			if len(m.instances) > 0 {
 				selected := m.instances[m.cursor]
-				detailView := NewInstanceDetailModel(m.querier, selected.ID)
+				detailView := NewInstanceDetailModel(m.querier, m.streamer, m.orders, m.signals, m.series, m.trader, selected.ID, selected.LogDir)
 				return m, bubblon.Open(detailView)
 			}
 			return m, nil
@@ -251,9 +491,26 @@ func (m *instanceListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.loading = true
 			return m, m.loadInstances()
 
+		case "h", "H":
+			// Browse past sessions, offering to narrow them down to the selected strategy
+			strategy := ""
+			if len(m.instances) > 0 {
+				strategy = m.instances[m.cursor].ID
+			}
+			return m, bubblon.Open(NewHistoryListModel(m.history, strategy))
+
+		case "p":
+			return m, bubblon.Open(NewPortfolioModel(m.portfolios))
+
+		case "P":
+			// Emergency stop of every instance, behind its own confirmation
+			m.showPanicConfirm = true
+			m.panicConfirmCursor = 0
+			return m, nil
+
 		case "s":
 			// Show stop confirmation for selected instance
-			if len(m.instances) > 0 && m.instances[m.cursor].Status != "stopped" {
+			if len(m.instances) > 0 && m.instances[m.cursor].Status != "stopped" && m.instances[m.cursor].Status != "crashed" {
 				m.showStopConfirm = true
 				m.stopConfirmCursor = 0 // Default to "No"
 			}
@@ -271,7 +528,15 @@ func (m *instanceListModel) View() string {
 	b.WriteString(ui.TitleStyle.Render("MONITOR"))
 	b.WriteString("\n")
 
-	if m.stopping {
+	if m.panicking {
+		spinner := "⠋⠙⠹⠸⠼⠴⠦⠧⠇⠏"
+		spinnerChar := string([]rune(spinner)[m.spinnerFrame%len(spinner)])
+		b.WriteString(ui.StatusErrorStyle.Render(fmt.Sprintf("%s PANIC - stopping every instance...", spinnerChar)))
+		b.WriteString("\n")
+		b.WriteString(ui.SubtitleStyle.Render(fmt.Sprintf("Draining (%s, up to %s), unresponsive instances are killed",
+			m.panicOptions.Mode.Description(), m.panicOptions.DrainTimeout)))
+		b.WriteString("\n")
+	} else if m.stopping {
 		// Show stopping message with spinner
 		if m.cursor < len(m.instances) {
 			selected := m.instances[m.cursor]
@@ -281,7 +546,8 @@ func (m *instanceListModel) View() string {
 			stoppingMsg := fmt.Sprintf("%s Stopping %s...", spinnerChar, selected.ID)
 			b.WriteString(ui.StatusErrorStyle.Render(stoppingMsg))
 			b.WriteString("\n")
-			b.WriteString(ui.SubtitleStyle.Render("Sending shutdown signal (SIGTERM)"))
+			b.WriteString(ui.SubtitleStyle.Render(fmt.Sprintf("Draining (%s, up to %s)",
+				m.stopOptions.Mode.Description(), m.stopOptions.DrainTimeout)))
 			b.WriteString("\n")
 		} else {
 			b.WriteString(ui.SubtitleStyle.Render("Stopping instance..."))
@@ -299,6 +565,26 @@ func (m *instanceListModel) View() string {
 		b.WriteString(m.renderTable())
 	}
 
+	if !m.loading && m.err == nil && len(m.waiting) > 0 {
+		b.WriteString(m.renderWaiting())
+	}
+
+	// Show the final state of the last stopped instance
+	if m.stopResult != nil {
+		b.WriteString("\n\n")
+		b.WriteString(ui.BoxStyle.Width(80).Render(ui.RenderStopResult(m.stopResult)))
+	}
+
+	if m.panicReport != nil {
+		b.WriteString("\n\n")
+		b.WriteString(ui.BoxStyle.Width(80).Render(ui.RenderPanicReport(m.panicReport)))
+	}
+
+	if m.showPanicConfirm {
+		b.WriteString("\n\n")
+		b.WriteString(m.renderPanicConfirmation())
+	}
+
 	// Show stop confirmation dialog if active
 	if m.showStopConfirm && len(m.instances) > 0 {
 		b.WriteString("\n\n")
@@ -307,15 +593,18 @@ func (m *instanceListModel) View() string {
 
 	// Help
 	b.WriteString("\n")
-	if m.showStopConfirm {
-		b.WriteString(ui.HelpStyle.Render("[←→] Select • [Enter] Confirm • [Q/Esc] Cancel"))
-	} else if m.stopping {
+	if m.stopResult != nil || m.panicReport != nil {
+		b.WriteString(ui.HelpStyle.Render("Press any key to continue"))
+	} else if m.showStopConfirm || m.showPanicConfirm {
+		b.WriteString(ui.HelpStyle.Render("[←→] Select • [M] Mode • [Enter] Confirm • [Q/Esc] Cancel"))
+	} else if m.stopping || m.panicking {
 		b.WriteString(ui.HelpStyle.Render("Please wait..."))
 	} else {
 		// Make [S] Stop prominent in red
 		helpStyle := ui.HelpStyle
 		stopKey := ui.StatusErrorStyle.Bold(true).Render("[S]")
-		helpText := fmt.Sprintf("[↑↓] Navigate • [Enter] Details • %s Stop • [R] Refresh • [Q] Back", stopKey)
+		panicKey := ui.StatusErrorStyle.Bold(true).Render("[Shift+P]")
+		helpText := fmt.Sprintf("[↑↓] Navigate • [Enter] Details • %s Stop • %s Panic • [R] Refresh • [H] History • [P] Portfolio • [Q] Back", stopKey, panicKey)
 		b.WriteString(helpStyle.Render(helpText))
 	}
 
@@ -327,7 +616,12 @@ func (m *instanceListModel) renderStopConfirmation() string {
 
 	confirmTitle := ui.StatusErrorStyle.Render("⚠ Stop Strategy Instance?")
 	strategyInfo := ui.SubtitleStyle.Render(fmt.Sprintf("Strategy: %s", selected.ID))
+	mode := ui.SubtitleStyle.Render(fmt.Sprintf("On stop: %s (drain timeout %s)",
+		m.stopOptions.Mode.Description(), m.stopOptions.DrainTimeout))
 	warning := ui.HelpStyle.Render("This will gracefully terminate the running process.")
+	if m.stopOptions.Mode == live.StopModeFlatten {
+		warning = ui.StatusErrorStyle.Render("Open positions will be closed at market.")
+	}
 
 	normalStyle := ui.SubtitleStyle
 	selectedNoStyle := ui.StrategyNameSelectedStyle
@@ -352,6 +646,7 @@ func (m *instanceListModel) renderStopConfirmation() string {
 		confirmTitle,
 		"",
 		strategyInfo,
+		mode,
 		warning,
 		"",
 		buttons,
@@ -360,6 +655,50 @@ func (m *instanceListModel) renderStopConfirmation() string {
 	return ui.BoxStyle.Width(80).Render(content)
 }
 
+func (m *instanceListModel) renderPanicConfirmation() string {
+	confirmTitle := ui.StatusErrorStyle.Render("⚠ PANIC: Stop Every Instance?")
+	scope := ui.SubtitleStyle.Render(fmt.Sprintf("Instances: %d listed, plus any tracked standby", len(m.instances)))
+	mode := ui.SubtitleStyle.Render(fmt.Sprintf("On stop: %s (drain timeout %s)",
+		m.panicOptions.Mode.Description(), m.panicOptions.DrainTimeout))
+	warning := ui.HelpStyle.Render("Instances that don't respond in time are killed.")
+	switch m.panicOptions.Mode {
+	case live.StopModeCancelOrders:
+		warning = ui.StatusErrorStyle.Render("Open orders on every enabled exchange will be cancelled.")
+	case live.StopModeFlatten:
+		warning = ui.StatusErrorStyle.Render("Orders on every enabled exchange will be cancelled and positions closed at market.")
+	}
+
+	noButton := ui.StrategyNameSelectedStyle.Render("[ No, Cancel ]")
+	yesButton := ui.SubtitleStyle.Render("[ Yes, Stop Everything ]")
+	if m.panicConfirmCursor == 1 {
+		noButton = ui.SubtitleStyle.Render("[ No, Cancel ]")
+		yesButton = ui.StatusDangerStyle.Render("[ Yes, Stop Everything ]")
+	}
+
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		confirmTitle,
+		"",
+		scope,
+		mode,
+		warning,
+		"",
+		lipgloss.JoinHorizontal(lipgloss.Left, noButton, "  ", yesButton),
+	)
+
+	return ui.BoxStyle.Width(80).Render(content)
+}
+
+// nextStopMode returns the mode after current in stopModes
+func nextStopMode(current live.StopMode) live.StopMode {
+	for i, mode := range stopModes {
+		if mode == current {
+			return stopModes[(i+1)%len(stopModes)]
+		}
+	}
+	return stopModes[0]
+}
+
 func (m *instanceListModel) renderEmpty() string {
 	box := ui.BoxStyle.Render(
 		lipgloss.JoinVertical(lipgloss.Center,
@@ -418,11 +757,52 @@ func (m *instanceListModel) renderInstanceRow(inst InstanceInfo, selected bool)
 
 	row := fmt.Sprintf("  %s %-4s %-18s %-8s %-10s %-14s %s",
 		icon, statusText, inst.ID, pid, uptime, pnl, health)
+	if inst.Outdated {
+		row += " " + ui.StatusRunningStyle.Render("⚠ outdated binary")
+	}
 
 	if selected {
-		return TableRowSelectedStyle.Render(row)
+		row = TableRowSelectedStyle.Render(row)
+	} else {
+		row = TableRowStyle.Render(row)
+	}
+
+	if inst.Schedule != nil {
+		row += "\n" + ui.HelpStyle.Render("       ⏱ "+scheduleNote(inst.Schedule))
+	}
+
+	if inst.Status == "crashed" {
+		reason := string(inst.CrashReason)
+		if reason == "" {
+			reason = "unknown"
+		}
+		row += "\n" + ui.StatusErrorStyle.Render(fmt.Sprintf("       ↳ %s: %s", reason, inst.Error))
+	}
+
+	return row
+}
+
+// renderWaiting lists scheduled strategies that aren't running, with when they start
+func (m *instanceListModel) renderWaiting() string {
+	var b strings.Builder
+
+	b.WriteString("\n")
+	b.WriteString(TableHeaderStyle.Render("  SCHEDULED"))
+	b.WriteString("\n")
+	for _, status := range m.waiting {
+		b.WriteString(TableRowStyle.Render(fmt.Sprintf("  ⏱ %-18s %s", status.StrategyName, scheduleNote(status))))
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// scheduleNote describes a strategy's next scheduled transition
+func scheduleNote(status *live.ScheduleStatus) string {
+	if status.Error != "" {
+		return "invalid schedule: " + status.Error
 	}
-	return TableRowStyle.Render(row)
+	return "next: " + ui.FormatScheduleNext(status, time.Now())
 }
 
 func formatDuration(d time.Duration) string {
@@ -0,0 +1,266 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/internal/ui"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// portfolioRefresh is how often the portfolio is queried again
+	portfolioRefresh = 5 * time.Second
+
+	// portfolioTimeout bounds one query of every instance
+	portfolioTimeout = 10 * time.Second
+
+	// shareBarWidth is the width of the bars showing each strategy's share of the PnL
+	shareBarWidth = 20
+)
+
+// portfolioModel adds up every running instance: PnL, exposure per asset and exchange, and the largest positions
+type portfolioModel struct {
+	ui.BaseModel
+	portfolios live.PortfolioQuerier
+	portfolio  *live.Portfolio
+	err        error
+}
+
+// NewPortfolioModel creates the portfolio view
+func NewPortfolioModel(portfolios live.PortfolioQuerier) tea.Model {
+	return &portfolioModel{
+		BaseModel:  ui.BaseModel{IsRoot: false},
+		portfolios: portfolios,
+	}
+}
+
+type portfolioLoadedMsg struct {
+	portfolio *live.Portfolio
+	err       error
+}
+
+type portfolioTickMsg time.Time
+
+func (m *portfolioModel) Init() tea.Cmd {
+	return tea.Batch(m.loadPortfolio(), m.tick())
+}
+
+func (m *portfolioModel) tick() tea.Cmd {
+	return tea.Tick(portfolioRefresh, func(t time.Time) tea.Msg {
+		return portfolioTickMsg(t)
+	})
+}
+
+func (m *portfolioModel) loadPortfolio() tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), portfolioTimeout)
+		defer cancel()
+		portfolio, err := m.portfolios.QueryPortfolio(ctx)
+		return portfolioLoadedMsg{portfolio: portfolio, err: err}
+	}
+}
+
+func (m *portfolioModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case portfolioLoadedMsg:
+		m.err = msg.err
+		if msg.err == nil {
+			m.portfolio = msg.portfolio
+		}
+		return m, nil
+
+	case portfolioTickMsg:
+		return m, tea.Batch(m.loadPortfolio(), m.tick())
+
+	case tea.KeyMsg:
+		if handled, cmd := m.BaseModel.HandleCommonKeys(msg); handled {
+			return m, cmd
+		}
+		if msg.String() == "r" {
+			return m, m.loadPortfolio()
+		}
+	}
+	return m, nil
+}
+
+func (m *portfolioModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(ui.TitleStyle.Render("PORTFOLIO"))
+	b.WriteString("\n")
+
+	switch {
+	case m.err != nil:
+		b.WriteString(ui.StatusErrorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+		b.WriteString("\n")
+	case m.portfolio == nil:
+		b.WriteString(ui.SubtitleStyle.Render("Loading portfolio..."))
+		b.WriteString("\n")
+	case len(m.portfolio.Strategies) == 0:
+		b.WriteString(ui.SubtitleStyle.Render("No running instances"))
+		b.WriteString("\n")
+	default:
+		b.WriteString(m.renderTotals())
+		b.WriteString("\n")
+		b.WriteString(m.renderStrategies())
+		b.WriteString("\n")
+		b.WriteString(m.renderExposure())
+		b.WriteString("\n")
+		b.WriteString(m.renderLargest())
+	}
+
+	b.WriteString("\n")
+	b.WriteString(ui.HelpStyle.Render("Positions are valued at each instance's latest fill • [R] Refresh • [Q] Back"))
+
+	return b.String()
+}
+
+func (m *portfolioModel) renderTotals() string {
+	p := m.portfolio
+	return fmt.Sprintf("  Total %s   Realized %s   Unrealized %s   Fees %s   %s\n",
+		FormatPnL(p.TotalPnL),
+		FormatPnL(p.RealizedPnL),
+		FormatPnL(p.UnrealizedPnL),
+		PnLLossStyle.Render(fmt.Sprintf("-$%.2f", p.Fees)),
+		lipgloss.NewStyle().Foreground(ui.ColorMuted).Render(fmt.Sprintf("(%d instances)", len(p.Strategies))),
+	)
+}
+
+func (m *portfolioModel) renderStrategies() string {
+	var b strings.Builder
+
+	b.WriteString(sectionTitle("STRATEGY CONTRIBUTION"))
+	b.WriteString(TableHeaderStyle.Render(fmt.Sprintf("  %-18s %-13s %-13s %-13s %-10s %-5s %s",
+		"STRATEGY", "TOTAL", "REALIZED", "UNREALIZED", "FEES", "POS", "SHARE")))
+	b.WriteString("\n")
+
+	for _, s := range m.portfolio.Strategies {
+		if s.Error != "" {
+			b.WriteString(fmt.Sprintf("  %-18s %s\n", s.InstanceID, PnLLossStyle.Render("unavailable: "+s.Error)))
+			continue
+		}
+		b.WriteString(fmt.Sprintf("  %-18s %s %s %s %-10s %-5d %s\n",
+			s.InstanceID,
+			padCell(FormatPnL(s.TotalPnL), 13),
+			padCell(FormatPnL(s.RealizedPnL), 13),
+			padCell(FormatPnL(s.UnrealizedPnL), 13),
+			fmt.Sprintf("$%.2f", s.Fees),
+			s.Positions,
+			shareBar(s.Share),
+		))
+	}
+	return b.String()
+}
+
+func (m *portfolioModel) renderExposure() string {
+	var b strings.Builder
+
+	b.WriteString(sectionTitle("NET EXPOSURE BY ASSET"))
+	if len(m.portfolio.Assets) == 0 {
+		b.WriteString(ui.SubtitleStyle.Render("  No open positions"))
+		b.WriteString("\n")
+		return b.String()
+	}
+	b.WriteString(TableHeaderStyle.Render(fmt.Sprintf("  %-12s %-16s %-16s %s", "ASSET", "QUANTITY", "NET", "GROSS")))
+	b.WriteString("\n")
+	for _, asset := range m.portfolio.Assets {
+		b.WriteString(fmt.Sprintf("  %-12s %s %s %s\n",
+			asset.Asset,
+			padCell(formatQuantity(asset.Quantity), 16),
+			padCell(formatNotional(asset.Notional), 16),
+			fmt.Sprintf("$%.2f", asset.Gross),
+		))
+	}
+
+	b.WriteString("\n")
+	b.WriteString(sectionTitle("NET EXPOSURE BY EXCHANGE"))
+	b.WriteString(TableHeaderStyle.Render(fmt.Sprintf("  %-12s %-16s %-16s %s", "EXCHANGE", "NET", "GROSS", "POSITIONS")))
+	b.WriteString("\n")
+	for _, exchange := range m.portfolio.Exchanges {
+		b.WriteString(fmt.Sprintf("  %-12s %s %-16s %d\n",
+			exchange.Exchange,
+			padCell(formatNotional(exchange.Net), 16),
+			fmt.Sprintf("$%.2f", exchange.Gross),
+			exchange.Positions,
+		))
+	}
+	return b.String()
+}
+
+func (m *portfolioModel) renderLargest() string {
+	var b strings.Builder
+
+	b.WriteString(sectionTitle("LARGEST POSITIONS"))
+	if len(m.portfolio.Largest) == 0 {
+		b.WriteString(ui.SubtitleStyle.Render("  No open positions"))
+		b.WriteString("\n")
+		return b.String()
+	}
+	b.WriteString(TableHeaderStyle.Render(fmt.Sprintf("  %-18s %-12s %-12s %-14s %-12s %s",
+		"STRATEGY", "EXCHANGE", "ASSET", "QUANTITY", "PRICE", "NOTIONAL")))
+	b.WriteString("\n")
+	for _, holding := range m.portfolio.Largest {
+		b.WriteString(fmt.Sprintf("  %-18s %-12s %-12s %s %-12s %s\n",
+			holding.InstanceID,
+			holding.Exchange,
+			holding.Asset,
+			padCell(formatQuantity(holding.Quantity), 14),
+			fmt.Sprintf("%.2f", holding.Price),
+			formatNotional(holding.Notional),
+		))
+	}
+	return b.String()
+}
+
+func sectionTitle(title string) string {
+	return ui.StrategyNameStyle.Render(title) + "\n" +
+		lipgloss.NewStyle().Foreground(ui.ColorMuted).Render(strings.Repeat("─", 90)) + "\n"
+}
+
+// padCell pads a styled cell to width visible columns, which fmt widths can't do around escape codes
+func padCell(cell string, width int) string {
+	return cell + strings.Repeat(" ", max(width-lipgloss.Width(cell), 0))
+}
+
+// formatQuantity shows long quantities green and short ones red
+func formatQuantity(quantity float64) string {
+	text := fmt.Sprintf("%+.4f", quantity)
+	switch {
+	case quantity > 0:
+		return PnLProfitStyle.Render(text)
+	case quantity < 0:
+		return PnLLossStyle.Render(text)
+	}
+	return PnLNeutralStyle.Render(text)
+}
+
+// formatNotional shows signed exposure in quote currency
+func formatNotional(notional float64) string {
+	sign := "+"
+	style := PnLProfitStyle
+	switch {
+	case notional < 0:
+		sign, style = "-", PnLLossStyle
+	case notional == 0:
+		sign, style = "", PnLNeutralStyle
+	}
+	return style.Render(fmt.Sprintf("%s$%.2f", sign, math.Abs(notional)))
+}
+
+// shareBar draws a strategy's share of the PnL, green for gains and red for losses
+func shareBar(share float64) string {
+	filled := int(math.Round(math.Abs(share) * shareBarWidth))
+	style := PnLProfitStyle
+	if share < 0 {
+		style = PnLLossStyle
+	}
+	bar := style.Render(strings.Repeat("█", filled)) +
+		lipgloss.NewStyle().Foreground(ui.ColorMuted).Render(strings.Repeat("░", shareBarWidth-filled))
+	return fmt.Sprintf("%s %+.0f%%", bar, share*100)
+}
@@ -96,7 +96,7 @@ func GetStatusIcon(status string) string {
 		return IconRunning
 	case "warning":
 		return IconWarning
-	case "stopped":
+	case "stopped", "crashed":
 		return IconStopped
 	default:
 		return IconUnknown
@@ -112,6 +112,8 @@ func GetStatusStyle(status string) lipgloss.Style {
 		return ui.StatusRunningStyle
 	case "stopped":
 		return ui.StatusDangerStyle
+	case "crashed":
+		return ui.StatusErrorStyle
 	default:
 		return lipgloss.NewStyle().Foreground(ui.ColorMuted)
 	}
@@ -0,0 +1,331 @@
+package tabs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/logs"
+	"github.com/backtesting-org/kronos-cli/internal/ui"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Log view styles
+var (
+	logDebugStyle = lipgloss.NewStyle().Foreground(ui.ColorMuted)
+	logInfoStyle  = lipgloss.NewStyle().Foreground(ui.ColorPrimary)
+	logWarnStyle  = lipgloss.NewStyle().Foreground(ui.ColorWarning).Bold(true)
+	logErrorStyle = lipgloss.NewStyle().Foreground(ui.ColorDanger).Bold(true)
+	logFieldStyle = lipgloss.NewStyle().Foreground(ui.ColorMuted)
+)
+
+// logLevelFilters is the cycle order for the level filter
+var logLevelFilters = []logs.Level{logs.LevelUnknown, logs.LevelDebug, logs.LevelInfo, logs.LevelWarn, logs.LevelError}
+
+// LogsModel is a tab that tails the instance's stdout/stderr logs
+type LogsModel struct {
+	logDir  string
+	stream  logs.Stream
+	entries []logs.Entry
+	limit   int
+	height  int
+	loading bool
+	err     error
+
+	// Filtering
+	levelIndex int
+	search     string
+	searching  bool
+	input      string
+
+	// Scrolling - offset counts lines up from the bottom
+	follow bool
+	offset int
+}
+
+// NewLogsModel creates a new logs tab reading the instance logs in logDir
+func NewLogsModel(logDir string) *LogsModel {
+	return &LogsModel{
+		logDir:  logDir,
+		stream:  logs.StreamStderr, // the SDK's zap logger writes to stderr
+		limit:   1000,
+		height:  20,
+		loading: true,
+		follow:  true,
+	}
+}
+
+// Logs messages
+type logsDataMsg struct {
+	stream  logs.Stream
+	entries []logs.Entry
+	err     error
+}
+
+type logsTickMsg time.Time
+
+func (m *LogsModel) Init() tea.Cmd {
+	return tea.Batch(
+		m.fetchData(),
+		m.tick(),
+	)
+}
+
+func (m *LogsModel) tick() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return logsTickMsg(t)
+	})
+}
+
+func (m *LogsModel) fetchData() tea.Cmd {
+	stream := m.stream
+	return func() tea.Msg {
+		entries, err := logs.Tail(logs.StreamPath(m.logDir, stream), m.limit)
+		return logsDataMsg{stream: stream, entries: entries, err: err}
+	}
+}
+
+// Capturing reports whether the tab is consuming raw key input (search prompt open)
+func (m *LogsModel) Capturing() bool {
+	return m.searching
+}
+
+func (m *LogsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		// Leave room for header, tabs, filter bar and help
+		m.height = msg.Height - 12
+		if m.height < 5 {
+			m.height = 5
+		}
+		return m, nil
+
+	case logsDataMsg:
+		// Ignore stale results from before a stream switch
+		if msg.stream != m.stream {
+			return m, nil
+		}
+		m.loading = false
+		m.err = msg.err
+		m.entries = msg.entries
+		return m, nil
+
+	case logsTickMsg:
+		if m.follow {
+			return m, tea.Batch(m.fetchData(), m.tick())
+		}
+		return m, m.tick()
+
+	case tea.KeyMsg:
+		if m.searching {
+			return m, m.updateSearch(msg)
+		}
+
+		switch msg.String() {
+		case "/":
+			m.searching = true
+			m.input = m.search
+			return m, nil
+
+		case "v":
+			m.levelIndex = (m.levelIndex + 1) % len(logLevelFilters)
+			m.offset = 0
+			return m, nil
+
+		case "s":
+			if m.stream == logs.StreamStderr {
+				m.stream = logs.StreamStdout
+			} else {
+				m.stream = logs.StreamStderr
+			}
+			m.loading = true
+			m.entries = nil
+			m.offset = 0
+			return m, m.fetchData()
+
+		case "f":
+			m.follow = !m.follow
+			if m.follow {
+				m.offset = 0
+				return m, m.fetchData()
+			}
+			return m, nil
+
+		case "up", "k":
+			m.scroll(1)
+			return m, nil
+
+		case "down", "j":
+			m.scroll(-1)
+			return m, nil
+
+		case "pgup":
+			m.scroll(m.height)
+			return m, nil
+
+		case "pgdown":
+			m.scroll(-m.height)
+			return m, nil
+
+		case "r":
+			m.loading = true
+			return m, m.fetchData()
+		}
+	}
+	return m, nil
+}
+
+func (m *LogsModel) updateSearch(msg tea.KeyMsg) tea.Cmd {
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.search = m.input
+		m.searching = false
+		m.offset = 0
+	case tea.KeyEsc:
+		m.searching = false
+	case tea.KeyBackspace:
+		if len(m.input) > 0 {
+			runes := []rune(m.input)
+			m.input = string(runes[:len(runes)-1])
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		m.input += string(msg.Runes)
+	}
+	return nil
+}
+
+// scroll moves the view by delta lines (positive = back in time); scrolling up pauses follow mode
+func (m *LogsModel) scroll(delta int) {
+	visible := m.filtered()
+	maxOffset := len(visible) - m.height
+	if maxOffset < 0 {
+		maxOffset = 0
+	}
+
+	m.offset += delta
+	if m.offset > maxOffset {
+		m.offset = maxOffset
+	}
+	if m.offset < 0 {
+		m.offset = 0
+	}
+
+	if m.offset > 0 {
+		m.follow = false
+	}
+}
+
+func (m *LogsModel) filtered() []logs.Entry {
+	minLevel := logLevelFilters[m.levelIndex]
+	if minLevel == logs.LevelUnknown && m.search == "" {
+		return m.entries
+	}
+
+	var out []logs.Entry
+	for _, entry := range m.entries {
+		if entry.Matches(minLevel, m.search) {
+			out = append(out, entry)
+		}
+	}
+	return out
+}
+
+func (m *LogsModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(ui.StrategyNameStyle.Render(fmt.Sprintf("LOGS (%s)", m.stream)))
+	b.WriteString("\n")
+	b.WriteString(m.renderFilterBar())
+	b.WriteString("\n\n")
+
+	if m.loading && len(m.entries) == 0 {
+		b.WriteString(ui.SubtitleStyle.Render("Loading logs..."))
+		return b.String()
+	}
+
+	if m.err != nil {
+		b.WriteString(ui.StatusErrorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+		return b.String()
+	}
+
+	visible := m.filtered()
+	if len(visible) == 0 {
+		b.WriteString(ui.SubtitleStyle.Render(fmt.Sprintf("No log lines in %s", logs.StreamPath(m.logDir, m.stream))))
+		return b.String()
+	}
+
+	end := len(visible) - m.offset
+	start := end - m.height
+	if start < 0 {
+		start = 0
+	}
+
+	for _, entry := range visible[start:end] {
+		b.WriteString(m.renderEntry(entry))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(ui.HelpStyle.Render("[/] Search • [V] Level • [F] Follow • [S] stdout/stderr • [↑↓/PgUp/PgDn] Scroll"))
+
+	return b.String()
+}
+
+func (m *LogsModel) renderFilterBar() string {
+	level := "ALL"
+	if minLevel := logLevelFilters[m.levelIndex]; minLevel != logs.LevelUnknown {
+		level = minLevel.String() + "+"
+	}
+
+	follow := neutralStyle.Render("paused")
+	if m.follow {
+		follow = liveStyle.Render("● following")
+	}
+
+	search := m.search
+	if m.searching {
+		search = m.input + "█"
+	}
+	if search == "" {
+		search = "-"
+	}
+
+	return ui.SubtitleStyle.Render(fmt.Sprintf("Level: %s  Search: %s  ", level, search)) + follow
+}
+
+func (m *LogsModel) renderEntry(entry logs.Entry) string {
+	if !entry.Structured {
+		return levelStyle(entry.Level).Render(entry.Raw)
+	}
+
+	timeStr := "        "
+	if !entry.Time.IsZero() {
+		timeStr = entry.Time.Local().Format("15:04:05")
+	}
+
+	line := fmt.Sprintf("%s %s %s",
+		timeStr,
+		levelStyle(entry.Level).Render(fmt.Sprintf("%-5s", entry.Level)),
+		entry.Message,
+	)
+	if fields := entry.FormatFields(); fields != "" {
+		line += " " + logFieldStyle.Render(fields)
+	}
+	return line
+}
+
+func levelStyle(level logs.Level) lipgloss.Style {
+	switch level {
+	case logs.LevelDebug:
+		return logDebugStyle
+	case logs.LevelWarn:
+		return logWarnStyle
+	case logs.LevelError:
+		return logErrorStyle
+	case logs.LevelInfo:
+		return logInfoStyle
+	default:
+		return lipgloss.NewStyle()
+	}
+}
@@ -0,0 +1,412 @@
+package tabs
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/backtesting-org/kronos-cli/internal/ui"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// closeFractions is the order the close dialog cycles the share of the position through
+var closeFractions = []float64{1, 0.5, 0.25}
+
+// Operator takes trading actions on an instance for the Positions, Orderbook and Orders tabs, each behind a
+// confirmation like the monitor's stop dialog. The tabs share one so they agree on whether signals are paused.
+type Operator struct {
+	trader     live.Trader
+	querier    monitoring.ViewQuerier
+	instanceID string
+
+	// The action waiting for confirmation, nil when no dialog is open
+	pending *live.TradingAction
+	cursor  int // 0 = no, 1 = yes (default to no for safety)
+
+	// Set by the close dialog once the position and book are loaded
+	position   *live.NetPosition
+	limitPrice float64 // the near side of the book the position is closed into, 0 if unknown
+	limit      bool
+	loadErr    error
+
+	running bool
+	result  *live.ActionResult // shown until a key is pressed
+	err     error
+
+	paused bool
+}
+
+// NewOperator creates the operator of an instance
+func NewOperator(trader live.Trader, querier monitoring.ViewQuerier, instanceID string) *Operator {
+	return &Operator{
+		trader:     trader,
+		querier:    querier,
+		instanceID: instanceID,
+	}
+}
+
+// Operator messages
+type actionDoneMsg struct {
+	operator *Operator
+	result   *live.ActionResult
+	err      error
+}
+
+type pauseStateMsg struct {
+	operator *Operator
+	paused   bool
+	err      error
+}
+
+type closeTargetMsg struct {
+	operator   *Operator
+	action     live.TradingAction
+	position   *live.NetPosition
+	limitPrice float64
+	err        error
+}
+
+// Init loads whether the instance is paused
+func (o *Operator) Init() tea.Cmd {
+	return func() tea.Msg {
+		paused, err := o.trader.Paused(o.instanceID)
+		return pauseStateMsg{operator: o, paused: paused, err: err}
+	}
+}
+
+// Paused reports whether signal execution is known to be paused
+func (o *Operator) Paused() bool {
+	return o.paused
+}
+
+// Capturing reports whether the operator takes every key: a dialog or a result is on screen, or an action runs
+func (o *Operator) Capturing() bool {
+	return o.pending != nil || o.result != nil || o.err != nil || o.running
+}
+
+// CancelOrders asks to cancel the open orders of an asset
+func (o *Operator) CancelOrders(exchange, asset string) {
+	o.open(live.TradingAction{Type: live.ActionCancelOrders, Exchange: exchange, Asset: asset})
+}
+
+// CancelOrder asks to cancel a single working order
+func (o *Operator) CancelOrder(exchange, asset, orderID string) {
+	o.open(live.TradingAction{Type: live.ActionCancelOrders, Exchange: exchange, Asset: asset, OrderIDs: []string{orderID}})
+}
+
+// ClosePosition asks to close or reduce the position in an asset; the position and the book are loaded first
+func (o *Operator) ClosePosition(exchange, asset string) tea.Cmd {
+	action := live.TradingAction{Type: live.ActionClosePosition, Exchange: exchange, Asset: asset, Fraction: closeFractions[0]}
+	o.open(action)
+	return o.loadCloseTarget(action)
+}
+
+// TogglePause asks to pause signal execution, or to resume it when paused
+func (o *Operator) TogglePause() {
+	if o.paused {
+		o.open(live.TradingAction{Type: live.ActionResume})
+		return
+	}
+	o.open(live.TradingAction{Type: live.ActionPause})
+}
+
+func (o *Operator) open(action live.TradingAction) {
+	o.pending = &action
+	o.cursor = 0
+	o.position = nil
+	o.limitPrice = 0
+	o.limit = false
+	o.loadErr = nil
+}
+
+// loadCloseTarget reads the net position from the instance's trades and the best prices from its book
+func (o *Operator) loadCloseTarget(action live.TradingAction) tea.Cmd {
+	return func() tea.Msg {
+		positions, err := o.querier.QueryPositions(o.instanceID)
+		if err != nil {
+			return closeTargetMsg{operator: o, action: action, err: err}
+		}
+
+		msg := closeTargetMsg{operator: o, action: action}
+		for _, position := range live.NetPositions(positions) {
+			if position.Exchange == action.Exchange && position.Symbol == action.Asset {
+				msg.position = &position
+				break
+			}
+		}
+		if msg.position == nil {
+			msg.err = fmt.Errorf("no open %s position on %s", action.Asset, action.Exchange)
+			return msg
+		}
+
+		// Without a book the position can still be closed at market
+		if book, err := o.querier.QueryOrderbook(o.instanceID, action.Asset, action.Exchange); err == nil {
+			msg.limitPrice = nearPrice(book, msg.position.Quantity)
+		}
+		return msg
+	}
+}
+
+// nearPrice is the best price on the side of the book a closing order joins: the ask when selling a long,
+// the bid when buying back a short
+func nearPrice(book *connector.OrderBook, quantity numerical.Decimal) float64 {
+	if book == nil {
+		return 0
+	}
+	levels := book.Asks
+	if quantity.IsNegative() {
+		levels = book.Bids
+	}
+	if len(levels) == 0 {
+		return 0
+	}
+	price, _ := levels[0].Price.Float64()
+	return price
+}
+
+// Update handles the operator's messages and, while it is capturing, keys. It reports whether msg was its own.
+func (o *Operator) Update(msg tea.Msg) (bool, tea.Cmd) {
+	switch msg := msg.(type) {
+	case pauseStateMsg:
+		if msg.operator != o {
+			return false, nil
+		}
+		if msg.err == nil {
+			o.paused = msg.paused
+		}
+		return true, nil
+
+	case closeTargetMsg:
+		if msg.operator != o {
+			return false, nil
+		}
+		// Ignore a load for a dialog that was closed or replaced meanwhile
+		if o.pending == nil || o.pending.Type != live.ActionClosePosition ||
+			o.pending.Exchange != msg.action.Exchange || o.pending.Asset != msg.action.Asset {
+			return true, nil
+		}
+		o.position = msg.position
+		o.limitPrice = msg.limitPrice
+		o.loadErr = msg.err
+		return true, nil
+
+	case actionDoneMsg:
+		if msg.operator != o {
+			return false, nil
+		}
+		o.running = false
+		o.result = msg.result
+		o.err = msg.err
+		if msg.result != nil {
+			o.paused = msg.result.Paused
+		}
+		return true, nil
+
+	case tea.KeyMsg:
+		if !o.Capturing() {
+			return false, nil
+		}
+		return true, o.handleKey(msg)
+	}
+	return false, nil
+}
+
+func (o *Operator) handleKey(msg tea.KeyMsg) tea.Cmd {
+	if o.running {
+		return nil
+	}
+
+	// Any key dismisses the result
+	if o.result != nil || o.err != nil {
+		o.result = nil
+		o.err = nil
+		return nil
+	}
+
+	switch msg.String() {
+	case "left", "h":
+		o.cursor = 0
+	case "right", "l", "tab":
+		o.cursor = 1
+	case "s":
+		if o.pending.Type == live.ActionClosePosition {
+			o.pending.Fraction = nextFraction(o.pending.Fraction)
+		}
+	case "t":
+		if o.pending.Type == live.ActionClosePosition && o.limitPrice > 0 {
+			o.limit = !o.limit
+		}
+	case "enter":
+		if o.cursor == 1 && o.ready() {
+			action := *o.pending
+			if action.Type == live.ActionClosePosition && o.limit {
+				action.Price = o.limitPrice
+			}
+			o.pending = nil
+			o.running = true
+			return o.act(action)
+		}
+		if o.cursor == 0 {
+			o.pending = nil
+		}
+	case "q", "esc":
+		o.pending = nil
+	}
+	return nil
+}
+
+// ready reports whether the pending action can be confirmed; closing waits for the position to load
+func (o *Operator) ready() bool {
+	if o.pending.Type != live.ActionClosePosition {
+		return true
+	}
+	return o.position != nil && o.loadErr == nil
+}
+
+func (o *Operator) act(action live.TradingAction) tea.Cmd {
+	return func() tea.Msg {
+		result, err := o.trader.Act(context.Background(), o.instanceID, action)
+		return actionDoneMsg{operator: o, result: result, err: err}
+	}
+}
+
+// nextFraction returns the fraction after current in closeFractions
+func nextFraction(current float64) float64 {
+	for i, fraction := range closeFractions {
+		if fraction == current {
+			return closeFractions[(i+1)%len(closeFractions)]
+		}
+	}
+	return closeFractions[0]
+}
+
+// View renders the dialog, the running action or its result; it is empty when there is none
+func (o *Operator) View() string {
+	switch {
+	case o.running:
+		return ui.SubtitleStyle.Render("Waiting for the instance to act...")
+	case o.err != nil:
+		return ui.BoxStyle.Width(80).Render(ui.StatusErrorStyle.Render(fmt.Sprintf("✗ %v", o.err)))
+	case o.result != nil:
+		return ui.BoxStyle.Width(80).Render(ui.RenderActionResult(o.result))
+	case o.pending != nil:
+		return o.renderConfirmation()
+	}
+	return ""
+}
+
+// Help is the key help while the operator is capturing
+func (o *Operator) Help() string {
+	switch {
+	case o.running:
+		return "Please wait..."
+	case o.result != nil || o.err != nil:
+		return "Press any key to continue"
+	case o.pending != nil && o.pending.Type == live.ActionClosePosition:
+		return "[←→] Select • [S] Size • [T] Market/Limit • [Enter] Confirm • [Q/Esc] Cancel"
+	}
+	return "[←→] Select • [Enter] Confirm • [Q/Esc] Cancel"
+}
+
+func (o *Operator) renderConfirmation() string {
+	action := o.pending
+
+	var title, confirm string
+	var details []string
+	warning := ui.HelpStyle.Render("The action is recorded in the event log.")
+
+	switch action.Type {
+	case live.ActionCancelOrders:
+		if len(action.OrderIDs) == 1 {
+			title = fmt.Sprintf("⚠ Cancel %s Order?", action.Asset)
+			confirm = "[ Yes, Cancel Order ]"
+			details = append(details, fmt.Sprintf("Order %s of this instance on %s is cancelled.", action.OrderIDs[0], action.Exchange))
+			break
+		}
+		title = fmt.Sprintf("⚠ Cancel %s Orders?", action.Asset)
+		confirm = "[ Yes, Cancel Orders ]"
+		details = append(details, fmt.Sprintf("Every open %s order of this instance on %s is cancelled.", action.Asset, action.Exchange))
+	case live.ActionClosePosition:
+		title = fmt.Sprintf("⚠ Close %s Position?", action.Asset)
+		confirm = "[ Yes, Place Order ]"
+		details = o.closeDetails()
+		if !o.limit {
+			warning = ui.StatusErrorStyle.Render("The order is placed at market and crosses the spread.")
+		}
+	case live.ActionPause:
+		title = "⚠ Pause Signal Execution?"
+		confirm = "[ Yes, Pause ]"
+		details = append(details,
+			"The strategy keeps running and generating signals, but none is executed until resumed.",
+			"Open orders and positions are left as they are.")
+	case live.ActionResume:
+		title = "Resume Signal Execution?"
+		confirm = "[ Yes, Resume ]"
+		details = append(details, "Signals are executed again from the next one the strategy generates.")
+	}
+
+	noButton := ui.StrategyNameSelectedStyle.Render("[ No, Cancel ]")
+	yesButton := ui.SubtitleStyle.Render(confirm)
+	if o.cursor == 1 {
+		noButton = ui.SubtitleStyle.Render("[ No, Cancel ]")
+		yesButton = ui.StatusDangerStyle.Render(confirm)
+	}
+
+	lines := []string{ui.StatusErrorStyle.Render(title), "", ui.SubtitleStyle.Render(fmt.Sprintf("Strategy: %s", o.instanceID))}
+	for _, detail := range details {
+		lines = append(lines, ui.SubtitleStyle.Render(detail))
+	}
+	lines = append(lines, warning, "", lipgloss.JoinHorizontal(lipgloss.Left, noButton, "  ", yesButton))
+
+	return ui.BoxStyle.Width(80).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
+func (o *Operator) closeDetails() []string {
+	action := o.pending
+	switch {
+	case o.loadErr != nil:
+		return []string{fmt.Sprintf("Can't close: %v", o.loadErr)}
+	case o.position == nil:
+		return []string{fmt.Sprintf("Loading the %s position on %s...", action.Asset, action.Exchange)}
+	}
+
+	quantity, _ := o.position.Quantity.Float64()
+	side := "Sell"
+	if quantity < 0 {
+		side = "Buy back"
+	}
+
+	order := "Order:    market"
+	switch {
+	case o.limit:
+		order = fmt.Sprintf("Order:    limit at %.2f, the best %s", o.limitPrice, nearSide(quantity))
+	case o.limitPrice == 0:
+		order = "Order:    market (no book to place a limit from)"
+	}
+
+	return []string{
+		fmt.Sprintf("Position: %+.4f %s on %s", quantity, action.Asset, action.Exchange),
+		fmt.Sprintf("Size:     %s %.0f%% (%.4f)", side, action.Fraction*100, math.Abs(quantity)*action.Fraction),
+		order,
+	}
+}
+
+func nearSide(quantity float64) string {
+	if quantity < 0 {
+		return "bid"
+	}
+	return "ask"
+}
+
+// pausedBadge marks a paused instance in the tabs it can be resumed from
+func pausedBadge(o *Operator) string {
+	if !o.Paused() {
+		return ""
+	}
+	return "  " + lipgloss.NewStyle().Foreground(ui.ColorWarning).Bold(true).Render("⏸ SIGNALS PAUSED")
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/backtesting-org/kronos-cli/internal/ui"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
 	tea "github.com/charmbracelet/bubbletea"
@@ -27,9 +28,12 @@ var (
 			Bold(true)
 )
 
-// OrderbookModel is a tab that displays live orderbook data
+// OrderbookModel is a tab that displays live orderbook data and acts on the selected asset
 type OrderbookModel struct {
 	querier    monitoring.ViewQuerier
+	feed       *Feed
+	operator   *Operator
+	sub        *subscription // for the selected asset, nil while polling
 	instanceID string
 	depth      int
 	orderbook  *connector.OrderBook
@@ -49,9 +53,11 @@ type OrderbookModel struct {
 }
 
 // NewOrderbookModel creates a new orderbook tab
-func NewOrderbookModel(querier monitoring.ViewQuerier, instanceID string) *OrderbookModel {
+func NewOrderbookModel(querier monitoring.ViewQuerier, feed *Feed, operator *Operator, instanceID string) *OrderbookModel {
 	return &OrderbookModel{
 		querier:         querier,
+		feed:            feed,
+		operator:        operator,
 		instanceID:      instanceID,
 		depth:           10,
 		loading:         true,
@@ -112,13 +118,37 @@ func (m *OrderbookModel) fetchData() tea.Cmd {
 	}
 }
 
+// subscribeSelected drops the subscription of the previously selected asset and subscribes to the selected one
+func (m *OrderbookModel) subscribeSelected() tea.Cmd {
+	m.sub.close()
+	m.sub = nil
+	if len(m.availableAssets) == 0 {
+		return nil
+	}
+	selected := m.availableAssets[m.selectedIndex]
+	return m.feed.subscribe(m, live.StreamRequest{
+		Topics:   []live.StreamTopic{live.StreamOrderbook},
+		Asset:    selected.Asset,
+		Exchange: selected.Exchange,
+	})
+}
+
+// isSelected reports whether a subscription opened with req is for the selected asset
+func (m *OrderbookModel) isSelected(req live.StreamRequest) bool {
+	if len(m.availableAssets) == 0 {
+		return false
+	}
+	selected := m.availableAssets[m.selectedIndex]
+	return req.Asset == selected.Asset && req.Exchange == selected.Exchange
+}
+
 func (m *OrderbookModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case orderbookAssetsMsg:
 		if msg.err == nil && len(msg.assets) > 0 {
 			m.availableAssets = msg.assets
 			m.selectedIndex = 0
-			return m, m.fetchData()
+			return m, tea.Batch(m.fetchData(), m.subscribeSelected())
 		}
 		m.loading = false
 		m.err = msg.err
@@ -128,23 +158,33 @@ func (m *OrderbookModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.loading = false
 		m.err = msg.err
 		if msg.err == nil && msg.orderbook != nil {
-			// Check if data actually changed
-			changed := m.hasDataChanged(msg.orderbook)
-			m.orderbook = msg.orderbook
-			m.lastUpdate = time.Now()
-			m.updateCount++
-
-			if changed {
-				m.showPulse = true
-				// Update last prices
-				if len(msg.orderbook.Bids) > 0 {
-					m.lastBestBid, _ = msg.orderbook.Bids[0].Price.Float64()
-				}
-				if len(msg.orderbook.Asks) > 0 {
-					m.lastBestAsk, _ = msg.orderbook.Asks[0].Price.Float64()
-				}
-				return m, m.pulseOff()
-			}
+			return m, m.applyOrderbook(msg.orderbook)
+		}
+		return m, nil
+
+	case streamOpenedMsg:
+		if msg.owner != tea.Model(m) || msg.err != nil {
+			return m, nil
+		}
+		if !m.isSelected(msg.req) {
+			// Opened for an asset that was switched away from meanwhile
+			msg.sub.close()
+			return m, nil
+		}
+		m.sub = msg.sub
+		return m, m.sub.next()
+
+	case streamUpdateMsg:
+		if msg.sub != m.sub || msg.update.Orderbook == nil {
+			return m, nil
+		}
+		m.loading = false
+		m.err = nil
+		return m, tea.Batch(m.applyOrderbook(msg.update.Orderbook), m.sub.next())
+
+	case streamClosedMsg:
+		if msg.sub == m.sub {
+			m.sub = nil
 		}
 		return m, nil
 
@@ -153,7 +193,7 @@ func (m *OrderbookModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case orderbookTickMsg:
-		if len(m.availableAssets) > 0 {
+		if len(m.availableAssets) > 0 && m.sub == nil {
 			return m, tea.Batch(m.fetchData(), m.tick())
 		}
 		return m, m.tick()
@@ -177,7 +217,7 @@ func (m *OrderbookModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.loading = true
 				m.orderbook = nil
 				m.updateCount = 0
-				return m, m.fetchData()
+				return m, tea.Batch(m.fetchData(), m.subscribeSelected())
 			}
 			return m, nil
 
@@ -190,7 +230,7 @@ func (m *OrderbookModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.loading = true
 				m.orderbook = nil
 				m.updateCount = 0
-				return m, m.fetchData()
+				return m, tea.Batch(m.fetchData(), m.subscribeSelected())
 			}
 			return m, nil
 
@@ -198,11 +238,52 @@ func (m *OrderbookModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.loading = true
 			m.err = nil
 			return m, tea.Batch(m.fetchAssets(), m.fetchData())
+
+		case "c":
+			if len(m.availableAssets) > 0 {
+				selected := m.availableAssets[m.selectedIndex]
+				return m, m.operator.ClosePosition(selected.Exchange, selected.Asset)
+			}
+			return m, nil
+
+		case "x":
+			if len(m.availableAssets) > 0 {
+				selected := m.availableAssets[m.selectedIndex]
+				m.operator.CancelOrders(selected.Exchange, selected.Asset)
+			}
+			return m, nil
+
+		case " ":
+			m.operator.TogglePause()
+			return m, nil
 		}
 	}
 	return m, nil
 }
 
+// applyOrderbook shows a new snapshot, pulsing the live indicator if the best prices moved
+func (m *OrderbookModel) applyOrderbook(orderbook *connector.OrderBook) tea.Cmd {
+	// Check if data actually changed
+	changed := m.hasDataChanged(orderbook)
+	m.orderbook = orderbook
+	m.lastUpdate = time.Now()
+	m.updateCount++
+
+	if !changed {
+		return nil
+	}
+
+	m.showPulse = true
+	// Update last prices
+	if len(orderbook.Bids) > 0 {
+		m.lastBestBid, _ = orderbook.Bids[0].Price.Float64()
+	}
+	if len(orderbook.Asks) > 0 {
+		m.lastBestAsk, _ = orderbook.Asks[0].Price.Float64()
+	}
+	return m.pulseOff()
+}
+
 // hasDataChanged checks if the orderbook data has meaningfully changed
 func (m *OrderbookModel) hasDataChanged(newOB *connector.OrderBook) bool {
 	if m.orderbook == nil {
@@ -274,6 +355,7 @@ func (m *OrderbookModel) renderHeader() string {
 		header.WriteString(ui.StrategyNameStyle.Render("ORDERBOOK"))
 	}
 
+	header.WriteString(pausedBadge(m.operator))
 	header.WriteString("  ")
 
 	// Live indicator with pulse
@@ -0,0 +1,223 @@
+package tabs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/internal/ui"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var staleStyle = lipgloss.NewStyle().Foreground(ui.ColorWarning).Bold(true)
+
+// OrdersModel is a tab that lists the orders an instance has resting on its exchanges and cancels them
+type OrdersModel struct {
+	querier    live.OrderQuerier
+	operator   *Operator
+	instanceID string
+	orders     *live.OpenOrders
+	cursor     int // selected order
+	loading    bool
+	err        error
+}
+
+// NewOrdersModel creates a new orders tab
+func NewOrdersModel(querier live.OrderQuerier, operator *Operator, instanceID string) *OrdersModel {
+	return &OrdersModel{
+		querier:    querier,
+		operator:   operator,
+		instanceID: instanceID,
+		loading:    true,
+	}
+}
+
+// Orders messages
+type ordersDataMsg struct {
+	orders *live.OpenOrders
+	err    error
+}
+
+type ordersTickMsg time.Time
+
+func (m *OrdersModel) Init() tea.Cmd {
+	return tea.Batch(m.fetchData(), m.tick())
+}
+
+func (m *OrdersModel) tick() tea.Cmd {
+	return tea.Tick(2*time.Second, func(t time.Time) tea.Msg {
+		return ordersTickMsg(t)
+	})
+}
+
+func (m *OrdersModel) fetchData() tea.Cmd {
+	return func() tea.Msg {
+		orders, err := m.querier.QueryOpenOrders(m.instanceID)
+		return ordersDataMsg{orders: orders, err: err}
+	}
+}
+
+func (m *OrdersModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case ordersDataMsg:
+		m.loading = false
+		m.err = msg.err
+		if msg.err == nil {
+			m.orders = msg.orders
+			m.cursor = max(min(m.cursor, len(m.orders.Orders)-1), 0)
+		}
+		return m, nil
+
+	case ordersTickMsg:
+		return m, tea.Batch(m.fetchData(), m.tick())
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "r":
+			m.loading = true
+			return m, m.fetchData()
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.orders != nil && m.cursor < len(m.orders.Orders)-1 {
+				m.cursor++
+			}
+		case "x":
+			if selected, ok := m.selected(); ok {
+				m.operator.CancelOrder(selected.Exchange, selected.Asset, selected.ID)
+			}
+		case "X":
+			if selected, ok := m.selected(); ok {
+				m.operator.CancelOrders(selected.Exchange, selected.Asset)
+			}
+		case " ":
+			m.operator.TogglePause()
+		}
+	}
+	return m, nil
+}
+
+// selected returns the order under the cursor
+func (m *OrdersModel) selected() (live.WorkingOrder, bool) {
+	if m.orders == nil || m.cursor >= len(m.orders.Orders) {
+		return live.WorkingOrder{}, false
+	}
+	return m.orders.Orders[m.cursor], true
+}
+
+func (m *OrdersModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(ui.StrategyNameStyle.Render("OPEN ORDERS"))
+	b.WriteString(pausedBadge(m.operator))
+	b.WriteString("\n\n")
+
+	if m.loading && m.orders == nil {
+		b.WriteString(ui.SubtitleStyle.Render("Loading open orders..."))
+		return b.String()
+	}
+
+	if m.err != nil {
+		b.WriteString(ui.StatusErrorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+		return b.String()
+	}
+
+	if len(m.orders.Orders) == 0 {
+		b.WriteString(ui.SubtitleStyle.Render("No working orders"))
+		b.WriteString("\n")
+	} else {
+		b.WriteString(m.renderOrders(time.Now()))
+	}
+
+	for _, err := range m.orders.Errors {
+		b.WriteString("\n")
+		b.WriteString(ui.StatusErrorStyle.Render("✗ " + err))
+	}
+
+	return b.String()
+}
+
+// renderOrders lists the orders grouped by exchange and asset, the one under the cursor selected and stale ones flagged
+func (m *OrdersModel) renderOrders(now time.Time) string {
+	var b strings.Builder
+
+	stale := 0
+	for _, order := range m.orders.Orders {
+		if order.Stale(now) {
+			stale++
+		}
+	}
+	summary := fmt.Sprintf("%d working orders", len(m.orders.Orders))
+	if stale > 0 {
+		summary += " • " + staleStyle.Render(fmt.Sprintf("%d stale", stale))
+	}
+	b.WriteString(ui.SubtitleStyle.Render(summary))
+	b.WriteString("\n\n")
+
+	b.WriteString(tableHeaderStyle.Render(fmt.Sprintf("  %-14s %-6s %-8s %-12s %-10s %-7s %-9s %s",
+		"ORDER", "SIDE", "TYPE", "PRICE", "SIZE", "FILLED", "AGE", "FROM MID")))
+	b.WriteString("\n")
+	b.WriteString(lipgloss.NewStyle().Foreground(ui.ColorMuted).Render(strings.Repeat("─", 80)))
+	b.WriteString("\n")
+
+	group := ""
+	for i, order := range m.orders.Orders {
+		if name := order.Exchange + " " + order.Asset; name != group {
+			group = name
+			b.WriteString(ui.SubtitleStyle.Render(fmt.Sprintf("%s · %s", order.Exchange, order.Asset)))
+			b.WriteString("\n")
+		}
+
+		price, _ := order.Price.Float64()
+		quantity, _ := order.Quantity.Float64()
+
+		sideStyle := profitStyle
+		if order.Side == connector.OrderSideSell {
+			sideStyle = lossStyle
+		}
+
+		age := "-"
+		if !order.CreatedAt.IsZero() {
+			age = ui.FormatSessionDuration(order.Age(now))
+		}
+		fromMid := "-"
+		if distance, ok := order.DistanceFromMid(); ok {
+			fromMid = fmt.Sprintf("%.2f%%", distance)
+		}
+
+		rest := fmt.Sprintf("%-8s %-12.2f %-10.4f %-7s %-9s %s",
+			order.Type,
+			price,
+			quantity,
+			fmt.Sprintf("%.0f%%", order.FilledPercent()),
+			age,
+			fromMid,
+		)
+		if order.Stale(now) {
+			rest = staleStyle.Render(rest + "  ⚠ stale")
+		}
+
+		marker := "  "
+		id := fmt.Sprintf("%-14s", truncateID(order.ID, 14))
+		if i == m.cursor {
+			marker = "▸ "
+			id = ui.StrategyNameSelectedStyle.Render(id)
+		}
+		b.WriteString(fmt.Sprintf("%s%s %s %s\n", marker, id, sideStyle.Render(fmt.Sprintf("%-6s", order.Side)), rest))
+	}
+
+	return b.String()
+}
+
+// truncateID shortens long exchange order IDs to fit the column, keeping the end that tells them apart
+func truncateID(id string, width int) string {
+	if len(id) <= width {
+		return id
+	}
+	return "…" + id[len(id)-width+1:]
+}
@@ -5,25 +5,48 @@ import (
 	"strings"
 	"time"
 
+	"github.com/backtesting-org/kronos-cli/internal/services/live/series"
 	"github.com/backtesting-org/kronos-cli/internal/ui"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
 	monitoring2 "github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
-// PnLModel is a tab that displays PnL data
+// Chart dimensions and how much history each range covers
+const (
+	chartWidth     = 60
+	equityHeight   = 8
+	drawdownHeight = 4
+	chartDays      = 30
+
+	// historyRefresh is how often recorded history is re-read; samples are taken about once a minute
+	historyRefresh = time.Minute
+)
+
+// PnLModel is a tab that displays PnL data and charts of the strategy's recorded history
 type PnLModel struct {
 	querier    monitoring2.ViewQuerier
+	feed       *Feed
+	sub        *subscription // nil while polling
+	store      live.SeriesStore
 	instanceID string
 	pnl        *monitoring2.PnLView
 	loading    bool
 	err        error
+
+	// Recorded history, charted for today or the last chartDays days
+	multiDay   bool
+	points     []series.Point
+	historyErr error
 }
 
 // NewPnLModel creates a new PnL tab
-func NewPnLModel(querier monitoring2.ViewQuerier, instanceID string) *PnLModel {
+func NewPnLModel(querier monitoring2.ViewQuerier, feed *Feed, store live.SeriesStore, instanceID string) *PnLModel {
 	return &PnLModel{
 		querier:    querier,
+		feed:       feed,
+		store:      store,
 		instanceID: instanceID,
 		loading:    true,
 	}
@@ -35,15 +58,48 @@ type pnlDataMsg struct {
 	err error
 }
 
+type pnlHistoryMsg struct {
+	multiDay bool
+	points   []series.Point
+	err      error
+}
+
 type pnlTickMsg time.Time
 
+type pnlHistoryTickMsg time.Time
+
 func (m *PnLModel) Init() tea.Cmd {
 	return tea.Batch(
 		m.fetchData(),
+		m.fetchHistory(),
 		m.tick(),
+		m.historyTick(),
+		m.feed.subscribe(m, live.StreamRequest{Topics: []live.StreamTopic{live.StreamPnL}}),
 	)
 }
 
+func (m *PnLModel) historyTick() tea.Cmd {
+	return tea.Tick(historyRefresh, func(t time.Time) tea.Msg {
+		return pnlHistoryTickMsg(t)
+	})
+}
+
+// fetchHistory reads the recorded samples of the selected range; the monitor names instances after their strategy
+func (m *PnLModel) fetchHistory() tea.Cmd {
+	multiDay := m.multiDay
+	return func() tea.Msg {
+		now := time.Now()
+		y, mo, d := now.Date()
+		from := time.Date(y, mo, d, 0, 0, 0, 0, now.Location())
+		if multiDay {
+			from = from.AddDate(0, 0, -chartDays+1)
+		}
+
+		samples, err := m.store.Query(m.instanceID, from, time.Time{})
+		return pnlHistoryMsg{multiDay: multiDay, points: series.Equity(samples), err: err}
+	}
+}
+
 func (m *PnLModel) tick() tea.Cmd {
 	return tea.Tick(5*time.Second, func(t time.Time) tea.Msg {
 		return pnlTickMsg(t)
@@ -65,13 +121,55 @@ func (m *PnLModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.pnl = msg.pnl
 		return m, nil
 
+	case streamOpenedMsg:
+		if msg.owner != tea.Model(m) || msg.err != nil {
+			return m, nil
+		}
+		m.sub = msg.sub
+		return m, m.sub.next()
+
+	case streamUpdateMsg:
+		if msg.sub != m.sub {
+			return m, nil
+		}
+		m.loading = false
+		m.err = nil
+		m.pnl = msg.update.PnL
+		return m, m.sub.next()
+
+	case streamClosedMsg:
+		if msg.sub == m.sub {
+			m.sub = nil
+		}
+		return m, nil
+
+	case pnlHistoryMsg:
+		// A range switched while loading is fetched again anyway
+		if msg.multiDay == m.multiDay {
+			m.points = msg.points
+			m.historyErr = msg.err
+		}
+		return m, nil
+
+	case pnlHistoryTickMsg:
+		return m, tea.Batch(m.fetchHistory(), m.historyTick())
+
 	case pnlTickMsg:
+		if m.sub != nil {
+			// Pushed updates are arriving, there's nothing to poll
+			return m, m.tick()
+		}
 		return m, tea.Batch(m.fetchData(), m.tick())
 
 	case tea.KeyMsg:
-		if msg.String() == "r" {
+		switch msg.String() {
+		case "r":
 			m.loading = true
-			return m, m.fetchData()
+			return m, tea.Batch(m.fetchData(), m.fetchHistory())
+		case "c":
+			m.multiDay = !m.multiDay
+			m.points = nil
+			return m, m.fetchHistory()
 		}
 	}
 	return m, nil
@@ -123,6 +221,59 @@ func (m *PnLModel) View() string {
 
 	b.WriteString(fmt.Sprintf("Trading Fees:  %s\n", lossStyle.Render(fmt.Sprintf("-$%.2f", fees))))
 
+	b.WriteString("\n")
+	b.WriteString(m.renderHistory())
+
+	return b.String()
+}
+
+// renderHistory charts equity and drawdown over the selected range: every sample for today, daily closes for longer
+func (m *PnLModel) renderHistory() string {
+	var b strings.Builder
+
+	title := "TODAY"
+	if m.multiDay {
+		title = fmt.Sprintf("LAST %d DAYS", chartDays)
+	}
+	b.WriteString(ui.StrategyNameStyle.Render("EQUITY - " + title))
+	b.WriteString("\n\n")
+
+	if m.historyErr != nil {
+		b.WriteString(ui.StatusErrorStyle.Render(fmt.Sprintf("Error: %v", m.historyErr)))
+		return b.String()
+	}
+	if len(m.points) < 2 {
+		b.WriteString(ui.SubtitleStyle.Render("Not enough history recorded yet"))
+		return b.String()
+	}
+
+	var equity, drawdown []float64
+	start, end := m.points[0].Time, m.points[len(m.points)-1].Time
+	layout := "15:04"
+	if m.multiDay {
+		days := series.Daily(m.points, time.Local)
+		for _, day := range days {
+			equity = append(equity, day.Close)
+			drawdown = append(drawdown, day.Drawdown)
+		}
+		start, end = days[0].Date, days[len(days)-1].Date
+		layout = "Jan 02"
+	} else {
+		for _, point := range m.points {
+			equity = append(equity, point.Equity)
+			drawdown = append(drawdown, point.Drawdown)
+		}
+	}
+
+	b.WriteString(ui.AreaChart(equity, chartWidth, equityHeight, ui.ColorPrimary))
+	b.WriteString("\n")
+	b.WriteString(ui.ChartTimeAxis(start.Local().Format(layout), end.Local().Format(layout), min(len(equity), chartWidth)))
+	b.WriteString("\n\n")
+
+	b.WriteString(ui.StrategyNameStyle.Render("DRAWDOWN"))
+	b.WriteString("\n\n")
+	b.WriteString(ui.DrawdownChart(drawdown, chartWidth, drawdownHeight, ui.ColorDanger))
+
 	return b.String()
 }
 
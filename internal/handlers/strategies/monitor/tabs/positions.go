@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/backtesting-org/kronos-cli/internal/ui"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
@@ -13,19 +14,26 @@ import (
 	"github.com/charmbracelet/lipgloss"
 )
 
-// PositionsModel is a tab that displays positions data
+// PositionsModel is a tab that displays positions data and closes them or cancels their orders
 type PositionsModel struct {
 	querier    monitoring.ViewQuerier
+	feed       *Feed
+	operator   *Operator
+	sub        *subscription // nil while polling
 	instanceID string
 	positions  *strategy.StrategyExecution
+	net        []live.NetPosition
+	cursor     int // selected net position
 	loading    bool
 	err        error
 }
 
 // NewPositionsModel creates a new positions tab
-func NewPositionsModel(querier monitoring.ViewQuerier, instanceID string) *PositionsModel {
+func NewPositionsModel(querier monitoring.ViewQuerier, feed *Feed, operator *Operator, instanceID string) *PositionsModel {
 	return &PositionsModel{
 		querier:    querier,
+		feed:       feed,
+		operator:   operator,
 		instanceID: instanceID,
 		loading:    true,
 	}
@@ -43,6 +51,7 @@ func (m *PositionsModel) Init() tea.Cmd {
 	return tea.Batch(
 		m.fetchData(),
 		m.tick(),
+		m.feed.subscribe(m, live.StreamRequest{Topics: []live.StreamTopic{live.StreamPositions}}),
 	)
 }
 
@@ -64,25 +73,86 @@ func (m *PositionsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case positionsDataMsg:
 		m.loading = false
 		m.err = msg.err
-		m.positions = msg.positions
+		m.setPositions(msg.positions)
+		return m, nil
+
+	case streamOpenedMsg:
+		if msg.owner != tea.Model(m) || msg.err != nil {
+			return m, nil
+		}
+		m.sub = msg.sub
+		return m, m.sub.next()
+
+	case streamUpdateMsg:
+		if msg.sub != m.sub {
+			return m, nil
+		}
+		m.loading = false
+		m.err = nil
+		m.setPositions(msg.update.Positions)
+		return m, m.sub.next()
+
+	case streamClosedMsg:
+		if msg.sub == m.sub {
+			m.sub = nil
+		}
 		return m, nil
 
 	case positionsTickMsg:
+		if m.sub != nil {
+			// Pushed updates are arriving, there's nothing to poll
+			return m, m.tick()
+		}
 		return m, tea.Batch(m.fetchData(), m.tick())
 
 	case tea.KeyMsg:
-		if msg.String() == "r" {
+		switch msg.String() {
+		case "r":
 			m.loading = true
 			return m, m.fetchData()
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.cursor < len(m.net)-1 {
+				m.cursor++
+			}
+		case "c":
+			if selected, ok := m.selected(); ok {
+				return m, m.operator.ClosePosition(selected.Exchange, selected.Symbol)
+			}
+		case "x":
+			if selected, ok := m.selected(); ok {
+				m.operator.CancelOrders(selected.Exchange, selected.Symbol)
+			}
+		case " ":
+			m.operator.TogglePause()
 		}
 	}
 	return m, nil
 }
 
+// setPositions shows a new positions view, keeping the cursor on the list
+func (m *PositionsModel) setPositions(positions *strategy.StrategyExecution) {
+	m.positions = positions
+	m.net = live.NetPositions(positions)
+	m.cursor = max(min(m.cursor, len(m.net)-1), 0)
+}
+
+// selected returns the net position under the cursor
+func (m *PositionsModel) selected() (live.NetPosition, bool) {
+	if m.cursor >= len(m.net) {
+		return live.NetPosition{}, false
+	}
+	return m.net[m.cursor], true
+}
+
 func (m *PositionsModel) View() string {
 	var b strings.Builder
 
 	b.WriteString(ui.StrategyNameStyle.Render("POSITIONS"))
+	b.WriteString(pausedBadge(m.operator))
 	b.WriteString("\n\n")
 
 	if m.loading {
@@ -100,8 +170,11 @@ func (m *PositionsModel) View() string {
 		return b.String()
 	}
 
+	b.WriteString(m.renderNet())
+
 	// Show orders
 	if len(m.positions.Orders) > 0 {
+		b.WriteString("\n")
 		b.WriteString(tableHeaderStyle.Render(fmt.Sprintf("  %-12s %-8s %-10s %-12s %-12s", "SYMBOL", "SIDE", "QTY", "PRICE", "STATUS")))
 		b.WriteString("\n")
 		b.WriteString(lipgloss.NewStyle().Foreground(ui.ColorMuted).Render(strings.Repeat("─", 60)))
@@ -128,3 +201,36 @@ func (m *PositionsModel) View() string {
 
 	return b.String()
 }
+
+// renderNet lists the net position per exchange and asset, the one under the cursor selected
+func (m *PositionsModel) renderNet() string {
+	var b strings.Builder
+
+	if len(m.net) == 0 {
+		b.WriteString(ui.SubtitleStyle.Render("Flat - no net positions"))
+		b.WriteString("\n")
+		return b.String()
+	}
+
+	b.WriteString(tableHeaderStyle.Render(fmt.Sprintf("  %-12s %-12s %s", "EXCHANGE", "ASSET", "NET QTY")))
+	b.WriteString("\n")
+	b.WriteString(lipgloss.NewStyle().Foreground(ui.ColorMuted).Render(strings.Repeat("─", 60)))
+	b.WriteString("\n")
+
+	for i, position := range m.net {
+		quantity, _ := position.Quantity.Float64()
+		style := profitStyle
+		if quantity < 0 {
+			style = lossStyle
+		}
+
+		marker := "  "
+		exchange := fmt.Sprintf("%-12s", position.Exchange)
+		if i == m.cursor {
+			marker = "▸ "
+			exchange = ui.StrategyNameSelectedStyle.Render(exchange)
+		}
+		b.WriteString(fmt.Sprintf("%s%s %-12s %s\n", marker, exchange, position.Symbol, style.Render(fmt.Sprintf("%+.4f", quantity))))
+	}
+	return b.String()
+}
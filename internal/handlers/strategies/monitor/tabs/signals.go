@@ -0,0 +1,381 @@
+package tabs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/internal/ui"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// signalOutcomeFilters is the cycle order for the outcome filter; empty shows every signal
+var signalOutcomeFilters = []live.SignalOutcome{
+	"",
+	live.SignalExecuted,
+	live.SignalRejected,
+	live.SignalPaused,
+	live.SignalInsufficientBalance,
+	live.SignalExchangeError,
+}
+
+// SignalsModel is a tab that lists every signal the instance generated and why it was or wasn't executed
+type SignalsModel struct {
+	querier    live.SignalQuerier
+	instanceID string
+	records    []live.SignalRecord // newest first
+	height     int
+	loading    bool
+	err        error
+
+	// Filtering
+	outcomeIndex int
+	search       string
+	searching    bool
+	input        string
+
+	// Selection - cursor indexes the filtered records, offset is the first one shown
+	cursor   int
+	offset   int
+	selected string // ID of the selected signal, kept across refreshes
+}
+
+// NewSignalsModel creates a new signals tab
+func NewSignalsModel(querier live.SignalQuerier, instanceID string) *SignalsModel {
+	return &SignalsModel{
+		querier:    querier,
+		instanceID: instanceID,
+		height:     15,
+		loading:    true,
+	}
+}
+
+// Signals messages
+type signalsDataMsg struct {
+	records []live.SignalRecord
+	err     error
+}
+
+type signalsTickMsg time.Time
+
+func (m *SignalsModel) Init() tea.Cmd {
+	return tea.Batch(m.fetchData(), m.tick())
+}
+
+func (m *SignalsModel) tick() tea.Cmd {
+	return tea.Tick(2*time.Second, func(t time.Time) tea.Msg {
+		return signalsTickMsg(t)
+	})
+}
+
+func (m *SignalsModel) fetchData() tea.Cmd {
+	return func() tea.Msg {
+		records, err := m.querier.QuerySignals(m.instanceID, live.DefaultSignalLimit)
+		return signalsDataMsg{records: records, err: err}
+	}
+}
+
+// Capturing reports whether the tab is consuming raw key input (search prompt open)
+func (m *SignalsModel) Capturing() bool {
+	return m.searching
+}
+
+func (m *SignalsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		// Leave room for header, tabs, filter bar, the selected signal's details and help
+		m.height = max(msg.Height-22, 5)
+		m.moveCursor(0)
+		return m, nil
+
+	case signalsDataMsg:
+		m.loading = false
+		m.err = msg.err
+		if msg.err == nil {
+			m.records = make([]live.SignalRecord, len(msg.records))
+			for i, record := range msg.records {
+				m.records[len(msg.records)-1-i] = record
+			}
+			m.reselect()
+		}
+		return m, nil
+
+	case signalsTickMsg:
+		return m, tea.Batch(m.fetchData(), m.tick())
+
+	case tea.KeyMsg:
+		if m.searching {
+			m.updateSearch(msg)
+			return m, nil
+		}
+
+		switch msg.String() {
+		case "/":
+			m.searching = true
+			m.input = m.search
+		case "o":
+			m.outcomeIndex = (m.outcomeIndex + 1) % len(signalOutcomeFilters)
+			m.reselect()
+		case "up", "k":
+			m.moveCursor(-1)
+		case "down", "j":
+			m.moveCursor(1)
+		case "pgup":
+			m.moveCursor(-m.height)
+		case "pgdown":
+			m.moveCursor(m.height)
+		case "home", "g":
+			m.moveCursor(-len(m.records))
+		case "r":
+			m.loading = true
+			return m, m.fetchData()
+		}
+	}
+	return m, nil
+}
+
+func (m *SignalsModel) updateSearch(msg tea.KeyMsg) {
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.search = m.input
+		m.searching = false
+		m.reselect()
+	case tea.KeyEsc:
+		m.searching = false
+	case tea.KeyBackspace:
+		if len(m.input) > 0 {
+			runes := []rune(m.input)
+			m.input = string(runes[:len(runes)-1])
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		m.input += string(msg.Runes)
+	}
+}
+
+func (m *SignalsModel) filtered() []live.SignalRecord {
+	outcome := signalOutcomeFilters[m.outcomeIndex]
+	if outcome == "" && m.search == "" {
+		return m.records
+	}
+
+	var out []live.SignalRecord
+	for _, record := range m.records {
+		if record.Matches(outcome, m.search) {
+			out = append(out, record)
+		}
+	}
+	return out
+}
+
+// moveCursor moves the selection by delta signals (positive = back in time), scrolling to keep it in view
+func (m *SignalsModel) moveCursor(delta int) {
+	visible := m.filtered()
+	m.cursor = max(min(m.cursor+delta, len(visible)-1), 0)
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	}
+	if m.cursor >= m.offset+m.height {
+		m.offset = m.cursor - m.height + 1
+	}
+	m.offset = max(min(m.offset, len(visible)-m.height), 0)
+
+	m.selected = ""
+	if m.cursor > 0 && m.cursor < len(visible) {
+		m.selected = visible[m.cursor].ID
+	}
+}
+
+// reselect keeps the selected signal under the cursor after the list changed; the newest is followed when none is
+func (m *SignalsModel) reselect() {
+	visible := m.filtered()
+	cursor := 0
+	for i, record := range visible {
+		if m.selected != "" && record.ID == m.selected {
+			cursor = i
+			break
+		}
+	}
+	m.cursor = cursor
+	m.moveCursor(0)
+}
+
+func (m *SignalsModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(ui.StrategyNameStyle.Render("SIGNALS"))
+	b.WriteString("\n")
+	b.WriteString(m.renderFilterBar())
+	b.WriteString("\n\n")
+
+	if m.loading && m.records == nil {
+		b.WriteString(ui.SubtitleStyle.Render("Loading signals..."))
+		return b.String()
+	}
+
+	if m.err != nil {
+		b.WriteString(ui.StatusErrorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+		return b.String()
+	}
+
+	visible := m.filtered()
+	if len(visible) == 0 {
+		if len(m.records) == 0 {
+			b.WriteString(ui.SubtitleStyle.Render("No signals generated yet"))
+		} else {
+			b.WriteString(ui.SubtitleStyle.Render("No signals match the filter"))
+		}
+		return b.String()
+	}
+
+	b.WriteString(tableHeaderStyle.Render(fmt.Sprintf("  %-9s %-22s %-11s %-14s %-10s %s", "TIME", "OUTCOME", "SIDE", "ASSETS", "SIZE", "ORDERS / REASON")))
+	b.WriteString("\n")
+	b.WriteString(lipgloss.NewStyle().Foreground(ui.ColorMuted).Render(strings.Repeat("─", 100)))
+	b.WriteString("\n")
+
+	end := min(m.offset+m.height, len(visible))
+	for i := m.offset; i < end; i++ {
+		b.WriteString(m.renderRow(visible[i], i == m.cursor))
+		b.WriteString("\n")
+	}
+	if len(visible) > m.height {
+		b.WriteString(neutralStyle.Render(fmt.Sprintf("  %d-%d of %d", m.offset+1, end, len(visible))))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(m.renderDetails(visible[m.cursor]))
+
+	return b.String()
+}
+
+func (m *SignalsModel) renderFilterBar() string {
+	outcome := "ALL"
+	if filter := signalOutcomeFilters[m.outcomeIndex]; filter != "" {
+		outcome = strings.ToUpper(string(filter))
+	}
+
+	search := m.search
+	if m.searching {
+		search = m.input + "█"
+	}
+	if search == "" {
+		search = "-"
+	}
+
+	executed := 0
+	for _, record := range m.records {
+		if record.Executed() {
+			executed++
+		}
+	}
+
+	return ui.SubtitleStyle.Render(fmt.Sprintf("Outcome: %s  Search: %s  (%d of the last %d executed)", outcome, search, executed, len(m.records)))
+}
+
+func (m *SignalsModel) renderRow(record live.SignalRecord, selected bool) string {
+	timeStr := "        "
+	if !record.Time.IsZero() {
+		timeStr = record.Time.Local().Format("15:04:05")
+	}
+
+	detail := strings.Join(record.OrderIDs, ", ")
+	if !record.Executed() {
+		detail = record.Reason
+	}
+
+	marker := "  "
+	timeCol := fmt.Sprintf("%-9s", timeStr)
+	if selected {
+		marker = "▸ "
+		timeCol = ui.StrategyNameSelectedStyle.Render(timeCol)
+	}
+
+	return fmt.Sprintf("%s%s %s %-11s %-14s %-10s %s",
+		marker,
+		timeCol,
+		outcomeStyle(record.Outcome).Render(fmt.Sprintf("%-22s", outcomeLabel(record.Outcome))),
+		signalSides(record),
+		truncate(strings.Join(record.Assets(), ","), 14),
+		signalSize(record),
+		truncate(detail, 40),
+	)
+}
+
+// renderDetails shows every action of the selected signal with its full reason and orders
+func (m *SignalsModel) renderDetails(record live.SignalRecord) string {
+	lines := []string{ui.SubtitleStyle.Render(fmt.Sprintf("Signal %s", record.ID))}
+	for _, action := range record.Actions {
+		quantity, _ := action.Quantity.Float64()
+		price, _ := action.Price.Float64()
+		lines = append(lines, fmt.Sprintf("  %-10s %.4f %s @ %.2f on %s", action.Action, quantity, action.Asset, price, action.Exchange))
+	}
+	if len(record.OrderIDs) > 0 {
+		lines = append(lines, fmt.Sprintf("  Orders: %s", strings.Join(record.OrderIDs, ", ")))
+	}
+	if record.Reason != "" {
+		lines = append(lines, outcomeStyle(record.Outcome).Render(fmt.Sprintf("  %s", record.Reason)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func outcomeLabel(outcome live.SignalOutcome) string {
+	if outcome == live.SignalExecuted {
+		return "✓ executed"
+	}
+	return "✗ " + strings.ReplaceAll(string(outcome), "_", " ")
+}
+
+func outcomeStyle(outcome live.SignalOutcome) lipgloss.Style {
+	switch outcome {
+	case live.SignalExecuted:
+		return profitStyle
+	case live.SignalPaused:
+		return staleStyle
+	default:
+		return lossStyle
+	}
+}
+
+// signalSides is the action of a single-action signal, or how many actions a signal has
+func signalSides(record live.SignalRecord) string {
+	switch len(record.Actions) {
+	case 0:
+		return "-"
+	case 1:
+		return string(record.Actions[0].Action)
+	}
+	first := record.Actions[0].Action
+	for _, action := range record.Actions[1:] {
+		if action.Action != first {
+			return fmt.Sprintf("%d actions", len(record.Actions))
+		}
+	}
+	return string(first)
+}
+
+// signalSize is the quantity of a single trade, or of the first one with a count of the rest
+func signalSize(record live.SignalRecord) string {
+	for i, action := range record.Actions {
+		if action.Action == strategy.ActionHold {
+			continue
+		}
+		quantity, _ := action.Quantity.Float64()
+		if rest := len(record.Actions) - i - 1; rest > 0 {
+			return fmt.Sprintf("%.4f+%d", quantity, rest)
+		}
+		return fmt.Sprintf("%.4f", quantity)
+	}
+	return "-"
+}
+
+// truncate cuts s to width runes, marking the cut
+func truncate(s string, width int) string {
+	runes := []rune(s)
+	if len(runes) <= width {
+		return s
+	}
+	return string(runes[:width-1]) + "…"
+}
@@ -0,0 +1,90 @@
+package tabs
+
+import (
+	"context"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Feed subscribes the tabs of one instance to the views it pushes.
+// Tabs keep polling until their subscription is open, and go back to polling if it can't be opened or ends.
+type Feed struct {
+	ctx        context.Context
+	cancel     context.CancelFunc
+	streamer   live.ViewStreamer
+	instanceID string
+}
+
+// NewFeed creates the feed for an instance's tabs; Close it when the tabs go away
+func NewFeed(streamer live.ViewStreamer, instanceID string) *Feed {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Feed{
+		ctx:        ctx,
+		cancel:     cancel,
+		streamer:   streamer,
+		instanceID: instanceID,
+	}
+}
+
+// Close ends every subscription opened through the feed
+func (f *Feed) Close() {
+	f.cancel()
+}
+
+// subscription is one tab's stream of pushed updates
+type subscription struct {
+	updates <-chan live.StreamUpdate
+	cancel  context.CancelFunc
+}
+
+// Stream messages. Every tab sees them, so each carries what it belongs to.
+type streamOpenedMsg struct {
+	owner tea.Model
+	req   live.StreamRequest
+	sub   *subscription
+	err   error
+}
+
+type streamUpdateMsg struct {
+	sub    *subscription
+	update live.StreamUpdate
+}
+
+type streamClosedMsg struct {
+	sub *subscription
+}
+
+// subscribe opens a subscription for owner; the outcome arrives as a streamOpenedMsg
+func (f *Feed) subscribe(owner tea.Model, req live.StreamRequest) tea.Cmd {
+	if f == nil || f.streamer == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(f.ctx)
+		updates, err := f.streamer.Subscribe(ctx, f.instanceID, req)
+		if err != nil {
+			cancel()
+			return streamOpenedMsg{owner: owner, req: req, err: err}
+		}
+		return streamOpenedMsg{owner: owner, req: req, sub: &subscription{updates: updates, cancel: cancel}}
+	}
+}
+
+// next waits for the subscription's next update
+func (s *subscription) next() tea.Cmd {
+	return func() tea.Msg {
+		update, ok := <-s.updates
+		if !ok {
+			return streamClosedMsg{sub: s}
+		}
+		return streamUpdateMsg{sub: s, update: update}
+	}
+}
+
+// close ends the subscription; nil is a no-op
+func (s *subscription) close() {
+	if s != nil {
+		s.cancel()
+	}
+}
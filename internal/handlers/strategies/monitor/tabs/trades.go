@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/backtesting-org/kronos-cli/internal/ui"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
 	tea "github.com/charmbracelet/bubbletea"
@@ -15,6 +16,8 @@ import (
 // TradesModel is a tab that displays recent trades
 type TradesModel struct {
 	querier    monitoring.ViewQuerier
+	feed       *Feed
+	sub        *subscription // nil while polling
 	instanceID string
 	trades     []connector.Trade
 	limit      int
@@ -23,9 +26,10 @@ type TradesModel struct {
 }
 
 // NewTradesModel creates a new trades tab
-func NewTradesModel(querier monitoring.ViewQuerier, instanceID string) *TradesModel {
+func NewTradesModel(querier monitoring.ViewQuerier, feed *Feed, instanceID string) *TradesModel {
 	return &TradesModel{
 		querier:    querier,
+		feed:       feed,
 		instanceID: instanceID,
 		limit:      20,
 		loading:    true,
@@ -44,6 +48,7 @@ func (m *TradesModel) Init() tea.Cmd {
 	return tea.Batch(
 		m.fetchData(),
 		m.tick(),
+		m.feed.subscribe(m, live.StreamRequest{Topics: []live.StreamTopic{live.StreamTrades}, TradeLimit: m.limit}),
 	)
 }
 
@@ -68,7 +73,33 @@ func (m *TradesModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.trades = msg.trades
 		return m, nil
 
+	case streamOpenedMsg:
+		if msg.owner != tea.Model(m) || msg.err != nil {
+			return m, nil
+		}
+		m.sub = msg.sub
+		return m, m.sub.next()
+
+	case streamUpdateMsg:
+		if msg.sub != m.sub {
+			return m, nil
+		}
+		m.loading = false
+		m.err = nil
+		m.trades = msg.update.Trades
+		return m, m.sub.next()
+
+	case streamClosedMsg:
+		if msg.sub == m.sub {
+			m.sub = nil
+		}
+		return m, nil
+
 	case tradesTickMsg:
+		if m.sub != nil {
+			// Pushed updates are arriving, there's nothing to poll
+			return m, m.tick()
+		}
 		return m, tea.Batch(m.fetchData(), m.tick())
 
 	case tea.KeyMsg:
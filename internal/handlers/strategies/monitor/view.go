@@ -1,6 +1,7 @@
 package monitor
 
 import (
+	"github.com/backtesting-org/kronos-cli/pkg/live"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -9,8 +10,23 @@ import (
 type MonitorViewFactory func() tea.Model
 
 // NewMonitorViewFactory creates the factory for monitor views
-func NewMonitorViewFactory(querier monitoring.ViewQuerier) MonitorViewFactory {
+func NewMonitorViewFactory(
+	querier monitoring.ViewQuerier,
+	streamer live.ViewStreamer,
+	summaries live.SummaryQuerier,
+	portfolios live.PortfolioQuerier,
+	stateStore live.StateStore,
+	manager live.InstanceManager,
+	history live.HistoryStore,
+	series live.SeriesStore,
+	scheduler live.Scheduler,
+	emergency live.EmergencyStop,
+	trader live.Trader,
+	orders live.OrderQuerier,
+	signals live.SignalQuerier,
+	cfg *live.SupervisorConfig,
+) MonitorViewFactory {
 	return func() tea.Model {
-		return NewInstanceListModel(querier)
+		return NewInstanceListModel(querier, streamer, summaries, portfolios, stateStore, manager, history, series, scheduler, emergency, trader, orders, signals, cfg)
 	}
 }
@@ -0,0 +1,10 @@
+package actions
+
+import "go.uber.org/fx"
+
+// Module provides manual trading actions on running instances via Fx
+var Module = fx.Module("live/actions",
+	fx.Provide(
+		NewTrader,
+	),
+)
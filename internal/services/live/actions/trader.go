@@ -0,0 +1,112 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+)
+
+type trader struct {
+	controller live.InstanceController
+	manager    live.InstanceManager
+	events     live.EventBus
+	logger     logging.ApplicationLogger
+}
+
+// NewTrader creates a Trader that acts through the strategies' control sockets
+func NewTrader(
+	controller live.InstanceController,
+	manager live.InstanceManager,
+	events live.EventBus,
+	logger logging.ApplicationLogger,
+) live.Trader {
+	return &trader{
+		controller: controller,
+		manager:    manager,
+		events:     events,
+		logger:     logger,
+	}
+}
+
+func (t *trader) Act(ctx context.Context, strategyName string, action live.TradingAction) (*live.ActionResult, error) {
+	if err := action.Validate(); err != nil {
+		return nil, err
+	}
+	if action.Actor == "" {
+		action.Actor = live.ActorFromContext(ctx)
+	}
+
+	t.logger.Info("Trading action", "strategy", strategyName, "action", action.Description(), "actor", action.Actor)
+
+	result, err := t.controller.Act(strategyName, action)
+	if err != nil {
+		t.record(strategyName, action, fmt.Sprintf("%s failed: %v", action.Description(), err))
+		return nil, fmt.Errorf("failed to %s: %w", action.Description(), err)
+	}
+	result.StrategyName = strategyName
+
+	t.record(strategyName, action, Summary(result))
+	return result, nil
+}
+
+func (t *trader) Paused(strategyName string) (bool, error) {
+	return t.controller.Paused(strategyName)
+}
+
+// record adds the action to the event log, whatever came of it
+func (t *trader) record(strategyName string, action live.TradingAction, reason string) {
+	t.events.Publish(live.Event{
+		Type:         eventType(action.Type),
+		Actor:        action.Actor,
+		StrategyName: strategyName,
+		InstanceID:   t.instanceID(strategyName),
+		Reason:       reason,
+	})
+}
+
+// instanceID returns the ID of the strategy's running instance, or "" for strategies the manager doesn't track
+func (t *trader) instanceID(strategyName string) string {
+	running, err := t.manager.List(live.StatusRunning)
+	if err != nil {
+		return ""
+	}
+	for _, inst := range running {
+		if inst.StrategyName == strategyName {
+			return inst.ID
+		}
+	}
+	return ""
+}
+
+func eventType(action live.ActionType) live.EventType {
+	switch action {
+	case live.ActionCancelOrders:
+		return live.EventOrdersCancelled
+	case live.ActionClosePosition:
+		return live.EventPositionClosed
+	case live.ActionPause:
+		return live.EventPaused
+	default:
+		return live.EventResumed
+	}
+}
+
+// Summary describes what an action did, for the event log and the monitor
+func Summary(result *live.ActionResult) string {
+	summary := result.Action.Description()
+	switch result.Action.Type {
+	case live.ActionCancelOrders:
+		summary += fmt.Sprintf(": cancelled %d orders", result.CancelledOrders)
+	case live.ActionClosePosition:
+		if len(result.OrderIDs) > 0 {
+			summary += ": placed order " + strings.Join(result.OrderIDs, ", ")
+		}
+	}
+	if len(result.Errors) > 0 {
+		summary += fmt.Sprintf(", %d errors: %s", len(result.Errors), strings.Join(result.Errors, "; "))
+	}
+	return summary
+}
@@ -0,0 +1,95 @@
+package actions_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/actions"
+	livemocks "github.com/backtesting-org/kronos-cli/mocks/github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+)
+
+var _ = Describe("Trader", func() {
+	var (
+		controller *livemocks.InstanceController
+		manager    *livemocks.InstanceManager
+		bus        *livemocks.EventBus
+		trader     live.Trader
+		published  []live.Event
+	)
+
+	BeforeEach(func() {
+		controller = livemocks.NewInstanceController(GinkgoT())
+		manager = livemocks.NewInstanceManager(GinkgoT())
+		bus = livemocks.NewEventBus(GinkgoT())
+		trader = actions.NewTrader(controller, manager, bus, &logging.NoOpLogger{})
+
+		published = nil
+		bus.EXPECT().Publish(mock.Anything).Run(func(event live.Event) {
+			published = append(published, event)
+		}).Maybe()
+		manager.EXPECT().List(live.StatusRunning).Return([]*live.Instance{
+			{ID: "m-1", StrategyName: "momentum", Status: live.StatusRunning},
+		}, nil).Maybe()
+	})
+
+	It("should record cancelled orders with the actor of the context", func() {
+		action := live.TradingAction{Type: live.ActionCancelOrders, Exchange: "paradex", Asset: "BTC"}
+		controller.EXPECT().Act("momentum", mock.Anything).Return(&live.ActionResult{Action: action, CancelledOrders: 3}, nil)
+
+		result, err := trader.Act(live.WithActor(context.Background(), live.ActorTUI), "momentum", action)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.StrategyName).To(Equal("momentum"))
+		Expect(result.CancelledOrders).To(Equal(3))
+
+		Expect(published).To(HaveLen(1))
+		Expect(published[0].Type).To(Equal(live.EventOrdersCancelled))
+		Expect(published[0].Actor).To(Equal(live.ActorTUI))
+		Expect(published[0].InstanceID).To(Equal("m-1"))
+		Expect(published[0].Reason).To(Equal("cancel all BTC orders on paradex: cancelled 3 orders"))
+	})
+
+	It("should record the order placed to reduce a position and its errors", func() {
+		action := live.TradingAction{Type: live.ActionClosePosition, Exchange: "paradex", Asset: "ETH", Fraction: 0.5, Price: 2500}
+		controller.EXPECT().Act("momentum", action).Return(&live.ActionResult{
+			Action:   action,
+			OrderIDs: []string{"o-7"},
+			Errors:   []string{"slow exchange"},
+		}, nil)
+
+		_, err := trader.Act(context.Background(), "momentum", action)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(published).To(HaveLen(1))
+		Expect(published[0].Type).To(Equal(live.EventPositionClosed))
+		Expect(published[0].Reason).To(ContainSubstring("reduce by 50% ETH position on paradex with a limit at 2500"))
+		Expect(published[0].Reason).To(ContainSubstring("placed order o-7"))
+		Expect(published[0].Reason).To(ContainSubstring("1 errors: slow exchange"))
+	})
+
+	It("should record actions the strategy couldn't be reached for", func() {
+		controller.EXPECT().Act("momentum", mock.Anything).Return(nil, errors.New("instance momentum has no control socket"))
+
+		_, err := trader.Act(context.Background(), "momentum", live.TradingAction{Type: live.ActionPause})
+		Expect(err).To(MatchError(ContainSubstring("failed to pause signal execution")))
+
+		Expect(published).To(HaveLen(1))
+		Expect(published[0].Type).To(Equal(live.EventPaused))
+		Expect(published[0].Reason).To(ContainSubstring("no control socket"))
+	})
+
+	It("should reject incomplete actions without contacting the strategy", func() {
+		_, err := trader.Act(context.Background(), "momentum", live.TradingAction{Type: live.ActionClosePosition, Asset: "BTC"})
+		Expect(err).To(MatchError(ContainSubstring("needs an exchange and an asset")))
+
+		_, err = trader.Act(context.Background(), "momentum", live.TradingAction{Type: live.ActionClosePosition, Exchange: "paradex", Asset: "BTC", Fraction: 1.5})
+		Expect(err).To(MatchError(ContainSubstring("fraction must be between 0 and 1")))
+
+		Expect(published).To(BeEmpty())
+	})
+})
@@ -0,0 +1,141 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+)
+
+type alerter struct {
+	querier monitoring.ViewQuerier
+	health  live.HealthQuerier
+	state   live.StateStore
+	logger  logging.ApplicationLogger
+}
+
+func NewAlerter(
+	querier monitoring.ViewQuerier,
+	health live.HealthQuerier,
+	state live.StateStore,
+	logger logging.ApplicationLogger,
+) live.Alerter {
+	return &alerter{
+		querier: querier,
+		health:  health,
+		state:   state,
+		logger:  logger,
+	}
+}
+
+// Watch evaluates the rules every cfg.Interval until ctx is done, starting right away
+func (a *alerter) Watch(ctx context.Context, cfg *live.AlertConfig, onNotify func(alert *live.Alert, errs map[string]error)) error {
+	sinks, err := newSinks(cfg)
+	if err != nil {
+		return err
+	}
+	engine := NewEngine(cfg, a.querier, a.health, a.state, time.Now())
+
+	ticker := time.NewTicker(cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		due, err := engine.Evaluate(ctx, time.Now())
+		if err != nil && ctx.Err() == nil {
+			a.logger.Warn("Failed to evaluate alert rules", "error", err)
+		}
+		for _, alert := range due {
+			errs := notify(ctx, sinks, alert)
+			for name, err := range errs {
+				a.logger.Warn("Failed to send alert", "rule", alert.Rule, "instance", alert.InstanceID, "sink", name, "error", err)
+			}
+			if onNotify != nil {
+				onNotify(alert, errs)
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (a *alerter) Check(ctx context.Context, cfg *live.AlertConfig) ([]*live.Alert, error) {
+	return NewEngine(cfg, a.querier, a.health, a.state, time.Now()).Firing(ctx, time.Now())
+}
+
+func (a *alerter) Test(ctx context.Context, cfg *live.AlertConfig, names []string) (map[string]error, error) {
+	sinks, err := newSinks(cfg)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		if _, ok := sinks[name]; !ok {
+			return nil, fmt.Errorf("no sink named %s", name)
+		}
+	}
+
+	now := time.Now()
+	alert := &live.Alert{
+		Rule:         "test",
+		Severity:     live.SeverityWarning,
+		Status:       live.AlertFiring,
+		InstanceID:   "kronos",
+		StrategyName: "kronos",
+		Message:      "Test alert from kronos alerts test",
+		Since:        now,
+		Time:         now,
+		Sinks:        names,
+	}
+
+	errs := notify(ctx, sinks, alert)
+	for _, name := range sinkNames(sinks, alert) {
+		if _, failed := errs[name]; !failed {
+			errs[name] = nil
+		}
+	}
+	return errs, nil
+}
+
+// newSinks creates every sink of cfg by name
+func newSinks(cfg *live.AlertConfig) (map[string]live.AlertSink, error) {
+	sinks := make(map[string]live.AlertSink, len(cfg.Sinks))
+	for _, sinkCfg := range cfg.Sinks {
+		sink, err := NewSink(sinkCfg)
+		if err != nil {
+			return nil, fmt.Errorf("sink %s: %w", sinkCfg.Name, err)
+		}
+		sinks[sinkCfg.Name] = sink
+	}
+	return sinks, nil
+}
+
+// sinkNames returns the sinks an alert goes to: the ones its rule names, or all of them
+func sinkNames(sinks map[string]live.AlertSink, alert *live.Alert) []string {
+	if len(alert.Sinks) > 0 {
+		return alert.Sinks
+	}
+	return sortedKeys(sinks)
+}
+
+// notify sends an alert through its sinks, each under its own timeout, and returns the errors by sink
+func notify(ctx context.Context, sinks map[string]live.AlertSink, alert *live.Alert) map[string]error {
+	errs := make(map[string]error)
+	for _, name := range sinkNames(sinks, alert) {
+		sink, ok := sinks[name]
+		if !ok {
+			continue
+		}
+		sinkCtx, cancel := context.WithTimeout(ctx, sinkTimeout)
+		if err := sink.Send(sinkCtx, alert); err != nil {
+			errs[name] = err
+		}
+		cancel()
+	}
+	return errs
+}
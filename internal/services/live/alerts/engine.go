@@ -0,0 +1,469 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/schedule"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring/health"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+)
+
+// Engine evaluates the rules of an alerts file, remembering between evaluations what fired,
+// what was sent and the PnL peaks drawdowns are measured from
+type Engine struct {
+	cfg      *live.AlertConfig
+	querier  monitoring.ViewQuerier
+	health   live.HealthQuerier
+	state    live.StateStore
+	silences []silence
+
+	// Crashes recorded after this are reported by the next evaluation
+	crashesSince time.Time
+
+	peaks     map[string]float64   // highest total PnL seen per instance
+	firstSeen map[string]time.Time // when each instance was first evaluated, for no_trades before any trade
+	active    map[string]*activeAlert
+}
+
+// activeAlert is an alert that fired at the last evaluation
+type activeAlert struct {
+	alert *live.Alert
+	sent  time.Time // zero while silenced before it was ever sent
+}
+
+type silence struct {
+	live.AlertSilence
+	plan *schedule.Plan
+}
+
+// firingAlert is a rule firing for an instance, keyed by rule, instance and asset
+type firingAlert struct {
+	key     string
+	alert   *live.Alert
+	instant bool // a one-off like a crash, sent once and never resolved
+}
+
+// NewEngine creates an engine for a validated config. Crashes recorded within the interval before
+// now are reported by the first evaluation.
+func NewEngine(cfg *live.AlertConfig, querier monitoring.ViewQuerier, health live.HealthQuerier, state live.StateStore, now time.Time) *Engine {
+	e := &Engine{
+		cfg:          cfg,
+		querier:      querier,
+		health:       health,
+		state:        state,
+		crashesSince: now.Add(-cfg.Interval),
+		peaks:        make(map[string]float64),
+		firstSeen:    make(map[string]time.Time),
+		active:       make(map[string]*activeAlert),
+	}
+	for _, s := range cfg.Silences {
+		compiled := silence{AlertSilence: s}
+		if s.Schedule != nil {
+			// Validated with the config
+			compiled.plan, _ = schedule.Compile(s.Schedule)
+		}
+		e.silences = append(e.silences, compiled)
+	}
+	return e
+}
+
+// Evaluate checks every rule at now and returns the notifications due: alerts that started firing,
+// alerts still firing repeat_after since they were last sent, and, with send_resolved, alerts that
+// stopped firing. Silenced alerts are held back until their silence ends.
+func (e *Engine) Evaluate(ctx context.Context, now time.Time) ([]*live.Alert, error) {
+	firing, instances, unknown, err := e.evaluate(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var due []*live.Alert
+	seen := make(map[string]bool, len(firing))
+	for _, f := range firing {
+		if f.instant {
+			if !e.silenced(f.alert, now) {
+				due = append(due, f.alert)
+			}
+			continue
+		}
+
+		seen[f.key] = true
+		active, ok := e.active[f.key]
+		if !ok {
+			active = &activeAlert{}
+			e.active[f.key] = active
+			f.alert.Since = now
+		} else {
+			f.alert.Since = active.alert.Since
+		}
+		active.alert = f.alert
+
+		if e.silenced(f.alert, now) {
+			continue
+		}
+		if active.sent.IsZero() || (e.cfg.RepeatAfter > 0 && now.Sub(active.sent) >= e.cfg.RepeatAfter) {
+			active.sent = now
+			due = append(due, f.alert)
+		}
+	}
+
+	keys := make([]string, 0, len(e.active))
+	for key := range e.active {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		active := e.active[key]
+		if seen[key] || unknown[unknownKey(active.alert.Rule, active.alert.InstanceID)] {
+			continue
+		}
+		delete(e.active, key)
+
+		// An instance that went away takes its alerts with it; only a recovery is worth telling
+		if !e.cfg.SendResolved || active.sent.IsZero() || !instances[active.alert.InstanceID] || e.silenced(active.alert, now) {
+			continue
+		}
+		resolved := *active.alert
+		resolved.Status = live.AlertResolved
+		resolved.Time = now
+		resolved.Message = "Resolved: " + active.alert.Message
+		due = append(due, &resolved)
+	}
+
+	return due, nil
+}
+
+// Firing evaluates every rule at now and returns the alerts firing, ignoring silences and what was sent before
+func (e *Engine) Firing(ctx context.Context, now time.Time) ([]*live.Alert, error) {
+	firing, _, _, err := e.evaluate(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	alerts := make([]*live.Alert, len(firing))
+	for i, f := range firing {
+		alerts[i] = f.alert
+		if active, ok := e.active[f.key]; ok {
+			f.alert.Since = active.alert.Since
+		} else {
+			f.alert.Since = now
+		}
+	}
+	return alerts, nil
+}
+
+// evaluate returns what fires at now, the instances evaluated, and the rule and instance pairs
+// that couldn't be evaluated because a query failed, whose alerts are left as they were
+func (e *Engine) evaluate(ctx context.Context, now time.Time) ([]firingAlert, map[string]bool, map[string]bool, error) {
+	instanceIDs, err := e.querier.ListInstances()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to list instances: %w", err)
+	}
+	sort.Strings(instanceIDs)
+
+	instances := make(map[string]bool, len(instanceIDs))
+	for _, instanceID := range instanceIDs {
+		instances[instanceID] = true
+		if _, ok := e.firstSeen[instanceID]; !ok {
+			e.firstSeen[instanceID] = now
+		}
+	}
+	for instanceID := range e.firstSeen {
+		if !instances[instanceID] {
+			delete(e.firstSeen, instanceID)
+			delete(e.peaks, instanceID)
+		}
+	}
+
+	var firing []firingAlert
+	unknown := make(map[string]bool)
+	for _, instanceID := range instanceIDs {
+		if ctx.Err() != nil {
+			return nil, nil, nil, ctx.Err()
+		}
+
+		views := &instanceViews{querier: e.querier, health: e.health, instanceID: instanceID}
+		for i := range e.cfg.Rules {
+			rule := &e.cfg.Rules[i]
+			if rule.Kind == live.AlertCrashed || !matches(rule.Strategies, instanceID) {
+				continue
+			}
+
+			alerts, err := e.evaluateRule(rule, views, now)
+			if err != nil {
+				unknown[unknownKey(rule.Name, instanceID)] = true
+				continue
+			}
+			firing = append(firing, alerts...)
+		}
+	}
+
+	crashes, err := e.crashes(now)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	firing = append(firing, crashes...)
+
+	return firing, instances, unknown, nil
+}
+
+// evaluateRule returns what a rule fires for one instance, or an error if the views it needs couldn't be queried
+func (e *Engine) evaluateRule(rule *live.AlertRule, views *instanceViews, now time.Time) ([]firingAlert, error) {
+	instanceID := views.instanceID
+	fire := func(asset, message string) []firingAlert {
+		return []firingAlert{{
+			key:   rule.Name + "\x00" + instanceID + "\x00" + asset,
+			alert: newAlert(rule, instanceID, asset, message, now),
+		}}
+	}
+
+	switch rule.Kind {
+	case live.AlertDrawdown:
+		pnl, err := views.pnl()
+		if err != nil {
+			return nil, err
+		}
+		// The peak is the highest PnL seen since the engine started watching the instance
+		total := pnl.TotalPnL.InexactFloat64()
+		if peak, ok := e.peaks[instanceID]; !ok || total > peak {
+			e.peaks[instanceID] = total
+		}
+		peak := e.peaks[instanceID]
+		if drawdown := peak - total; drawdown > rule.MaxDrawdown {
+			return fire("", fmt.Sprintf("PnL %.2f is %.2f below its peak of %.2f, more than %.2f", total, drawdown, peak, rule.MaxDrawdown)), nil
+		}
+
+	case live.AlertNoTrades:
+		trades, err := views.trades()
+		if err != nil {
+			return nil, err
+		}
+		last := e.firstSeen[instanceID]
+		traded := false
+		for _, trade := range trades {
+			if !trade.Timestamp.IsZero() && (!traded || trade.Timestamp.After(last)) {
+				last, traded = trade.Timestamp, true
+			}
+		}
+		if quiet := now.Sub(last); quiet > rule.QuietFor {
+			message := fmt.Sprintf("No trades for %s", quiet.Round(time.Second))
+			if traded {
+				message += fmt.Sprintf(", the last at %s", last.Local().Format("2006-01-02 15:04:05"))
+			}
+			return fire("", message), nil
+		}
+
+	case live.AlertHealth:
+		report, err := views.healthReport()
+		if err != nil {
+			return fire("", fmt.Sprintf("Health check failed: %v", err)), nil
+		}
+		if problems := healthProblems(report); len(problems) > 0 {
+			return fire("", "Health degraded: "+strings.Join(problems, "; ")), nil
+		}
+
+	case live.AlertLatency:
+		stats, err := views.profiling()
+		if err != nil {
+			return nil, err
+		}
+		if stats.TotalRuns > 0 && stats.P99 > rule.MaxP99 {
+			return fire("", fmt.Sprintf("p99 execution time %s is above %s", stats.P99.Round(time.Microsecond), rule.MaxP99)), nil
+		}
+
+	case live.AlertPosition:
+		execution, err := views.positions()
+		if err != nil {
+			return nil, err
+		}
+		var alerts []firingAlert
+		for _, position := range live.NetPositions(execution) {
+			if rule.Asset != "" && !strings.EqualFold(position.Symbol, rule.Asset) {
+				continue
+			}
+			quantity := position.Quantity.Abs().InexactFloat64()
+			if quantity > rule.MaxPosition {
+				message := fmt.Sprintf("Net position of %s %s on %s is beyond %g", position.Quantity.String(), position.Symbol, position.Exchange, rule.MaxPosition)
+				alerts = append(alerts, fire(position.Symbol, message)...)
+			}
+		}
+		return alerts, nil
+	}
+
+	return nil, nil
+}
+
+// crashes returns an alert for every crash recorded since the last evaluation, for each crashed rule matching it
+func (e *Engine) crashes(now time.Time) ([]firingAlert, error) {
+	var rules []*live.AlertRule
+	for i := range e.cfg.Rules {
+		if e.cfg.Rules[i].Kind == live.AlertCrashed {
+			rules = append(rules, &e.cfg.Rules[i])
+		}
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	recorded, err := e.state.ListEvents(live.HistoryFilter{Since: e.crashesSince})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read events: %w", err)
+	}
+
+	var firing []firingAlert
+	// ListEvents returns newest first
+	for i := len(recorded) - 1; i >= 0; i-- {
+		event := recorded[i]
+		if event.Type != live.EventCrashed || !event.Time.After(e.crashesSince) {
+			continue
+		}
+		for _, rule := range rules {
+			if !matches(rule.Strategies, event.StrategyName) {
+				continue
+			}
+			message := "Crashed"
+			if event.Reason != "" {
+				message += ": " + event.Reason
+			}
+			alert := newAlert(rule, event.InstanceID, "", message, now)
+			alert.StrategyName = event.StrategyName
+			alert.Since = event.Time
+			firing = append(firing, firingAlert{alert: alert, instant: true})
+		}
+	}
+
+	if len(recorded) > 0 && recorded[0].Time.After(e.crashesSince) {
+		e.crashesSince = recorded[0].Time
+	}
+	return firing, nil
+}
+
+// silenced reports whether a silence holds back alert at now
+func (e *Engine) silenced(alert *live.Alert, now time.Time) bool {
+	for _, s := range e.silences {
+		if len(s.Rules) > 0 && !slices.Contains(s.Rules, alert.Rule) {
+			continue
+		}
+		if len(s.Strategies) > 0 && !slices.Contains(s.Strategies, alert.StrategyName) && !slices.Contains(s.Strategies, alert.InstanceID) {
+			continue
+		}
+		if now.Before(s.From) || (!s.Until.IsZero() && !now.Before(s.Until)) {
+			continue
+		}
+		if s.plan != nil && !s.plan.Open(now) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+func newAlert(rule *live.AlertRule, instanceID, asset, message string, now time.Time) *live.Alert {
+	return &live.Alert{
+		Rule:         rule.Name,
+		Kind:         rule.Kind,
+		Severity:     rule.Severity,
+		Status:       live.AlertFiring,
+		InstanceID:   instanceID,
+		StrategyName: instanceID,
+		Asset:        asset,
+		Message:      message,
+		Time:         now,
+		Sinks:        rule.Sinks,
+	}
+}
+
+// healthProblems lists what a health report says is wrong
+func healthProblems(report *health.SystemHealthReport) []string {
+	var problems []string
+	if report.OverallState != "" && report.OverallState != health.StateConnected {
+		problems = append(problems, fmt.Sprintf("state %s", report.OverallState))
+	}
+	if report.ConnectorErrors != nil {
+		for _, name := range sortedKeys(report.ConnectorErrors.Errors) {
+			problems = append(problems, fmt.Sprintf("%s %s", name, report.ConnectorErrors.Errors[name].State))
+		}
+	}
+	if report.DataFlowErrors != nil {
+		for _, name := range sortedKeys(report.DataFlowErrors.Errors) {
+			problems = append(problems, fmt.Sprintf("%s %s data failing", name, strings.Join(sortedKeys(report.DataFlowErrors.Errors[name]), ", ")))
+		}
+	}
+	if len(problems) == 0 && report.HasErrors {
+		problems = append(problems, "errors reported")
+	}
+	return problems
+}
+
+// matches reports whether a rule or silence limited to strategies applies to name
+func matches(strategies []string, name string) bool {
+	return len(strategies) == 0 || slices.Contains(strategies, name)
+}
+
+func unknownKey(rule, instanceID string) string {
+	return rule + "\x00" + instanceID
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// instanceViews queries each view of an instance at most once per evaluation, however many rules need it
+type instanceViews struct {
+	querier    monitoring.ViewQuerier
+	health     live.HealthQuerier
+	instanceID string
+
+	pnlView      once[*monitoring.PnLView]
+	tradesView   once[[]connector.Trade]
+	healthView   once[*health.SystemHealthReport]
+	statsView    once[*monitoring.ProfilingStats]
+	positionView once[*strategy.StrategyExecution]
+}
+
+// once keeps the result of the first query
+type once[T any] struct {
+	value T
+	err   error
+	done  bool
+}
+
+func (o *once[T]) get(query func() (T, error)) (T, error) {
+	if !o.done {
+		o.value, o.err = query()
+		o.done = true
+	}
+	return o.value, o.err
+}
+
+func (v *instanceViews) pnl() (*monitoring.PnLView, error) {
+	return v.pnlView.get(func() (*monitoring.PnLView, error) { return v.querier.QueryPnL(v.instanceID) })
+}
+
+func (v *instanceViews) trades() ([]connector.Trade, error) {
+	return v.tradesView.get(func() ([]connector.Trade, error) { return v.querier.QueryRecentTrades(v.instanceID, 1) })
+}
+
+func (v *instanceViews) healthReport() (*health.SystemHealthReport, error) {
+	return v.healthView.get(func() (*health.SystemHealthReport, error) { return v.health.QueryHealth(v.instanceID) })
+}
+
+func (v *instanceViews) profiling() (*monitoring.ProfilingStats, error) {
+	return v.statsView.get(func() (*monitoring.ProfilingStats, error) { return v.querier.QueryProfilingStats(v.instanceID) })
+}
+
+func (v *instanceViews) positions() (*strategy.StrategyExecution, error) {
+	return v.positionView.get(func() (*strategy.StrategyExecution, error) { return v.querier.QueryPositions(v.instanceID) })
+}
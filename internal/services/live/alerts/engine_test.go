@@ -0,0 +1,177 @@
+package alerts_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/alerts"
+	livemocks "github.com/backtesting-org/kronos-cli/mocks/github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	monitoringmocks "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+)
+
+var _ = Describe("Engine", func() {
+	var (
+		querier *monitoringmocks.ViewQuerier
+		health  *livemocks.HealthQuerier
+		state   *livemocks.StateStore
+		cfg     *live.AlertConfig
+		start   time.Time
+	)
+
+	BeforeEach(func() {
+		querier = monitoringmocks.NewViewQuerier(GinkgoT())
+		health = livemocks.NewHealthQuerier(GinkgoT())
+		state = livemocks.NewStateStore(GinkgoT())
+		start = time.Date(2025, 3, 3, 12, 0, 0, 0, time.UTC)
+
+		cfg = &live.AlertConfig{
+			Sinks: []live.AlertSinkConfig{{Name: "me", Type: live.SinkDesktop}},
+		}
+		querier.EXPECT().ListInstances().Return([]string{"momentum"}, nil).Maybe()
+	})
+
+	newEngine := func() *alerts.Engine {
+		Expect(alerts.Validate(cfg)).To(Succeed())
+		return alerts.NewEngine(cfg, querier, health, state, start)
+	}
+
+	pnl := func(total int64) *monitoring.PnLView {
+		return &monitoring.PnLView{TotalPnL: numerical.NewFromInt(total)}
+	}
+
+	evaluate := func(engine *alerts.Engine, at time.Time) []*live.Alert {
+		due, err := engine.Evaluate(context.Background(), at)
+		Expect(err).NotTo(HaveOccurred())
+		return due
+	}
+
+	Describe("deduplication", func() {
+		BeforeEach(func() {
+			cfg.RepeatAfter = time.Hour
+			cfg.SendResolved = true
+			cfg.Rules = []live.AlertRule{{Name: "drawdown", Kind: live.AlertDrawdown, MaxDrawdown: 100}}
+		})
+
+		It("sends a firing alert once, again after repeat_after, and when it resolves", func() {
+			querier.EXPECT().QueryPnL("momentum").Return(pnl(500), nil).Once()
+			querier.EXPECT().QueryPnL("momentum").Return(pnl(300), nil).Times(3)
+			querier.EXPECT().QueryPnL("momentum").Return(pnl(450), nil).Once()
+			engine := newEngine()
+
+			Expect(evaluate(engine, start)).To(BeEmpty())
+
+			due := evaluate(engine, start.Add(time.Minute))
+			Expect(due).To(HaveLen(1))
+			Expect(due[0].Status).To(Equal(live.AlertFiring))
+			Expect(due[0].Message).To(ContainSubstring("200.00 below its peak of 500.00"))
+
+			Expect(evaluate(engine, start.Add(30*time.Minute))).To(BeEmpty())
+
+			due = evaluate(engine, start.Add(time.Minute+time.Hour))
+			Expect(due).To(HaveLen(1))
+			Expect(due[0].Since).To(Equal(start.Add(time.Minute)))
+
+			due = evaluate(engine, start.Add(2*time.Hour))
+			Expect(due).To(HaveLen(1))
+			Expect(due[0].Status).To(Equal(live.AlertResolved))
+		})
+
+		It("keeps an alert firing while its instance can't be queried", func() {
+			querier.EXPECT().QueryPnL("momentum").Return(pnl(500), nil).Once()
+			querier.EXPECT().QueryPnL("momentum").Return(pnl(300), nil).Once()
+			querier.EXPECT().QueryPnL("momentum").Return(nil, errors.New("timeout")).Once()
+			querier.EXPECT().QueryPnL("momentum").Return(pnl(300), nil).Once()
+			engine := newEngine()
+
+			evaluate(engine, start)
+			Expect(evaluate(engine, start.Add(time.Minute))).To(HaveLen(1))
+			Expect(evaluate(engine, start.Add(2*time.Minute))).To(BeEmpty())
+			Expect(evaluate(engine, start.Add(3*time.Minute))).To(BeEmpty())
+		})
+	})
+
+	Describe("silences", func() {
+		BeforeEach(func() {
+			cfg.Rules = []live.AlertRule{{Name: "quiet", Kind: live.AlertNoTrades, QuietFor: 10 * time.Minute}}
+			cfg.Silences = []live.AlertSilence{{
+				Rules: []string{"quiet"},
+				Until: start.Add(time.Hour),
+			}}
+			querier.EXPECT().QueryRecentTrades("momentum", 1).Return(nil, nil)
+		})
+
+		It("holds an alert back until the silence ends", func() {
+			engine := newEngine()
+
+			Expect(evaluate(engine, start)).To(BeEmpty())
+			Expect(evaluate(engine, start.Add(30*time.Minute))).To(BeEmpty())
+
+			due := evaluate(engine, start.Add(time.Hour))
+			Expect(due).To(HaveLen(1))
+			Expect(due[0].Message).To(Equal("No trades for 1h0m0s"))
+			Expect(due[0].Since).To(Equal(start.Add(30 * time.Minute)))
+		})
+	})
+
+	It("measures no_trades from the last trade", func() {
+		cfg.Rules = []live.AlertRule{{Name: "quiet", Kind: live.AlertNoTrades, QuietFor: 10 * time.Minute}}
+		querier.EXPECT().QueryRecentTrades("momentum", 1).Return([]connector.Trade{{Timestamp: start.Add(-5 * time.Minute)}}, nil)
+		engine := newEngine()
+
+		Expect(evaluate(engine, start)).To(BeEmpty())
+		Expect(evaluate(engine, start.Add(6*time.Minute))).To(HaveLen(1))
+	})
+
+	It("alerts on the net position of an asset beyond the limit", func() {
+		cfg.Rules = []live.AlertRule{{Name: "btc", Kind: live.AlertPosition, MaxPosition: 1, Asset: "BTC"}}
+		trade := func(symbol string, side connector.OrderSide, qty int64) connector.Trade {
+			return connector.Trade{Symbol: symbol, Exchange: "binance", Side: side, Quantity: numerical.NewFromInt(qty)}
+		}
+		querier.EXPECT().QueryPositions("momentum").Return(&strategy.StrategyExecution{Trades: []connector.Trade{
+			trade("BTC", connector.OrderSideBuy, 3),
+			trade("BTC", connector.OrderSideSell, 1),
+			trade("ETH", connector.OrderSideBuy, 50),
+		}}, nil)
+
+		due := evaluate(newEngine(), start)
+		Expect(due).To(HaveLen(1))
+		Expect(due[0].Asset).To(Equal("BTC"))
+		Expect(due[0].Message).To(ContainSubstring("Net position of 2 BTC on binance"))
+	})
+
+	It("reports a health check that fails", func() {
+		cfg.Rules = []live.AlertRule{{Name: "down", Kind: live.AlertHealth, Severity: live.SeverityCritical}}
+		health.EXPECT().QueryHealth("momentum").Return(nil, errors.New("socket does not exist"))
+
+		due := evaluate(newEngine(), start)
+		Expect(due).To(HaveLen(1))
+		Expect(due[0].Severity).To(Equal(live.SeverityCritical))
+		Expect(due[0].Message).To(Equal("Health check failed: socket does not exist"))
+	})
+
+	It("reports each crash once", func() {
+		cfg.Rules = []live.AlertRule{{Name: "crash", Kind: live.AlertCrashed, Strategies: []string{"momentum"}}}
+		crashed := &live.Event{Time: start.Add(-time.Second), Type: live.EventCrashed, StrategyName: "momentum", InstanceID: "abc", Reason: "oom"}
+		other := &live.Event{Time: start.Add(-time.Second), Type: live.EventCrashed, StrategyName: "arbitrage"}
+		state.EXPECT().ListEvents(mock.Anything).Return([]*live.Event{crashed, other}, nil).Once()
+		state.EXPECT().ListEvents(mock.Anything).Return([]*live.Event{crashed}, nil).Once()
+		engine := newEngine()
+
+		due := evaluate(engine, start)
+		Expect(due).To(HaveLen(1))
+		Expect(due[0].InstanceID).To(Equal("abc"))
+		Expect(due[0].Message).To(Equal("Crashed: oom"))
+
+		Expect(evaluate(engine, start.Add(time.Minute))).To(BeEmpty())
+	})
+})
@@ -0,0 +1,148 @@
+package alerts
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/schedule"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// DefaultInterval is how often rules are evaluated unless the alerts file says otherwise
+	DefaultInterval = 30 * time.Second
+
+	// DefaultRepeatAfter is how long an alert keeps firing before it is sent again
+	DefaultRepeatAfter = time.Hour
+)
+
+// DefaultPath returns ~/.kronos/alerts.yml
+func DefaultPath() string {
+	homeDir, _ := os.UserHomeDir()
+	return filepath.Join(homeDir, ".kronos", "alerts.yml")
+}
+
+// LoadConfig reads and validates an alerts file, filling in the defaults
+func LoadConfig(path string) (*live.AlertConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no alerts file at %s", path)
+		}
+		return nil, fmt.Errorf("failed to read alerts: %w", err)
+	}
+
+	var cfg live.AlertConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse alerts %s: %w", path, err)
+	}
+	if err := Validate(&cfg); err != nil {
+		return nil, fmt.Errorf("alerts %s: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+// Validate checks every rule, sink and silence of cfg and fills in the defaults
+func Validate(cfg *live.AlertConfig) error {
+	if cfg.Interval == 0 {
+		cfg.Interval = DefaultInterval
+	}
+	if cfg.Interval < 0 {
+		return fmt.Errorf("interval must be positive")
+	}
+	if cfg.RepeatAfter == 0 {
+		cfg.RepeatAfter = DefaultRepeatAfter
+	}
+
+	sinks := make(map[string]bool, len(cfg.Sinks))
+	for i, sink := range cfg.Sinks {
+		if sink.Name == "" {
+			return fmt.Errorf("sink %d has no name", i+1)
+		}
+		if sinks[sink.Name] {
+			return fmt.Errorf("sink %s is listed twice", sink.Name)
+		}
+		sinks[sink.Name] = true
+		if _, err := NewSink(sink); err != nil {
+			return fmt.Errorf("sink %s: %w", sink.Name, err)
+		}
+	}
+	if len(cfg.Sinks) == 0 {
+		return fmt.Errorf("no sinks configured")
+	}
+
+	rules := make(map[string]bool, len(cfg.Rules))
+	for i := range cfg.Rules {
+		rule := &cfg.Rules[i]
+		if rule.Name == "" {
+			return fmt.Errorf("rule %d has no name", i+1)
+		}
+		if rules[rule.Name] {
+			return fmt.Errorf("rule %s is listed twice", rule.Name)
+		}
+		rules[rule.Name] = true
+
+		if err := validateRule(rule); err != nil {
+			return fmt.Errorf("rule %s: %w", rule.Name, err)
+		}
+		for _, name := range rule.Sinks {
+			if !sinks[name] {
+				return fmt.Errorf("rule %s sends to %s, which isn't a configured sink", rule.Name, name)
+			}
+		}
+	}
+
+	for i, silence := range cfg.Silences {
+		for _, name := range silence.Rules {
+			if !rules[name] {
+				return fmt.Errorf("silence %d names rule %s, which isn't configured", i+1, name)
+			}
+		}
+		if !silence.Until.IsZero() && silence.Until.Before(silence.From) {
+			return fmt.Errorf("silence %d ends before it starts", i+1)
+		}
+		if silence.Schedule != nil {
+			if _, err := schedule.Compile(silence.Schedule); err != nil {
+				return fmt.Errorf("silence %d: %w", i+1, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateRule(rule *live.AlertRule) error {
+	switch rule.Severity {
+	case "":
+		rule.Severity = live.SeverityWarning
+	case live.SeverityWarning, live.SeverityCritical:
+	default:
+		return fmt.Errorf("unknown severity %q, expected warning or critical", rule.Severity)
+	}
+
+	switch rule.Kind {
+	case live.AlertDrawdown:
+		if rule.MaxDrawdown <= 0 {
+			return fmt.Errorf("max_drawdown must be positive")
+		}
+	case live.AlertNoTrades:
+		if rule.QuietFor <= 0 {
+			return fmt.Errorf("quiet_for must be positive")
+		}
+	case live.AlertLatency:
+		if rule.MaxP99 <= 0 {
+			return fmt.Errorf("max_p99 must be positive")
+		}
+	case live.AlertPosition:
+		if rule.MaxPosition <= 0 {
+			return fmt.Errorf("max_position must be positive")
+		}
+	case live.AlertHealth, live.AlertCrashed:
+	default:
+		return fmt.Errorf("unknown kind %q, expected drawdown, no_trades, health, latency, crashed or position", rule.Kind)
+	}
+	return nil
+}
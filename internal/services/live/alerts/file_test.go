@@ -0,0 +1,99 @@
+package alerts_test
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/alerts"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+var _ = Describe("LoadConfig", func() {
+	var path string
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "alerts.yml")
+	})
+
+	write := func(content string) {
+		Expect(os.WriteFile(path, []byte(content), 0644)).To(Succeed())
+	}
+
+	It("reads rules, sinks and silences and fills in the defaults", func() {
+		write(`
+rules:
+  - name: quiet
+    kind: no_trades
+    quiet_for: 30m
+    sinks: [ops]
+sinks:
+  - name: ops
+    type: webhook
+    url: http://localhost:9000/hook
+silences:
+  - rules: [quiet]
+    schedule:
+      rules:
+        - start: "0 16 * * *"
+          stop: "30 9 * * *"
+`)
+		cfg, err := alerts.LoadConfig(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Interval).To(Equal(alerts.DefaultInterval))
+		Expect(cfg.RepeatAfter).To(Equal(alerts.DefaultRepeatAfter))
+		Expect(cfg.Rules[0].QuietFor).To(Equal(30 * time.Minute))
+		Expect(cfg.Rules[0].Severity).To(Equal(live.SeverityWarning))
+		Expect(cfg.Silences[0].Schedule.Rules).To(HaveLen(1))
+	})
+
+	DescribeTable("rejects invalid files",
+		func(content, message string) {
+			write(content)
+			_, err := alerts.LoadConfig(path)
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("no sinks", `
+rules:
+  - {name: down, kind: health}
+`, "no sinks configured"),
+		Entry("unknown kind", `
+rules:
+  - {name: odd, kind: moon_phase}
+sinks:
+  - {name: me, type: desktop}
+`, `unknown kind "moon_phase"`),
+		Entry("missing threshold", `
+rules:
+  - {name: dd, kind: drawdown}
+sinks:
+  - {name: me, type: desktop}
+`, "max_drawdown must be positive"),
+		Entry("unknown sink in a rule", `
+rules:
+  - {name: down, kind: health, sinks: [pager]}
+sinks:
+  - {name: me, type: desktop}
+`, "pager, which isn't a configured sink"),
+		Entry("webhook without a url", `
+sinks:
+  - {name: ops, type: webhook}
+`, "webhook sink needs a url"),
+		Entry("silence of an unknown rule", `
+rules:
+  - {name: down, kind: health}
+sinks:
+  - {name: me, type: desktop}
+silences:
+  - rules: [quiet]
+`, "names rule quiet"),
+	)
+
+	It("says where it looked when there is no file", func() {
+		_, err := alerts.LoadConfig(path)
+		Expect(err).To(MatchError(ContainSubstring("no alerts file at " + path)))
+	})
+})
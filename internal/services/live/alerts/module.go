@@ -0,0 +1,10 @@
+package alerts
+
+import "go.uber.org/fx"
+
+// Module provides the alerter via Fx
+var Module = fx.Module("live/alerts",
+	fx.Provide(
+		NewAlerter,
+	),
+)
@@ -0,0 +1,189 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/smtp"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+// sinkTimeout bounds delivering one notification through one sink
+const sinkTimeout = 10 * time.Second
+
+// NewSink creates the sink a sink config describes
+func NewSink(cfg live.AlertSinkConfig) (live.AlertSink, error) {
+	switch cfg.Type {
+	case live.SinkWebhook:
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("webhook sink needs a url")
+		}
+		return &webhookSink{url: cfg.URL, headers: cfg.Headers, client: &http.Client{Timeout: sinkTimeout}}, nil
+
+	case live.SinkSMTP:
+		if cfg.Host == "" || cfg.From == "" || len(cfg.To) == 0 {
+			return nil, fmt.Errorf("smtp sink needs a host, from and to")
+		}
+		if _, _, err := net.SplitHostPort(cfg.Host); err != nil {
+			return nil, fmt.Errorf("smtp host must be host:port: %w", err)
+		}
+		return &smtpSink{cfg: cfg}, nil
+
+	case live.SinkDesktop:
+		return &desktopSink{}, nil
+
+	case live.SinkCommand:
+		if cfg.Command == "" {
+			return nil, fmt.Errorf("command sink needs a command")
+		}
+		return &commandSink{command: cfg.Command}, nil
+	}
+
+	return nil, fmt.Errorf("unknown sink type %q, expected webhook, smtp, desktop or command", cfg.Type)
+}
+
+// Title is the one-line summary of an alert sinks use as a subject
+func Title(alert *live.Alert) string {
+	title := fmt.Sprintf("[%s] %s: %s", strings.ToUpper(string(alert.Severity)), alert.StrategyName, alert.Rule)
+	if alert.Status == live.AlertResolved {
+		title = fmt.Sprintf("[RESOLVED] %s: %s", alert.StrategyName, alert.Rule)
+	}
+	return title
+}
+
+// webhookSink POSTs alerts as JSON
+type webhookSink struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func (s *webhookSink) Send(ctx context.Context, alert *live.Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return fmt.Errorf("failed to encode alert: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range s.headers {
+		req.Header.Set(name, os.ExpandEnv(value))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("webhook returned status %d: %s", resp.StatusCode, bytes.TrimSpace(msg))
+	}
+	return nil
+}
+
+// smtpSink emails alerts
+type smtpSink struct {
+	cfg live.AlertSinkConfig
+}
+
+func (s *smtpSink) Send(ctx context.Context, alert *live.Alert) error {
+	var auth smtp.Auth
+	if s.cfg.Username != "" {
+		host, _, _ := net.SplitHostPort(s.cfg.Host)
+		auth = smtp.PlainAuth("", s.cfg.Username, os.Getenv(s.cfg.PasswordEnv), host)
+	}
+
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", s.cfg.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(s.cfg.To, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", Title(alert))
+	fmt.Fprintf(&msg, "Date: %s\r\n", alert.Time.Format(time.RFC1123Z))
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	fmt.Fprintf(&msg, "%s\r\n\r\n", alert.Message)
+	fmt.Fprintf(&msg, "Instance: %s\r\nRule: %s (%s)\r\nFiring since: %s\r\n",
+		alert.InstanceID, alert.Rule, alert.Kind, alert.Since.Format(time.RFC3339))
+
+	// net/smtp takes no context, so a hung server is cut off by running it aside
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(s.cfg.Host, auth, s.cfg.From, s.cfg.To, []byte(msg.String()))
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("failed to send email: %w", err)
+		}
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to send email: %w", ctx.Err())
+	}
+}
+
+// desktopSink shows alerts as desktop notifications
+type desktopSink struct{}
+
+func (s *desktopSink) Send(ctx context.Context, alert *live.Alert) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		script := fmt.Sprintf("display notification %q with title %q", alert.Message, Title(alert))
+		cmd = exec.CommandContext(ctx, "osascript", "-e", script)
+	default:
+		urgency := "normal"
+		if alert.Severity == live.SeverityCritical && alert.Status == live.AlertFiring {
+			urgency = "critical"
+		}
+		cmd = exec.CommandContext(ctx, "notify-send", "--urgency", urgency, "--app-name", "kronos", Title(alert), alert.Message)
+	}
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("desktop notification failed: %w: %s", err, bytes.TrimSpace(output))
+	}
+	return nil
+}
+
+// commandSink runs a shell command for each alert
+type commandSink struct {
+	command string
+}
+
+func (s *commandSink) Send(ctx context.Context, alert *live.Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return fmt.Errorf("failed to encode alert: %w", err)
+	}
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", s.command)
+	cmd.Stdin = bytes.NewReader(body)
+	cmd.Env = append(os.Environ(),
+		"KRONOS_ALERT_RULE="+alert.Rule,
+		"KRONOS_ALERT_KIND="+string(alert.Kind),
+		"KRONOS_ALERT_SEVERITY="+string(alert.Severity),
+		"KRONOS_ALERT_STATUS="+string(alert.Status),
+		"KRONOS_ALERT_INSTANCE="+alert.InstanceID,
+		"KRONOS_ALERT_STRATEGY="+alert.StrategyName,
+		"KRONOS_ALERT_ASSET="+alert.Asset,
+		"KRONOS_ALERT_MESSAGE="+alert.Message,
+		"KRONOS_ALERT_TITLE="+Title(alert),
+	)
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("alert command failed: %w: %s", err, bytes.TrimSpace(output))
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package alerts_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/alerts"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+var _ = Describe("Sinks", func() {
+	alert := &live.Alert{
+		Rule:         "drawdown",
+		Kind:         live.AlertDrawdown,
+		Severity:     live.SeverityCritical,
+		Status:       live.AlertFiring,
+		InstanceID:   "momentum",
+		StrategyName: "momentum",
+		Message:      "PnL -120.00 is 620.00 below its peak of 500.00, more than 500.00",
+		Time:         time.Now(),
+	}
+
+	Describe("webhook", func() {
+		It("posts the alert as JSON with the configured headers", func() {
+			received := make(chan *http.Request, 1)
+			var body live.Alert
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				received <- r
+			}))
+			DeferCleanup(server.Close)
+
+			GinkgoT().Setenv("HOOK_TOKEN", "secret")
+			sink, err := alerts.NewSink(live.AlertSinkConfig{
+				Name:    "ops",
+				Type:    live.SinkWebhook,
+				URL:     server.URL,
+				Headers: map[string]string{"Authorization": "Bearer $HOOK_TOKEN"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sink.Send(context.Background(), alert)).To(Succeed())
+
+			var req *http.Request
+			Eventually(received).Should(Receive(&req))
+			Expect(req.Method).To(Equal(http.MethodPost))
+			Expect(req.Header.Get("Authorization")).To(Equal("Bearer secret"))
+			Expect(body.Rule).To(Equal("drawdown"))
+			Expect(body.Status).To(Equal(live.AlertFiring))
+		})
+
+		It("fails when the receiver rejects the alert", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "bad token", http.StatusUnauthorized)
+			}))
+			DeferCleanup(server.Close)
+
+			sink, err := alerts.NewSink(live.AlertSinkConfig{Name: "ops", Type: live.SinkWebhook, URL: server.URL})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sink.Send(context.Background(), alert)).To(MatchError(ContainSubstring("status 401: bad token")))
+		})
+	})
+
+	Describe("command", func() {
+		It("runs the command with the alert in its environment and on stdin", func() {
+			out := filepath.Join(GinkgoT().TempDir(), "out")
+			sink, err := alerts.NewSink(live.AlertSinkConfig{
+				Name:    "script",
+				Type:    live.SinkCommand,
+				Command: `printf '%s|%s|' "$KRONOS_ALERT_RULE" "$KRONOS_ALERT_SEVERITY" > ` + out + ` && cat >> ` + out,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sink.Send(context.Background(), alert)).To(Succeed())
+
+			written, err := os.ReadFile(out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(written)).To(HavePrefix("drawdown|critical|{"))
+			Expect(string(written)).To(ContainSubstring(`"instance_id":"momentum"`))
+		})
+
+		It("fails with the command's output", func() {
+			sink, err := alerts.NewSink(live.AlertSinkConfig{Name: "script", Type: live.SinkCommand, Command: "echo no route >&2; exit 3"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sink.Send(context.Background(), alert)).To(MatchError(ContainSubstring("no route")))
+		})
+	})
+})
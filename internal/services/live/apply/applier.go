@@ -0,0 +1,222 @@
+package apply
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	liveService "github.com/backtesting-org/kronos-cli/internal/services/live"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/binary"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/config"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+)
+
+// DefaultFile is where the desired state is read from, relative to the project root
+const DefaultFile = "instances.yml"
+
+// strategiesDir is where strategies and their variants live, relative to the project root
+const strategiesDir = "strategies"
+
+type applier struct {
+	dir        string
+	manager    live.InstanceManager
+	service    liveService.LiveService
+	strategies config.StrategyConfig
+	logger     logging.ApplicationLogger
+}
+
+// NewApplier creates an Applier for the project in the working directory.
+// Instances are started like any other strategy, pre-flight checks included.
+func NewApplier(
+	manager live.InstanceManager,
+	service liveService.LiveService,
+	strategies config.StrategyConfig,
+	logger logging.ApplicationLogger,
+) live.Applier {
+	return NewApplierAt(strategiesDir, manager, service, strategies, logger)
+}
+
+// NewApplierAt creates an Applier for strategies kept in a custom directory
+func NewApplierAt(
+	dir string,
+	manager live.InstanceManager,
+	service liveService.LiveService,
+	strategies config.StrategyConfig,
+	logger logging.ApplicationLogger,
+) live.Applier {
+	return &applier{
+		dir:        dir,
+		manager:    manager,
+		service:    service,
+		strategies: strategies,
+		logger:     logger,
+	}
+}
+
+func (a *applier) Plan(desired *live.DesiredState) (*live.Plan, error) {
+	if err := Validate(desired); err != nil {
+		return nil, err
+	}
+
+	instances, err := a.manager.List(live.StatusRunning)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list running instances: %w", err)
+	}
+	running := make(map[string]*live.Instance, len(instances))
+	for _, instance := range instances {
+		running[instance.StrategyName] = instance
+	}
+
+	declared := make(map[string]bool, len(desired.Instances))
+	var stops, rest []*live.PlanStep
+
+	for i := range desired.Instances {
+		instance := &desired.Instances[i]
+		declared[instance.Name] = true
+
+		step, err := a.planInstance(instance, running[instance.Name])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", instance.Name, err)
+		}
+		rest = append(rest, step)
+	}
+
+	// Anything running that isn't declared goes, stopped before the rest start so they don't compete for the same accounts
+	var undeclared []string
+	for name := range running {
+		if !declared[name] {
+			undeclared = append(undeclared, name)
+		}
+	}
+	sort.Strings(undeclared)
+	for _, name := range undeclared {
+		stops = append(stops, &live.PlanStep{
+			Action: live.PlanStop,
+			Name:   name,
+			Reason: "not in desired state",
+		})
+	}
+
+	return &live.Plan{Steps: append(stops, rest...)}, nil
+}
+
+// planInstance decides what converging one declared instance takes, given its running instance if there is one
+func (a *applier) planInstance(instance *live.DesiredInstance, current *live.Instance) (*live.PlanStep, error) {
+	step := &live.PlanStep{
+		Name:     instance.Name,
+		Strategy: instance.Strategy,
+		Desired:  instance,
+	}
+
+	if _, err := os.Stat(filepath.Join(a.dir, instance.Strategy, configFile)); err != nil {
+		return nil, fmt.Errorf("strategy %s not found in %s", instance.Strategy, a.dir)
+	}
+
+	newVariant := false
+	if instance.IsVariant() {
+		exists, err := a.checkVariant(instance)
+		if err != nil {
+			return nil, err
+		}
+		newVariant = !exists
+	}
+
+	configChanged := false
+	if !newVariant {
+		_, _, changed, err := a.desiredConfig(instance)
+		if err != nil {
+			return nil, err
+		}
+		configChanged = changed
+	}
+
+	switch {
+	case current == nil && newVariant:
+		step.Action, step.Reason = live.PlanStart, fmt.Sprintf("new variant of %s", instance.Strategy)
+	case current == nil && configChanged:
+		step.Action, step.Reason = live.PlanStart, "not running, parameters changed"
+	case current == nil:
+		step.Action, step.Reason = live.PlanStart, "not running"
+	case configChanged:
+		step.Action, step.Reason = live.PlanRestart, "parameters changed"
+	case a.startedWithOtherConfig(current):
+		step.Action, step.Reason = live.PlanRestart, "config.yml changed since it started"
+	default:
+		step.Action, step.Reason = live.PlanKeep, "running as declared"
+	}
+	return step, nil
+}
+
+// startedWithOtherConfig reports whether a running instance loaded a different config.yml than the one on disk.
+// Instances recorded before config hashes were kept can't tell, so they are left alone.
+func (a *applier) startedWithOtherConfig(instance *live.Instance) bool {
+	if instance.ConfigHash == "" {
+		return false
+	}
+	hash, err := binary.Hash(filepath.Join(a.dir, instance.StrategyName, configFile))
+	return err == nil && hash != instance.ConfigHash
+}
+
+func (a *applier) Apply(ctx context.Context, plan *live.Plan, opts live.StopOptions) error {
+	var errs []error
+	for _, step := range plan.Steps {
+		if step.Action == live.PlanKeep {
+			continue
+		}
+		if ctx.Err() != nil {
+			step.Error = ctx.Err().Error()
+			errs = append(errs, fmt.Errorf("%s: %w", step.Name, ctx.Err()))
+			continue
+		}
+
+		if err := a.applyStep(ctx, step, opts); err != nil {
+			step.Error = err.Error()
+			errs = append(errs, fmt.Errorf("%s %s: %w", step.Action, step.Name, err))
+			continue
+		}
+		step.Done = true
+	}
+	return errors.Join(errs...)
+}
+
+func (a *applier) applyStep(ctx context.Context, step *live.PlanStep, opts live.StopOptions) error {
+	switch step.Action {
+	case live.PlanStop:
+		a.logger.Info("Stopping undeclared instance", "strategy", step.Name)
+		_, err := a.manager.StopStrategy(ctx, step.Name, opts)
+		return err
+
+	case live.PlanRestart:
+		// The config is written first so a bad declaration leaves the old instance running
+		if err := a.materialize(step.Desired); err != nil {
+			return err
+		}
+		a.logger.Info("Restarting instance", "strategy", step.Name, "reason", step.Reason)
+		if _, err := a.manager.StopStrategy(ctx, step.Name, opts); err != nil {
+			return fmt.Errorf("failed to stop: %w", err)
+		}
+		return a.start(ctx, step.Desired)
+
+	case live.PlanStart:
+		if err := a.materialize(step.Desired); err != nil {
+			return err
+		}
+		a.logger.Info("Starting instance", "strategy", step.Name, "reason", step.Reason)
+		return a.start(ctx, step.Desired)
+	}
+	return nil
+}
+
+func (a *applier) start(ctx context.Context, instance *live.DesiredInstance) error {
+	path := filepath.Join(a.dir, instance.Name)
+	strat, err := a.strategies.Load(filepath.Join(path, configFile))
+	if err != nil {
+		return err
+	}
+	strat.Path = path
+	return a.service.ExecuteStrategy(ctx, strat)
+}
@@ -0,0 +1,255 @@
+package apply_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/apply"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/binary"
+	livemocks "github.com/backtesting-org/kronos-cli/mocks/github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/config/strategy"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/config"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+)
+
+// fakeLiveService records the strategies it was asked to start and fails those it's told to
+type fakeLiveService struct {
+	started []*config.Strategy
+	fail    map[string]error
+}
+
+func (f *fakeLiveService) ExecuteStrategy(_ context.Context, strat *config.Strategy) error {
+	if err := f.fail[strat.Name]; err != nil {
+		return err
+	}
+	f.started = append(f.started, strat)
+	return nil
+}
+
+const momentumConfig = `# momentum strategy
+name: momentum
+exchanges: [binance]
+parameters:
+  lookback: 20 # bars
+  threshold: 0.5
+`
+
+var _ = Describe("Applier", func() {
+	var (
+		dir     string
+		manager *livemocks.InstanceManager
+		service *fakeLiveService
+		applier live.Applier
+		running []*live.Instance
+	)
+
+	writeStrategy := func(name, content string) {
+		path := filepath.Join(dir, name)
+		Expect(os.MkdirAll(path, 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(path, "config.yml"), []byte(content), 0644)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(path, "main.go"), []byte("package main\n"), 0644)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(path, name+".so"), []byte("plugin"), 0644)).To(Succeed())
+	}
+
+	runningAs := func(name string) *live.Instance {
+		hash, err := binary.Hash(filepath.Join(dir, name, "config.yml"))
+		Expect(err).NotTo(HaveOccurred())
+		instance := &live.Instance{ID: name + "-1", StrategyName: name, Status: live.StatusRunning, ConfigHash: hash}
+		running = append(running, instance)
+		return instance
+	}
+
+	actions := func(plan *live.Plan) map[string]live.PlanAction {
+		byName := make(map[string]live.PlanAction)
+		for _, step := range plan.Steps {
+			byName[step.Name] = step.Action
+		}
+		return byName
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		running = nil
+		manager = livemocks.NewInstanceManager(GinkgoT())
+		manager.EXPECT().List(live.StatusRunning).RunAndReturn(func(live.InstanceStatus) ([]*live.Instance, error) {
+			return running, nil
+		}).Maybe()
+		service = &fakeLiveService{fail: map[string]error{}}
+		applier = apply.NewApplierAt(dir, manager, service, strategy.NewStrategyConfigService(), &logging.NoOpLogger{})
+
+		writeStrategy("momentum", momentumConfig)
+		writeStrategy("grid", "name: grid\nparameters:\n  levels: 10\n")
+	})
+
+	Describe("Plan", func() {
+		It("starts what isn't running and stops what isn't declared, stops first", func() {
+			runningAs("grid")
+
+			plan, err := applier.Plan(&live.DesiredState{Instances: []live.DesiredInstance{{Strategy: "momentum"}}})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(plan.Steps).To(HaveLen(2))
+			Expect(plan.Steps[0].Action).To(Equal(live.PlanStop))
+			Expect(plan.Steps[0].Name).To(Equal("grid"))
+			Expect(plan.Steps[1].Action).To(Equal(live.PlanStart))
+			Expect(plan.Changes()).To(Equal(2))
+		})
+
+		It("keeps instances running as declared", func() {
+			runningAs("momentum")
+
+			plan, err := applier.Plan(&live.DesiredState{Instances: []live.DesiredInstance{
+				{Strategy: "momentum", Parameters: map[string]interface{}{"lookback": 20}},
+			}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actions(plan)).To(Equal(map[string]live.PlanAction{"momentum": live.PlanKeep}))
+			Expect(plan.Changes()).To(BeZero())
+		})
+
+		It("restarts instances whose parameters changed", func() {
+			runningAs("momentum")
+
+			plan, err := applier.Plan(&live.DesiredState{Instances: []live.DesiredInstance{
+				{Strategy: "momentum", Parameters: map[string]interface{}{"lookback": 50}},
+			}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.Steps[0].Action).To(Equal(live.PlanRestart))
+			Expect(plan.Steps[0].Reason).To(Equal("parameters changed"))
+		})
+
+		It("restarts instances whose config.yml was edited after they started", func() {
+			runningAs("momentum")
+			writeStrategy("momentum", momentumConfig+"description: edited\n")
+
+			plan, err := applier.Plan(&live.DesiredState{Instances: []live.DesiredInstance{{Strategy: "momentum"}}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.Steps[0].Action).To(Equal(live.PlanRestart))
+			Expect(plan.Steps[0].Reason).To(ContainSubstring("config.yml changed"))
+		})
+
+		It("leaves instances recorded without a config hash alone", func() {
+			runningAs("momentum").ConfigHash = ""
+			writeStrategy("momentum", momentumConfig+"description: edited\n")
+
+			plan, err := applier.Plan(&live.DesiredState{Instances: []live.DesiredInstance{{Strategy: "momentum"}}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.Steps[0].Action).To(Equal(live.PlanKeep))
+		})
+
+		It("plans new variants without touching the disk", func() {
+			plan, err := applier.Plan(&live.DesiredState{Instances: []live.DesiredInstance{
+				{Name: "momentum-fast", Strategy: "momentum", Parameters: map[string]interface{}{"lookback": 5}},
+			}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.Steps[0].Action).To(Equal(live.PlanStart))
+			Expect(plan.Steps[0].Reason).To(Equal("new variant of momentum"))
+			Expect(filepath.Join(dir, "momentum-fast")).NotTo(BeAnExistingFile())
+		})
+
+		It("refuses to turn an existing strategy into a variant", func() {
+			_, err := applier.Plan(&live.DesiredState{Instances: []live.DesiredInstance{
+				{Name: "grid", Strategy: "momentum"},
+			}})
+			Expect(err).To(MatchError(ContainSubstring("isn't a variant")))
+		})
+
+		It("fails on unknown strategies", func() {
+			_, err := applier.Plan(&live.DesiredState{Instances: []live.DesiredInstance{{Strategy: "missing"}}})
+			Expect(err).To(MatchError(ContainSubstring("strategy missing not found")))
+		})
+	})
+
+	Describe("Apply", func() {
+		It("writes parameters into config.yml, keeping comments, and starts the instance", func() {
+			plan, err := applier.Plan(&live.DesiredState{Instances: []live.DesiredInstance{
+				{Strategy: "momentum", Parameters: map[string]interface{}{"lookback": 50, "window": "1h"}},
+			}})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(applier.Apply(context.Background(), plan, live.StopOptions{})).To(Succeed())
+
+			data, err := os.ReadFile(filepath.Join(dir, "momentum", "config.yml"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).To(ContainSubstring("# momentum strategy"))
+			Expect(string(data)).To(ContainSubstring("lookback: 50 # bars"))
+			Expect(string(data)).To(ContainSubstring("threshold: 0.5"))
+			Expect(string(data)).To(ContainSubstring("window: 1h"))
+
+			Expect(service.started).To(HaveLen(1))
+			Expect(service.started[0].Parameters).To(HaveKeyWithValue("lookback", 50))
+			Expect(plan.Steps[0].Done).To(BeTrue())
+		})
+
+		It("leaves config.yml byte for byte alone when the parameters already match", func() {
+			plan, err := applier.Plan(&live.DesiredState{Instances: []live.DesiredInstance{
+				{Strategy: "momentum", Parameters: map[string]interface{}{"lookback": 20}},
+			}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(applier.Apply(context.Background(), plan, live.StopOptions{})).To(Succeed())
+
+			data, err := os.ReadFile(filepath.Join(dir, "momentum", "config.yml"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).To(Equal(momentumConfig))
+		})
+
+		It("creates variants linked to their strategy's code with their own config", func() {
+			plan, err := applier.Plan(&live.DesiredState{Instances: []live.DesiredInstance{
+				{Name: "momentum-fast", Strategy: "momentum", Parameters: map[string]interface{}{"lookback": 5}},
+			}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(applier.Apply(context.Background(), plan, live.StopOptions{})).To(Succeed())
+
+			variant := filepath.Join(dir, "momentum-fast")
+			target, err := os.Readlink(filepath.Join(variant, "main.go"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(target).To(Equal(filepath.Join("..", "momentum", "main.go")))
+			Expect(filepath.Join(variant, "momentum.so")).NotTo(BeAnExistingFile())
+
+			Expect(service.started).To(HaveLen(1))
+			Expect(service.started[0].Name).To(Equal("momentum-fast"))
+			Expect(service.started[0].Path).To(Equal(variant))
+			Expect(service.started[0].Parameters).To(HaveKeyWithValue("lookback", 5))
+			Expect(service.started[0].Parameters).To(HaveKeyWithValue("threshold", 0.5))
+
+			// Applied again, the variant is found on disk and needs nothing
+			runningAs("momentum-fast")
+			plan, err = applier.Plan(&live.DesiredState{Instances: []live.DesiredInstance{
+				{Name: "momentum-fast", Strategy: "momentum", Parameters: map[string]interface{}{"lookback": 5}},
+			}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.Steps[0].Action).To(Equal(live.PlanKeep))
+		})
+
+		It("stops before starting again on restart", func() {
+			runningAs("momentum")
+			manager.EXPECT().StopStrategy(mock.Anything, "momentum", mock.Anything).Return(&live.StopResult{}, nil).Once()
+
+			plan, err := applier.Plan(&live.DesiredState{Instances: []live.DesiredInstance{
+				{Strategy: "momentum", Parameters: map[string]interface{}{"lookback": 50}},
+			}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(applier.Apply(context.Background(), plan, live.StopOptions{})).To(Succeed())
+			Expect(service.started).To(HaveLen(1))
+		})
+
+		It("carries on past failed steps and reports them", func() {
+			runningAs("grid")
+			manager.EXPECT().StopStrategy(mock.Anything, "grid", mock.Anything).Return(nil, errors.New("socket gone")).Once()
+
+			plan, err := applier.Plan(&live.DesiredState{Instances: []live.DesiredInstance{{Strategy: "momentum"}}})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = applier.Apply(context.Background(), plan, live.StopOptions{})
+			Expect(err).To(MatchError(ContainSubstring("socket gone")))
+			Expect(plan.Steps[0].Error).To(ContainSubstring("socket gone"))
+			Expect(plan.Steps[1].Done).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,76 @@
+package apply
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"gopkg.in/yaml.v3"
+)
+
+// LoadDesiredState reads and validates a desired state file. Instances without a name are named after their strategy.
+func LoadDesiredState(path string) (*live.DesiredState, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no desired state file at %s", path)
+		}
+		return nil, fmt.Errorf("failed to read desired state: %w", err)
+	}
+
+	var desired live.DesiredState
+	if err := yaml.Unmarshal(data, &desired); err != nil {
+		return nil, fmt.Errorf("failed to parse desired state %s: %w", path, err)
+	}
+
+	if err := Validate(&desired); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &desired, nil
+}
+
+// Validate fills in instance names and rejects instances that can't be told apart or run
+func Validate(desired *live.DesiredState) error {
+	seen := make(map[string]bool, len(desired.Instances))
+	for i := range desired.Instances {
+		instance := &desired.Instances[i]
+		if instance.Strategy == "" {
+			return fmt.Errorf("instance %d has no strategy", i+1)
+		}
+		if instance.Name == "" {
+			instance.Name = instance.Strategy
+		}
+		if !validName(instance.Name) {
+			return fmt.Errorf("instance name %q must be a plain directory name", instance.Name)
+		}
+		if seen[instance.Name] {
+			return fmt.Errorf("instance %s is declared twice", instance.Name)
+		}
+		seen[instance.Name] = true
+	}
+
+	// A variant's strategy has to be real code, not another variant that may be removed under it
+	for _, instance := range desired.Instances {
+		if !instance.IsVariant() {
+			continue
+		}
+		for _, other := range desired.Instances {
+			if other.Name == instance.Strategy && other.IsVariant() {
+				return fmt.Errorf("%s is a variant of %s, which is itself a variant", instance.Name, instance.Strategy)
+			}
+		}
+	}
+	return nil
+}
+
+func validName(name string) bool {
+	if name == "." || name == ".." {
+		return false
+	}
+	for _, r := range name {
+		if r == '/' || r == '\\' {
+			return false
+		}
+	}
+	return true
+}
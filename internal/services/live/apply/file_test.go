@@ -0,0 +1,82 @@
+package apply_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/apply"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+var _ = Describe("LoadDesiredState", func() {
+	var path string
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "instances.yml")
+	})
+
+	write := func(content string) {
+		Expect(os.WriteFile(path, []byte(content), 0644)).To(Succeed())
+	}
+
+	It("names instances after their strategy unless named", func() {
+		write(`
+instances:
+  - strategy: momentum
+    parameters:
+      lookback: 20
+  - name: momentum-fast
+    strategy: momentum
+`)
+		desired, err := apply.LoadDesiredState(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(desired.Instances).To(HaveLen(2))
+		Expect(desired.Instances[0].Name).To(Equal("momentum"))
+		Expect(desired.Instances[0].IsVariant()).To(BeFalse())
+		Expect(desired.Instances[0].Parameters).To(HaveKeyWithValue("lookback", 20))
+		Expect(desired.Instances[1].IsVariant()).To(BeTrue())
+	})
+
+	It("reports a missing file", func() {
+		_, err := apply.LoadDesiredState(path)
+		Expect(err).To(MatchError(ContainSubstring("no desired state file")))
+	})
+
+	It("rejects an instance declared twice", func() {
+		write(`
+instances:
+  - strategy: momentum
+  - name: momentum
+    strategy: momentum
+`)
+		_, err := apply.LoadDesiredState(path)
+		Expect(err).To(MatchError(ContainSubstring("declared twice")))
+	})
+
+	It("rejects instances without a strategy", func() {
+		write(`
+instances:
+  - name: orphan
+`)
+		_, err := apply.LoadDesiredState(path)
+		Expect(err).To(MatchError(ContainSubstring("has no strategy")))
+	})
+
+	It("rejects names that aren't plain directory names", func() {
+		err := apply.Validate(&live.DesiredState{Instances: []live.DesiredInstance{
+			{Name: "../escape", Strategy: "momentum"},
+		}})
+		Expect(err).To(MatchError(ContainSubstring("plain directory name")))
+	})
+
+	It("rejects variants of variants", func() {
+		err := apply.Validate(&live.DesiredState{Instances: []live.DesiredInstance{
+			{Name: "fast", Strategy: "momentum"},
+			{Name: "faster", Strategy: "fast"},
+		}})
+		Expect(err).To(MatchError(ContainSubstring("itself a variant")))
+	})
+})
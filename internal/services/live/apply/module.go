@@ -0,0 +1,10 @@
+package apply
+
+import "go.uber.org/fx"
+
+// Module provides the applier via Fx
+var Module = fx.Module("live/apply",
+	fx.Provide(
+		NewApplier,
+	),
+)
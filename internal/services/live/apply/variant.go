@@ -0,0 +1,248 @@
+package apply
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"gopkg.in/yaml.v3"
+)
+
+// VariantMarker is written into variant directories, naming the strategy whose code they link to.
+// Directories without it are never touched, so a variant can't overwrite a strategy of the same name.
+const VariantMarker = ".kronos-variant"
+
+// configFile is the strategy config inside a strategy directory
+const configFile = "config.yml"
+
+// variantOf returns the strategy a directory is a variant of, or "" if it isn't one
+func variantOf(dir string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(dir, VariantMarker))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to read variant marker: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// materialize makes the instance's directory match its declaration: a variant directory linked to its
+// strategy's code, and a config.yml carrying the declared parameters. Files already as declared aren't rewritten.
+func (a *applier) materialize(instance *live.DesiredInstance) error {
+	if instance.IsVariant() {
+		if _, err := a.checkVariant(instance); err != nil {
+			return err
+		}
+		if err := a.linkVariant(instance); err != nil {
+			return fmt.Errorf("variant %s: %w", instance.Name, err)
+		}
+	}
+
+	target, updated, changed, err := a.desiredConfig(instance)
+	if err != nil || !changed {
+		return err
+	}
+	if err := os.WriteFile(target, updated, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", target, err)
+	}
+	return nil
+}
+
+// desiredConfig renders the config.yml the instance should run with and reports whether it differs from
+// the one on disk. Variants follow their strategy's config, so theirs is rebuilt from it every time.
+func (a *applier) desiredConfig(instance *live.DesiredInstance) (target string, updated []byte, changed bool, err error) {
+	target = filepath.Join(a.dir, instance.Name, configFile)
+	source := target
+	if instance.IsVariant() {
+		source = filepath.Join(a.dir, instance.Strategy, configFile)
+	}
+
+	data, err := os.ReadFile(source)
+	if err != nil {
+		return "", nil, false, fmt.Errorf("failed to read %s: %w", source, err)
+	}
+
+	updated, err = applyConfig(data, instance)
+	if err != nil {
+		return "", nil, false, fmt.Errorf("failed to update %s: %w", source, err)
+	}
+
+	current, err := os.ReadFile(target)
+	if err != nil && !os.IsNotExist(err) {
+		return "", nil, false, fmt.Errorf("failed to read %s: %w", target, err)
+	}
+	return target, updated, !bytes.Equal(current, updated), nil
+}
+
+// checkVariant makes sure a variant's directory is free or already a variant of the same strategy,
+// and that the strategy it links to isn't a variant itself. It reports whether the directory exists.
+func (a *applier) checkVariant(instance *live.DesiredInstance) (bool, error) {
+	baseDir := filepath.Join(a.dir, instance.Strategy)
+	dir := filepath.Join(a.dir, instance.Name)
+
+	if base, err := variantOf(baseDir); err != nil {
+		return false, err
+	} else if base != "" {
+		return false, fmt.Errorf("%s is itself a variant of %s", instance.Strategy, base)
+	}
+
+	if _, err := os.Stat(dir); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check %s: %w", dir, err)
+	}
+
+	base, err := variantOf(dir)
+	if err != nil {
+		return true, err
+	}
+	switch base {
+	case instance.Strategy:
+		return true, nil
+	case "":
+		return true, fmt.Errorf("%s already exists and isn't a variant", dir)
+	default:
+		return true, fmt.Errorf("%s is a variant of %s, not %s", dir, base, instance.Strategy)
+	}
+}
+
+// linkVariant creates or refreshes a variant directory: every file of the strategy except its config
+// and compiled plugin is symlinked, so the variant compiles the same code into its own plugin
+func (a *applier) linkVariant(instance *live.DesiredInstance) error {
+	baseDir := filepath.Join(a.dir, instance.Strategy)
+	dir := filepath.Join(a.dir, instance.Name)
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", dir, err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, VariantMarker), []byte(instance.Strategy+"\n"), 0644); err != nil {
+		return fmt.Errorf("failed to write variant marker: %w", err)
+	}
+
+	entries, err := os.ReadDir(baseDir)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", baseDir, err)
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if name == configFile || name == VariantMarker || strings.HasSuffix(name, ".so") {
+			continue
+		}
+		link := filepath.Join(dir, name)
+		if _, err := os.Lstat(link); err == nil {
+			continue
+		}
+		if err := os.Symlink(filepath.Join("..", instance.Strategy, name), link); err != nil {
+			return fmt.Errorf("failed to link %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// applyConfig sets the instance's name and merges its parameters into a config.yml,
+// editing the document in place so comments and the order of keys survive
+func applyConfig(data []byte, instance *live.DesiredInstance) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("config is not a mapping")
+	}
+	root := doc.Content[0]
+
+	nameNode := mappingValue(root, "name", yaml.ScalarNode)
+	if nameNode.Value != instance.Name {
+		nameNode.SetString(instance.Name)
+	}
+
+	if len(instance.Parameters) > 0 {
+		params := mappingValue(root, "parameters", yaml.MappingNode)
+		if params.Kind != yaml.MappingNode {
+			return nil, fmt.Errorf("parameters is not a mapping")
+		}
+
+		keys := make([]string, 0, len(instance.Parameters))
+		for key := range instance.Parameters {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			value := mappingValue(params, key, yaml.ScalarNode)
+			var current interface{}
+			if err := value.Decode(&current); err == nil && sameValue(current, instance.Parameters[key]) {
+				continue
+			}
+			head, line, foot := value.HeadComment, value.LineComment, value.FootComment
+			if err := value.Encode(instance.Parameters[key]); err != nil {
+				return nil, fmt.Errorf("parameter %s: %w", key, err)
+			}
+			value.HeadComment, value.LineComment, value.FootComment = head, line, foot
+		}
+	}
+
+	var out bytes.Buffer
+	encoder := yaml.NewEncoder(&out)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&doc); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+
+	// Leave the file byte for byte alone when nothing changed, rather than reformatting it
+	if sameDocument(data, out.Bytes()) {
+		return data, nil
+	}
+	return out.Bytes(), nil
+}
+
+// mappingValue returns the value node of key in a mapping, appending an empty one of kind if it's missing
+func mappingValue(mapping *yaml.Node, key string, kind yaml.Kind) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	keyNode := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}
+	valueNode := &yaml.Node{Kind: kind}
+	if kind == yaml.MappingNode {
+		valueNode.Tag = "!!map"
+	}
+	mapping.Content = append(mapping.Content, keyNode, valueNode)
+	return valueNode
+}
+
+// sameDocument reports whether two YAML documents decode to the same data
+func sameDocument(a, b []byte) bool {
+	var left, right interface{}
+	if err := yaml.Unmarshal(a, &left); err != nil {
+		return false
+	}
+	if err := yaml.Unmarshal(b, &right); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(left, right)
+}
+
+// sameValue compares parameter values as YAML sees them, so 1 from a file and int64(1) from code are equal
+func sameValue(a, b interface{}) bool {
+	left, err := yaml.Marshal(a)
+	if err != nil {
+		return false
+	}
+	right, err := yaml.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return sameDocument(left, right)
+}
@@ -0,0 +1,125 @@
+package binary
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/internal/version"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+// hashCache avoids re-hashing an unchanged executable on every spawn or monitor refresh
+var hashCache = struct {
+	sync.Mutex
+	entries map[string]cachedHash
+}{entries: make(map[string]cachedHash)}
+
+type cachedHash struct {
+	size    int64
+	modTime time.Time
+	hash    string
+}
+
+// Resolve returns the absolute path of the kronos executable: override if set, otherwise the running binary
+func Resolve(override string) (string, error) {
+	path := override
+	if path == "" {
+		exe, err := os.Executable()
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve running executable: %w", err)
+		}
+		path = exe
+	}
+
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve executable path: %w", err)
+	}
+	if resolved, err := filepath.EvalSymlinks(abs); err == nil {
+		abs = resolved
+	}
+
+	info, err := os.Stat(abs)
+	if err != nil {
+		return "", fmt.Errorf("kronos executable not found: %w", err)
+	}
+	if info.IsDir() {
+		return "", fmt.Errorf("kronos executable is a directory: %s", abs)
+	}
+
+	return abs, nil
+}
+
+// Hash returns the sha256 of the file at path
+func Hash(path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+
+	hashCache.Lock()
+	cached, ok := hashCache.entries[path]
+	hashCache.Unlock()
+	if ok && cached.size == info.Size() && cached.modTime.Equal(info.ModTime()) {
+		return cached.hash, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("failed to hash %s: %w", path, err)
+	}
+	sum := hex.EncodeToString(h.Sum(nil))
+
+	hashCache.Lock()
+	hashCache.entries[path] = cachedHash{size: info.Size(), modTime: info.ModTime(), hash: sum}
+	hashCache.Unlock()
+
+	return sum, nil
+}
+
+// Identify describes the executable at path. The version is only known when it is the running binary.
+func Identify(path string) (*live.BinaryInfo, error) {
+	hash, err := Hash(path)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &live.BinaryInfo{Path: path, Hash: hash}
+	if current, err := Current(); err == nil && current.Hash == hash {
+		info.Version = version.Version
+	}
+	return info, nil
+}
+
+// Current describes the running executable
+func Current() (*live.BinaryInfo, error) {
+	path, err := Resolve("")
+	if err != nil {
+		return nil, err
+	}
+	hash, err := Hash(path)
+	if err != nil {
+		return nil, err
+	}
+	return &live.BinaryInfo{Path: path, Version: version.Version, Hash: hash}, nil
+}
+
+// Outdated reports whether an instance runs a different build than the given binary
+func Outdated(instance *live.Instance, current *live.BinaryInfo) bool {
+	if instance.Binary == nil || current == nil {
+		return false
+	}
+	return instance.Binary.Hash != current.Hash
+}
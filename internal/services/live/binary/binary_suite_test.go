@@ -0,0 +1,13 @@
+package binary_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestBinary(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Binary Suite")
+}
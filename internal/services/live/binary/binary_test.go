@@ -0,0 +1,98 @@
+package binary_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/binary"
+	"github.com/backtesting-org/kronos-cli/internal/version"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+var _ = Describe("Binary", func() {
+	var tmpDir string
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = os.MkdirTemp("", "binary-test-*")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(func() {
+			_ = os.RemoveAll(tmpDir)
+		})
+	})
+
+	Describe("Resolve", func() {
+		It("should default to the running executable", func() {
+			path, err := binary.Resolve("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filepath.IsAbs(path)).To(BeTrue())
+		})
+
+		It("should follow symlinks of an override", func() {
+			target := filepath.Join(tmpDir, "kronos-real")
+			link := filepath.Join(tmpDir, "kronos")
+			Expect(os.WriteFile(target, []byte("bin"), 0755)).To(Succeed())
+			Expect(os.Symlink(target, link)).To(Succeed())
+
+			path, err := binary.Resolve(link)
+			Expect(err).NotTo(HaveOccurred())
+			resolvedTarget, _ := filepath.EvalSymlinks(target)
+			Expect(path).To(Equal(resolvedTarget))
+		})
+
+		It("should reject a missing override", func() {
+			_, err := binary.Resolve(filepath.Join(tmpDir, "missing"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Hash", func() {
+		It("should change when the file changes", func() {
+			path := filepath.Join(tmpDir, "kronos")
+			Expect(os.WriteFile(path, []byte("v1"), 0755)).To(Succeed())
+			first, err := binary.Hash(path)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(os.WriteFile(path, []byte("v2-longer"), 0755)).To(Succeed())
+			second, err := binary.Hash(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(second).NotTo(Equal(first))
+		})
+	})
+
+	Describe("Identify", func() {
+		It("should attach the CLI version to the running executable", func() {
+			current, err := binary.Current()
+			Expect(err).NotTo(HaveOccurred())
+
+			info, err := binary.Identify(current.Path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Version).To(Equal(version.Version))
+		})
+
+		It("should leave the version empty for other builds", func() {
+			path := filepath.Join(tmpDir, "kronos")
+			Expect(os.WriteFile(path, []byte("other"), 0755)).To(Succeed())
+
+			info, err := binary.Identify(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Version).To(BeEmpty())
+		})
+	})
+
+	Describe("Outdated", func() {
+		current := &live.BinaryInfo{Hash: "new"}
+
+		It("should flag instances started from another build", func() {
+			Expect(binary.Outdated(&live.Instance{Binary: &live.BinaryInfo{Hash: "old"}}, current)).To(BeTrue())
+			Expect(binary.Outdated(&live.Instance{Binary: &live.BinaryInfo{Hash: "new"}}, current)).To(BeFalse())
+		})
+
+		It("should not flag instances without binary info", func() {
+			Expect(binary.Outdated(&live.Instance{}, current)).To(BeFalse())
+		})
+	})
+})
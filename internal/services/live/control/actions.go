@@ -0,0 +1,60 @@
+package control
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+const (
+	// ActionsPath is where a strategy's control socket takes trading actions
+	ActionsPath = "/actions"
+
+	// PausePath is where a strategy's control socket reports whether signal execution is paused
+	PausePath = "/paused"
+
+	// actionTimeout bounds a trading action, which waits on the exchange for every order it touches
+	actionTimeout = 30 * time.Second
+)
+
+// PauseState is the reply to PausePath
+type PauseState struct {
+	Paused bool `json:"paused"`
+}
+
+// NewActionsHandler accepts POST ActionsPath with a TradingAction and replies with what the strategy did
+func NewActionsHandler(executor live.ActionExecutor) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var action live.TradingAction
+		if err := json.NewDecoder(req.Body).Decode(&action); err != nil {
+			http.Error(w, fmt.Sprintf("invalid action: %v", err), http.StatusBadRequest)
+			return
+		}
+		if err := action.Validate(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		WriteJSON(w, executor.Execute(req.Context(), action))
+	}
+}
+
+// NewPauseHandler accepts GET PausePath and replies with the PauseState.
+// Pausing and resuming are trading actions, POSTed to ActionsPath.
+func NewPauseHandler(executor live.ActionExecutor) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		WriteJSON(w, PauseState{Paused: executor.Paused()})
+	}
+}
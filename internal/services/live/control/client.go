@@ -0,0 +1,158 @@
+package control
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+const (
+	// stopGrace is added to the drain timeout when waiting for a stop reply
+	stopGrace = 15 * time.Second
+
+	// promoteTimeout bounds connector initialisation when a standby instance takes over
+	promoteTimeout = time.Minute
+)
+
+type client struct {
+	socketDir string
+	timeout   time.Duration
+}
+
+// NewClient creates an InstanceController using the default control socket directory
+func NewClient() live.InstanceController {
+	return NewClientWithConfig(DefaultSocketDir(), 5*time.Second)
+}
+
+// NewClientWithConfig creates an InstanceController for a custom socket directory
+func NewClientWithConfig(socketDir string, timeout time.Duration) live.InstanceController {
+	return &client{
+		socketDir: socketDir,
+		timeout:   timeout,
+	}
+}
+
+// Available reports whether the strategy's control socket exists
+func (c *client) Available(strategyName string) bool {
+	_, err := os.Stat(SocketPath(c.socketDir, strategyName))
+	return err == nil
+}
+
+// StandbyAvailable reports whether a standby instance of the strategy is waiting to be promoted
+func (c *client) StandbyAvailable(strategyName string) bool {
+	return c.Available(StandbyName(strategyName))
+}
+
+// Promote tells the standby instance to start trading and returns the exposure it took over
+func (c *client) Promote(strategyName string) (*live.Exposure, error) {
+	var exposure live.Exposure
+	if err := c.post(StandbyName(strategyName), "/promote", struct{}{}, &exposure, promoteTimeout); err != nil {
+		return nil, err
+	}
+	return &exposure, nil
+}
+
+// Stop asks the strategy to drain and exit, and returns its final state
+func (c *client) Stop(ctx context.Context, strategyName string, opts live.StopOptions) (*live.StopResult, error) {
+	var result live.StopResult
+	if err := c.postContext(ctx, strategyName, "/stop", opts, &result, opts.DrainTimeout+stopGrace); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Act has the strategy carry out a trading action and returns what it did
+func (c *client) Act(strategyName string, action live.TradingAction) (*live.ActionResult, error) {
+	var result live.ActionResult
+	if err := c.post(strategyName, ActionsPath, action, &result, actionTimeout); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Paused reports whether the strategy's signal execution is paused
+func (c *client) Paused(strategyName string) (bool, error) {
+	var state PauseState
+	if err := c.get(strategyName, PausePath, &state); err != nil {
+		return false, err
+	}
+	return state.Paused, nil
+}
+
+// get decodes the JSON reply to a GET of path into result
+func (c *client) get(strategyName, path string, result interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, "http://unix"+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	return c.do(strategyName, req, result, 0)
+}
+
+// post sends body as JSON and decodes the JSON reply into result
+func (c *client) post(strategyName, path string, body, result interface{}, timeout time.Duration) error {
+	return c.postContext(context.Background(), strategyName, path, body, result, timeout)
+}
+
+// postContext is post that ctx can cut short
+func (c *client) postContext(ctx context.Context, strategyName, path string, body, result interface{}, timeout time.Duration) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "http://unix"+path, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return c.do(strategyName, req, result, timeout)
+}
+
+// do sends req over the strategy's control socket and decodes the JSON reply into result
+func (c *client) do(strategyName string, req *http.Request, result interface{}, timeout time.Duration) error {
+	socketPath := SocketPath(c.socketDir, strategyName)
+	if _, err := os.Stat(socketPath); os.IsNotExist(err) {
+		return fmt.Errorf("instance %s has no control socket", strategyName)
+	}
+
+	if timeout <= 0 {
+		timeout = c.timeout
+	}
+
+	httpClient := &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", socketPath)
+			},
+		},
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach instance %s: %w", strategyName, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("instance %s returned status %d: %s", strategyName, resp.StatusCode, bytes.TrimSpace(msg))
+	}
+
+	if result != nil {
+		if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+
+	return nil
+}
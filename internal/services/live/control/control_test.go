@@ -0,0 +1,163 @@
+package control_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/control"
+	livemocks "github.com/backtesting-org/kronos-cli/mocks/github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+var _ = Describe("Control socket", func() {
+	var (
+		socketDir string
+		server    *control.Server
+		client    live.InstanceController
+	)
+
+	BeforeEach(func() {
+		var err error
+		socketDir, err = os.MkdirTemp("", "ctl")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(func() {
+			_ = os.RemoveAll(socketDir)
+		})
+
+		server = control.NewServer(socketDir, "momentum")
+		client = control.NewClientWithConfig(socketDir, time.Second)
+	})
+
+	It("should report whether a strategy has a control socket", func() {
+		Expect(client.Available("momentum")).To(BeFalse())
+
+		Expect(server.Start()).To(Succeed())
+		Expect(client.Available("momentum")).To(BeTrue())
+
+		Expect(server.Stop(context.Background())).To(Succeed())
+		Expect(client.Available("momentum")).To(BeFalse())
+	})
+
+	It("should send stop options and return the strategy's result", func() {
+		var received live.StopOptions
+		server.Handle("/stop", func(w http.ResponseWriter, r *http.Request) {
+			Expect(json.NewDecoder(r.Body).Decode(&received)).To(Succeed())
+			control.WriteJSON(w, &live.StopResult{
+				StrategyName:    "momentum",
+				Mode:            received.Mode,
+				CancelledOrders: 3,
+				Snapshot:        true,
+			})
+		})
+		Expect(server.Start()).To(Succeed())
+		DeferCleanup(func() {
+			_ = server.Stop(context.Background())
+		})
+
+		result, err := client.Stop(context.Background(), "momentum", live.StopOptions{Mode: live.StopModeCancelOrders, DrainTimeout: 5 * time.Second})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(received.Mode).To(Equal(live.StopModeCancelOrders))
+		Expect(received.DrainTimeout).To(Equal(5 * time.Second))
+		Expect(result.CancelledOrders).To(Equal(3))
+		Expect(result.Snapshot).To(BeTrue())
+	})
+
+	It("should surface errors returned by the strategy", func() {
+		server.Handle("/stop", func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "strategy is already stopping", http.StatusConflict)
+		})
+		Expect(server.Start()).To(Succeed())
+		DeferCleanup(func() {
+			_ = server.Stop(context.Background())
+		})
+
+		_, err := client.Stop(context.Background(), "momentum", live.StopOptions{})
+		Expect(err).To(MatchError(ContainSubstring("already stopping")))
+	})
+
+	It("should send trading actions to the strategy's executor", func() {
+		executor := livemocks.NewActionExecutor(GinkgoT())
+		action := live.TradingAction{Type: live.ActionCancelOrders, Exchange: "paradex", Asset: "BTC", OrderIDs: []string{"o-1"}}
+		executor.EXPECT().Execute(mock.Anything, action).Return(&live.ActionResult{Action: action, CancelledOrders: 1})
+		executor.EXPECT().Paused().Return(true)
+
+		server.Handle(control.ActionsPath, control.NewActionsHandler(executor))
+		server.Handle(control.PausePath, control.NewPauseHandler(executor))
+		Expect(server.Start()).To(Succeed())
+		DeferCleanup(func() {
+			_ = server.Stop(context.Background())
+		})
+
+		result, err := client.Act("momentum", action)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.CancelledOrders).To(Equal(1))
+
+		paused, err := client.Paused("momentum")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(paused).To(BeTrue())
+	})
+
+	It("should only report the pause state on GET", func() {
+		handler := control.NewPauseHandler(livemocks.NewActionExecutor(GinkgoT()))
+
+		recorder := httptest.NewRecorder()
+		handler(recorder, httptest.NewRequest(http.MethodPost, control.PausePath, nil))
+		Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+	})
+
+	It("should refuse invalid trading actions", func() {
+		server.Handle(control.ActionsPath, control.NewActionsHandler(livemocks.NewActionExecutor(GinkgoT())))
+		Expect(server.Start()).To(Succeed())
+		DeferCleanup(func() {
+			_ = server.Stop(context.Background())
+		})
+
+		_, err := client.Act("momentum", live.TradingAction{Type: "liquidate"})
+		Expect(err).To(MatchError(ContainSubstring(`unknown action "liquidate"`)))
+	})
+
+	It("should fail without a control socket", func() {
+		_, err := client.Stop(context.Background(), "momentum", live.StopOptions{})
+		Expect(err).To(MatchError(ContainSubstring("no control socket")))
+	})
+})
+
+var _ = Describe("Stop results", func() {
+	It("should round-trip the last stop result", func() {
+		projectDir := GinkgoT().TempDir()
+		stoppedAt := time.Now().Truncate(time.Second)
+
+		Expect(control.SaveResult(projectDir, &live.StopResult{
+			StrategyName:    "momentum",
+			Mode:            live.StopModeFlatten,
+			ClosedPositions: 2,
+			StoppedAt:       stoppedAt,
+		})).To(Succeed())
+
+		result, err := control.LoadResult(projectDir, "momentum")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Mode).To(Equal(live.StopModeFlatten))
+		Expect(result.ClosedPositions).To(Equal(2))
+		Expect(result.StoppedAt.Equal(stoppedAt)).To(BeTrue())
+	})
+})
+
+var _ = DescribeTable("ParseStopMode",
+	func(input string, expected live.StopMode) {
+		mode, err := live.ParseStopMode(input)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mode).To(Equal(expected))
+	},
+	Entry("empty defaults to leave", "", live.StopModeLeave),
+	Entry("dashed form", "cancel-orders", live.StopModeCancelOrders),
+	Entry("underscored form", "cancel_orders", live.StopModeCancelOrders),
+	Entry("flatten", "FLATTEN", live.StopModeFlatten),
+)
@@ -0,0 +1,12 @@
+package control
+
+import (
+	"go.uber.org/fx"
+)
+
+// Module provides the control socket client via Fx
+var Module = fx.Module("live/control",
+	fx.Provide(
+		NewClient,
+	),
+)
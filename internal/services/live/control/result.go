@@ -0,0 +1,49 @@
+package control
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/logs"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+// ResultPath returns where a strategy records the result of its last stop, relative to projectDir
+func ResultPath(projectDir, strategyName string) string {
+	return filepath.Join(projectDir, logs.InstanceLogDir(strategyName), "last-stop.json")
+}
+
+// SaveResult records a stop result so that callers that stopped the strategy with a signal can read it
+func SaveResult(projectDir string, result *live.StopResult) error {
+	path := ResultPath(projectDir, result.StrategyName)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create instance directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode stop result: %w", err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to write stop result: %w", err)
+	}
+	return os.Rename(tmp, path)
+}
+
+// LoadResult reads the last recorded stop result of a strategy
+func LoadResult(projectDir, strategyName string) (*live.StopResult, error) {
+	data, err := os.ReadFile(ResultPath(projectDir, strategyName))
+	if err != nil {
+		return nil, err
+	}
+
+	var result live.StopResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse stop result: %w", err)
+	}
+	return &result, nil
+}
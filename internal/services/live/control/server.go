@@ -0,0 +1,122 @@
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// DefaultSocketDir returns ~/.kronos/control. It is kept apart from the SDK's monitoring sockets
+// so that querier.ListInstances doesn't pick up control sockets.
+func DefaultSocketDir() string {
+	homeDir, _ := os.UserHomeDir()
+	return filepath.Join(homeDir, ".kronos", "control")
+}
+
+// SocketPath returns the control socket of a strategy inside dir
+func SocketPath(dir, strategyName string) string {
+	return filepath.Join(dir, strategyName+".sock")
+}
+
+// StandbyName is the control socket name of a strategy's standby instance during a reload
+func StandbyName(strategyName string) string {
+	return strategyName + ".standby"
+}
+
+// Server exposes CLI-owned endpoints from inside a running strategy process.
+// The SDK's monitoring server is read-only; anything that acts on the strategy goes through here.
+type Server struct {
+	socketPath string
+	mux        *http.ServeMux
+	listener   net.Listener
+	httpServer *http.Server
+	mu         sync.Mutex
+}
+
+// NewServer creates a control server for a strategy; register handlers before calling Start
+func NewServer(socketDir, strategyName string) *Server {
+	return &Server{
+		socketPath: SocketPath(socketDir, strategyName),
+		mux:        http.NewServeMux(),
+	}
+}
+
+// Handle registers a handler for pattern
+func (s *Server) Handle(pattern string, handler http.HandlerFunc) {
+	s.mux.HandleFunc(pattern, handler)
+}
+
+// SocketPath returns the path the server listens on
+func (s *Server) SocketPath() string {
+	return s.socketPath
+}
+
+// Start listens on the control socket and serves in the background
+func (s *Server) Start() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.listener != nil {
+		return fmt.Errorf("control server already started")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.socketPath), 0700); err != nil {
+		return fmt.Errorf("failed to create control socket directory: %w", err)
+	}
+	if err := os.Remove(s.socketPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove old control socket: %w", err)
+	}
+
+	listener, err := net.Listen("unix", s.socketPath)
+	if err != nil {
+		return fmt.Errorf("failed to create control socket: %w", err)
+	}
+	if err := os.Chmod(s.socketPath, 0600); err != nil {
+		_ = listener.Close()
+		return fmt.Errorf("failed to set control socket permissions: %w", err)
+	}
+
+	httpServer := &http.Server{Handler: s.mux}
+	s.listener = listener
+	s.httpServer = httpServer
+
+	go func() {
+		_ = httpServer.Serve(listener)
+	}()
+
+	return nil
+}
+
+// Stop shuts the server down and removes the socket
+func (s *Server) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.httpServer == nil {
+		return nil
+	}
+
+	err := s.httpServer.Shutdown(ctx)
+	if rmErr := os.Remove(s.socketPath); rmErr != nil && !os.IsNotExist(rmErr) {
+		err = errors.Join(err, rmErr)
+	}
+
+	s.httpServer = nil
+	s.listener = nil
+
+	return err
+}
+
+// WriteJSON writes v as a JSON response
+func WriteJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,249 @@
+package deploy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	liveService "github.com/backtesting-org/kronos-cli/internal/services/live"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/config"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+)
+
+const (
+	// defaultReadyTimeout applies to deployments without a ready_timeout
+	defaultReadyTimeout = time.Minute
+
+	// readyPollInterval is how often a starting member is health checked
+	readyPollInterval = 500 * time.Millisecond
+)
+
+type deployer struct {
+	manager    live.InstanceManager
+	service    liveService.LiveService
+	querier    monitoring.ViewQuerier
+	strategies config.StrategyConfig
+	logger     logging.ApplicationLogger
+}
+
+// NewDeployer creates a Deployer. Members are started like any other strategy, pre-flight checks included.
+func NewDeployer(
+	manager live.InstanceManager,
+	service liveService.LiveService,
+	querier monitoring.ViewQuerier,
+	strategies config.StrategyConfig,
+	logger logging.ApplicationLogger,
+) live.Deployer {
+	return &deployer{
+		manager:    manager,
+		service:    service,
+		querier:    querier,
+		strategies: strategies,
+		logger:     logger,
+	}
+}
+
+func (d *deployer) Up(ctx context.Context, deployment *live.Deployment) (*live.DeploymentStatus, error) {
+	ordered, err := Order(deployment)
+	if err != nil {
+		return nil, fmt.Errorf("deployment %s: %w", deployment.Name, err)
+	}
+
+	strategies, err := d.strategies.FindStrategies()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find strategies: %w", err)
+	}
+	byName := make(map[string]*config.Strategy, len(strategies))
+	for i := range strategies {
+		byName[strategies[i].Name] = &strategies[i]
+	}
+
+	timeout := deployment.ReadyTimeout
+	if timeout <= 0 {
+		timeout = defaultReadyTimeout
+	}
+
+	details := make(map[string]string)
+	for _, member := range ordered {
+		name := member.Strategy
+
+		if status := d.memberStatus(name); status.State == live.MemberRunning || status.State == live.MemberUnhealthy {
+			details[name] = "already running"
+		} else {
+			strat, ok := byName[name]
+			if !ok {
+				details[name] = "not found in ./strategies"
+				return d.status(deployment.Name, ordered, details), fmt.Errorf("strategy %s not found", name)
+			}
+
+			d.logger.Info("Starting deployment member", "deployment", deployment.Name, "strategy", name)
+			if err := d.service.ExecuteStrategy(ctx, strat); err != nil {
+				details[name] = "failed to start"
+				return d.status(deployment.Name, ordered, details), fmt.Errorf("failed to start %s: %w", name, err)
+			}
+			details[name] = "started"
+		}
+
+		// The members after this one rely on it, so it has to be serving before they start
+		if err := d.waitReady(ctx, name, timeout); err != nil {
+			details[name] = err.Error()
+			return d.status(deployment.Name, ordered, details), fmt.Errorf("%s didn't come up: %w", name, err)
+		}
+	}
+
+	return d.status(deployment.Name, ordered, details), nil
+}
+
+func (d *deployer) Down(ctx context.Context, deployment *live.Deployment, opts live.StopOptions) (*live.DeploymentStatus, error) {
+	ordered, err := Order(deployment)
+	if err != nil {
+		return nil, fmt.Errorf("deployment %s: %w", deployment.Name, err)
+	}
+
+	// A member stays up until everything listed after it has stopped, so stop in reverse, carrying on past failures
+	var errs []error
+	details := make(map[string]string)
+	for i := len(ordered) - 1; i >= 0; i-- {
+		name := ordered[i].Strategy
+		if ctx.Err() != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, ctx.Err()))
+			continue
+		}
+
+		status := d.memberStatus(name)
+		if status.State != live.MemberRunning && status.State != live.MemberUnhealthy {
+			continue
+		}
+
+		d.logger.Info("Stopping deployment member", "deployment", deployment.Name, "strategy", name)
+		result, err := d.manager.StopStrategy(ctx, name, opts)
+		if err != nil {
+			details[name] = "failed to stop"
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			continue
+		}
+		details[name] = fmt.Sprintf("stopped (%s)", result.Mode.Description())
+	}
+
+	return d.status(deployment.Name, ordered, details), errors.Join(errs...)
+}
+
+func (d *deployer) Status(deployment *live.Deployment) (*live.DeploymentStatus, error) {
+	ordered, err := Order(deployment)
+	if err != nil {
+		return nil, fmt.Errorf("deployment %s: %w", deployment.Name, err)
+	}
+	return d.status(deployment.Name, ordered, nil), nil
+}
+
+// waitReady polls a member's health until it answers, its instance stops running or timeout expires
+func (d *deployer) waitReady(ctx context.Context, name string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		status := d.memberStatus(name)
+		switch status.State {
+		case live.MemberRunning:
+			return nil
+		case live.MemberCrashed:
+			return fmt.Errorf("crashed: %s", status.Detail)
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("not healthy after %s", timeout)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(readyPollInterval):
+		}
+	}
+}
+
+// status reports each member in start order with what the last action did to it
+func (d *deployer) status(name string, ordered []live.DeploymentMember, details map[string]string) *live.DeploymentStatus {
+	status := &live.DeploymentStatus{Name: name}
+
+	running := 0
+	for _, member := range ordered {
+		memberStatus := d.memberStatus(member.Strategy)
+		if detail, ok := details[member.Strategy]; ok {
+			memberStatus.Detail = detail
+		}
+		if memberStatus.State == live.MemberRunning {
+			running++
+		}
+		status.Members = append(status.Members, memberStatus)
+	}
+
+	switch {
+	case running == len(ordered):
+		status.State = live.DeploymentUp
+	case running == 0 && !anyUnhealthy(status.Members):
+		status.State = live.DeploymentDown
+	default:
+		status.State = live.DeploymentDegraded
+	}
+	return status
+}
+
+// memberStatus combines the supervisor's record of a strategy with whether it answers health checks.
+// Strategies started outside this project's state are still seen through their monitoring socket.
+func (d *deployer) memberStatus(name string) *live.MemberStatus {
+	status := &live.MemberStatus{Strategy: name, State: live.MemberStopped}
+	healthy := d.querier.HealthCheck(name) == nil
+
+	instance := d.latest(name)
+	switch {
+	case instance != nil && instance.Status == live.StatusRunning:
+		status.State = live.MemberUnhealthy
+		if healthy {
+			status.State = live.MemberRunning
+		}
+		status.PID = instance.PID
+		status.Uptime = time.Since(instance.StartedAt).Round(time.Second)
+	case healthy:
+		status.State = live.MemberRunning
+	case instance != nil && instance.Status == live.StatusCrashed:
+		status.State = live.MemberCrashed
+		status.Detail = instance.Error
+		if status.Detail == "" {
+			status.Detail = string(instance.CrashReason)
+		}
+	}
+	return status
+}
+
+// latest returns the running instance of a strategy, or else its most recently started one
+func (d *deployer) latest(name string) *live.Instance {
+	instances, err := d.manager.List("")
+	if err != nil {
+		return nil
+	}
+
+	var latest *live.Instance
+	for _, instance := range instances {
+		if instance.StrategyName != name || instance.Status == live.StatusStandby {
+			continue
+		}
+		if instance.Status == live.StatusRunning {
+			return instance
+		}
+		if latest == nil || instance.StartedAt.After(latest.StartedAt) {
+			latest = instance
+		}
+	}
+	return latest
+}
+
+func anyUnhealthy(members []*live.MemberStatus) bool {
+	for _, member := range members {
+		if member.State == live.MemberUnhealthy {
+			return true
+		}
+	}
+	return false
+}
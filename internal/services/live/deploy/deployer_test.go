@@ -0,0 +1,141 @@
+package deploy_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/deploy"
+	livemocks "github.com/backtesting-org/kronos-cli/mocks/github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	configmocks "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/config"
+	monitoringmocks "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/config"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+)
+
+// fakeLiveService records which strategies were started and brings them up unless told to fail
+type fakeLiveService struct {
+	mu      sync.Mutex
+	started []string
+	healthy map[string]bool
+	fail    map[string]error
+}
+
+func (f *fakeLiveService) ExecuteStrategy(_ context.Context, strat *config.Strategy) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.fail[strat.Name]; err != nil {
+		return err
+	}
+	f.started = append(f.started, strat.Name)
+	f.healthy[strat.Name] = true
+	return nil
+}
+
+func (f *fakeLiveService) isHealthy(name string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.healthy[name]
+}
+
+var _ = Describe("Deployer", func() {
+	var (
+		manager    *livemocks.InstanceManager
+		querier    *monitoringmocks.ViewQuerier
+		strategies *configmocks.StrategyConfig
+		service    *fakeLiveService
+		deployer   live.Deployer
+		deployment *live.Deployment
+	)
+
+	BeforeEach(func() {
+		manager = livemocks.NewInstanceManager(GinkgoT())
+		querier = monitoringmocks.NewViewQuerier(GinkgoT())
+		strategies = configmocks.NewStrategyConfig(GinkgoT())
+		service = &fakeLiveService{healthy: map[string]bool{}, fail: map[string]error{}}
+		deployer = deploy.NewDeployer(manager, service, querier, strategies, &logging.NoOpLogger{})
+
+		deployment = &live.Deployment{
+			Name:         "market-making",
+			ReadyTimeout: time.Second,
+			Members: []live.DeploymentMember{
+				{Strategy: "maker", After: []string{"hedger"}},
+				{Strategy: "hedger"},
+			},
+		}
+
+		strategies.EXPECT().FindStrategies().Return([]config.Strategy{{Name: "hedger"}, {Name: "maker"}}, nil).Maybe()
+		manager.EXPECT().List(live.InstanceStatus("")).Return(nil, nil).Maybe()
+		querier.EXPECT().HealthCheck(mock.Anything).RunAndReturn(func(name string) error {
+			if service.isHealthy(name) {
+				return nil
+			}
+			return errors.New("connection refused")
+		}).Maybe()
+	})
+
+	It("starts members in dependency order and reports the deployment up", func() {
+		status, err := deployer.Up(context.Background(), deployment)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(service.started).To(Equal([]string{"hedger", "maker"}))
+		Expect(status.State).To(Equal(live.DeploymentUp))
+		Expect(status.Members[0].Detail).To(Equal("started"))
+	})
+
+	It("leaves running members alone", func() {
+		service.healthy["hedger"] = true
+
+		status, err := deployer.Up(context.Background(), deployment)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(service.started).To(Equal([]string{"maker"}))
+		Expect(status.Members[0].Detail).To(Equal("already running"))
+	})
+
+	It("doesn't start members after one that fails", func() {
+		service.fail["hedger"] = errors.New("pre-flight failed")
+
+		status, err := deployer.Up(context.Background(), deployment)
+
+		Expect(err).To(MatchError(ContainSubstring("failed to start hedger")))
+		Expect(service.started).To(BeEmpty())
+		Expect(status.State).To(Equal(live.DeploymentDown))
+		Expect(status.Members[1].State).To(Equal(live.MemberStopped))
+	})
+
+	It("stops members in reverse order", func() {
+		service.healthy["hedger"] = true
+		service.healthy["maker"] = true
+
+		var stopped []string
+		manager.EXPECT().StopStrategy(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, name string, opts live.StopOptions) (*live.StopResult, error) {
+			stopped = append(stopped, name)
+			service.healthy[name] = false
+			return &live.StopResult{StrategyName: name, Mode: opts.Mode}, nil
+		})
+
+		status, err := deployer.Down(context.Background(), deployment, live.StopOptions{Mode: live.StopModeFlatten})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stopped).To(Equal([]string{"maker", "hedger"}))
+		Expect(status.State).To(Equal(live.DeploymentDown))
+	})
+
+	It("reports a partly running deployment as degraded", func() {
+		service.healthy["hedger"] = true
+
+		status, err := deployer.Status(deployment)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.State).To(Equal(live.DeploymentDegraded))
+		Expect(status.Members[0].State).To(Equal(live.MemberRunning))
+		Expect(status.Members[1].State).To(Equal(live.MemberStopped))
+	})
+})
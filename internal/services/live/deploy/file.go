@@ -0,0 +1,124 @@
+package deploy
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultFile is where deployments are read from, relative to the project root
+const DefaultFile = "deployments.yml"
+
+type deploymentsFile struct {
+	Groups map[string]*live.Deployment `yaml:"groups"`
+}
+
+// LoadDeployments reads and validates every deployment in a deployments file
+func LoadDeployments(path string) (map[string]*live.Deployment, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no deployments file at %s", path)
+		}
+		return nil, fmt.Errorf("failed to read deployments: %w", err)
+	}
+
+	var file deploymentsFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse deployments %s: %w", path, err)
+	}
+
+	for name, deployment := range file.Groups {
+		if deployment == nil {
+			return nil, fmt.Errorf("deployment %s has no strategies", name)
+		}
+		deployment.Name = name
+		if _, err := Order(deployment); err != nil {
+			return nil, fmt.Errorf("deployment %s: %w", name, err)
+		}
+	}
+
+	return file.Groups, nil
+}
+
+// LoadDeployment reads a single deployment from a deployments file
+func LoadDeployment(path, name string) (*live.Deployment, error) {
+	deployments, err := LoadDeployments(path)
+	if err != nil {
+		return nil, err
+	}
+
+	deployment, ok := deployments[name]
+	if !ok {
+		return nil, fmt.Errorf("no deployment named %s in %s", name, path)
+	}
+	return deployment, nil
+}
+
+// Order returns the members of a deployment in start order: each member comes after the members it names
+// in after. Otherwise members keep the order they are listed in.
+func Order(deployment *live.Deployment) ([]live.DeploymentMember, error) {
+	if len(deployment.Members) == 0 {
+		return nil, fmt.Errorf("no strategies listed")
+	}
+
+	index := make(map[string]int, len(deployment.Members))
+	for i, member := range deployment.Members {
+		if member.Strategy == "" {
+			return nil, fmt.Errorf("strategy %d has no name", i+1)
+		}
+		if _, dup := index[member.Strategy]; dup {
+			return nil, fmt.Errorf("%s is listed twice", member.Strategy)
+		}
+		index[member.Strategy] = i
+	}
+	for _, member := range deployment.Members {
+		for _, dep := range member.After {
+			if dep == member.Strategy {
+				return nil, fmt.Errorf("%s is listed after itself", member.Strategy)
+			}
+			if _, ok := index[dep]; !ok {
+				return nil, fmt.Errorf("%s is listed after %s, which isn't part of the deployment", member.Strategy, dep)
+			}
+		}
+	}
+
+	ordered := make([]live.DeploymentMember, 0, len(deployment.Members))
+	placed := make(map[string]bool, len(deployment.Members))
+	for len(ordered) < len(deployment.Members) {
+		progressed := false
+		for _, member := range deployment.Members {
+			if placed[member.Strategy] || !allPlaced(member.After, placed) {
+				continue
+			}
+			ordered = append(ordered, member)
+			placed[member.Strategy] = true
+			progressed = true
+			break
+		}
+
+		if !progressed {
+			var cycle []string
+			for _, member := range deployment.Members {
+				if !placed[member.Strategy] {
+					cycle = append(cycle, member.Strategy)
+				}
+			}
+			return nil, fmt.Errorf("ordering cycle between %s", strings.Join(cycle, ", "))
+		}
+	}
+
+	return ordered, nil
+}
+
+func allPlaced(names []string, placed map[string]bool) bool {
+	for _, name := range names {
+		if !placed[name] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,88 @@
+package deploy_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/deploy"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+var _ = Describe("Order", func() {
+	names := func(members []live.DeploymentMember) []string {
+		var out []string
+		for _, member := range members {
+			out = append(out, member.Strategy)
+		}
+		return out
+	}
+
+	It("starts members after the ones they name and otherwise keeps file order", func() {
+		ordered, err := deploy.Order(&live.Deployment{Members: []live.DeploymentMember{
+			{Strategy: "maker", After: []string{"hedger"}},
+			{Strategy: "reporter"},
+			{Strategy: "hedger", After: []string{"feed"}},
+			{Strategy: "feed"},
+		}})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(names(ordered)).To(Equal([]string{"reporter", "feed", "hedger", "maker"}))
+	})
+
+	DescribeTable("rejects invalid deployments",
+		func(members []live.DeploymentMember, message string) {
+			_, err := deploy.Order(&live.Deployment{Members: members})
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("empty", nil, "no strategies"),
+		Entry("duplicate", []live.DeploymentMember{{Strategy: "a"}, {Strategy: "a"}}, "listed twice"),
+		Entry("unknown dependency", []live.DeploymentMember{{Strategy: "a", After: []string{"b"}}}, "isn't part of the deployment"),
+		Entry("cycle", []live.DeploymentMember{
+			{Strategy: "a", After: []string{"b"}},
+			{Strategy: "b", After: []string{"a"}},
+			{Strategy: "c"},
+		}, "ordering cycle between a, b"),
+	)
+})
+
+var _ = Describe("LoadDeployments", func() {
+	var path string
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), deploy.DefaultFile)
+	})
+
+	It("names each deployment after its group", func() {
+		Expect(os.WriteFile(path, []byte(`
+groups:
+  market-making:
+    ready_timeout: 30s
+    strategies:
+      - strategy: hedger
+      - strategy: maker
+        after: [hedger]
+`), 0644)).To(Succeed())
+
+		deployment, err := deploy.LoadDeployment(path, "market-making")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deployment.Name).To(Equal("market-making"))
+		Expect(deployment.Members).To(HaveLen(2))
+		Expect(deployment.Members[1].After).To(Equal([]string{"hedger"}))
+	})
+
+	It("fails on a group with an ordering cycle", func() {
+		Expect(os.WriteFile(path, []byte(`
+groups:
+  broken:
+    strategies:
+      - {strategy: a, after: [b]}
+      - {strategy: b, after: [a]}
+`), 0644)).To(Succeed())
+
+		_, err := deploy.LoadDeployments(path)
+		Expect(err).To(MatchError(ContainSubstring("deployment broken")))
+	})
+})
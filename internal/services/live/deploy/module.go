@@ -0,0 +1,10 @@
+package deploy
+
+import "go.uber.org/fx"
+
+// Module provides the deployer via Fx
+var Module = fx.Module("live/deploy",
+	fx.Provide(
+		NewDeployer,
+	),
+)
@@ -0,0 +1,282 @@
+package emergency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/config"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+)
+
+// killGrace is how long past its drain timeout a strategy may take to stop before it is killed, and how long
+// one only found by its monitoring socket may take to shut down. It is shorter than the manager's own fallbacks,
+// which wait for the control socket and then a SIGTERM in turn.
+var killGrace = 5 * time.Second
+
+// shutdownPoll is how often a strategy shut down through its monitoring socket is checked for having gone
+const shutdownPoll = 100 * time.Millisecond
+
+type emergencyStop struct {
+	manager    live.InstanceManager
+	querier    monitoring.ViewQuerier
+	connectors config.ConnectorService
+	settings   config.Configuration
+	registry   registry.ConnectorRegistry
+	drainer    live.Drainer
+	logger     logging.ApplicationLogger
+}
+
+// NewEmergencyStop creates the panic button. The exchange sweep uses this process's connector registry,
+// so it works without any strategy process being reachable.
+func NewEmergencyStop(
+	manager live.InstanceManager,
+	querier monitoring.ViewQuerier,
+	connectors config.ConnectorService,
+	settings config.Configuration,
+	registry registry.ConnectorRegistry,
+	drainer live.Drainer,
+	logger logging.ApplicationLogger,
+) live.EmergencyStop {
+	return &emergencyStop{
+		manager:    manager,
+		querier:    querier,
+		connectors: connectors,
+		settings:   settings,
+		registry:   registry,
+		drainer:    drainer,
+		logger:     logger,
+	}
+}
+
+// target is a strategy to stop; instance is nil for strategies only found by their monitoring socket
+type target struct {
+	name     string
+	instance *live.Instance
+}
+
+func (e *emergencyStop) Panic(ctx context.Context, opts live.PanicOptions) *live.PanicReport {
+	report := &live.PanicReport{Mode: opts.Mode, StartedAt: time.Now()}
+
+	e.logger.Warn("Panic: stopping all instances", "mode", opts.Mode, "drain_timeout", opts.DrainTimeout)
+
+	targets := e.targets(report)
+	report.Stops = make([]*live.PanicStop, len(targets))
+
+	var wg sync.WaitGroup
+	for i, t := range targets {
+		wg.Add(1)
+		go func(i int, t target) {
+			defer wg.Done()
+			if t.instance != nil && t.instance.Status == live.StatusStandby {
+				report.Stops[i] = e.killStandby(t.instance, opts.Actor)
+				return
+			}
+			report.Stops[i] = e.stop(t, opts)
+		}(i, t)
+	}
+	wg.Wait()
+
+	// Strategies that drained themselves leave nothing behind; the sweep catches those that couldn't
+	if opts.Mode == live.StopModeCancelOrders || opts.Mode == live.StopModeFlatten {
+		e.sweep(ctx, opts, report)
+	}
+
+	report.Duration = time.Since(report.StartedAt)
+	e.logger.Warn("Panic complete", "instances", len(report.Stops), "clean", report.Clean(), "took", report.Duration)
+
+	return report
+}
+
+// targets lists the instances this process tracks plus any other strategy with a monitoring socket
+func (e *emergencyStop) targets(report *live.PanicReport) []target {
+	var targets []target
+	seen := make(map[string]bool)
+
+	for _, status := range []live.InstanceStatus{live.StatusRunning, live.StatusStandby} {
+		instances, _ := e.manager.List(status)
+		for _, instance := range instances {
+			targets = append(targets, target{name: instance.StrategyName, instance: instance})
+			if status == live.StatusRunning {
+				seen[instance.StrategyName] = true
+			}
+		}
+	}
+
+	names, err := e.querier.ListInstances()
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("failed to list instance sockets: %v", err))
+	}
+	for _, name := range names {
+		if !seen[name] {
+			seen[name] = true
+			targets = append(targets, target{name: name})
+		}
+	}
+
+	sort.SliceStable(targets, func(i, j int) bool { return targets[i].name < targets[j].name })
+	return targets
+}
+
+// stop drains a strategy through the manager and kills it if that doesn't finish in time
+func (e *emergencyStop) stop(t target, opts live.PanicOptions) *live.PanicStop {
+	stop := &live.PanicStop{StrategyName: t.name}
+	if t.instance != nil {
+		stop.InstanceID = t.instance.ID
+	}
+
+	type outcome struct {
+		result *live.StopResult
+		err    error
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan outcome, 1)
+	go func() {
+		result, err := e.manager.StopStrategy(ctx, t.name, live.StopOptions{
+			Mode:         opts.Mode,
+			DrainTimeout: opts.DrainTimeout,
+			Actor:        opts.Actor,
+		})
+		done <- outcome{result: result, err: err}
+	}()
+
+	timer := time.NewTimer(opts.DrainTimeout + killGrace)
+	defer timer.Stop()
+
+	var out outcome
+	select {
+	case out = <-done:
+	case <-timer.C:
+		// The stop has to be over before the kill, or both would end the session
+		cancel()
+		if out = <-done; out.err != nil {
+			out.err = fmt.Errorf("no response within %s", opts.DrainTimeout+killGrace)
+		}
+	}
+	if out.err == nil {
+		stop.Result = out.result
+		return stop
+	}
+	stopErr := out.err
+
+	e.logger.Warn("Panic: graceful stop failed", "strategy", t.name, "error", stopErr)
+
+	// Without a tracked process the monitoring socket is the last way in
+	if t.instance == nil {
+		if err := e.shutdown(t.name); err != nil {
+			stop.Error = fmt.Sprintf("%v; monitoring shutdown: %v", stopErr, err)
+		}
+		return stop
+	}
+
+	if err := e.manager.Kill(live.WithActor(context.Background(), opts.Actor), t.instance.ID); err != nil {
+		stop.Error = fmt.Sprintf("%v; kill: %v", stopErr, err)
+		return stop
+	}
+	stop.Killed = true
+	return stop
+}
+
+// shutdown asks a strategy to shut down through its monitoring socket and waits up to killGrace for the socket
+// to go. The request being accepted doesn't mean the strategy stopped trading, so only the socket going does.
+func (e *emergencyStop) shutdown(name string) error {
+	if err := e.querier.Shutdown(name); err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(killGrace)
+	for {
+		if e.socketGone(name) {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("still serving its monitoring socket %s after shutdown", killGrace)
+		}
+		time.Sleep(shutdownPoll)
+	}
+}
+
+// socketGone reports whether nothing serves the strategy's monitoring socket any more:
+// it was removed, or it was left behind by a process that died and refuses connections
+func (e *emergencyStop) socketGone(name string) bool {
+	names, err := e.querier.ListInstances()
+	if err == nil && !slices.Contains(names, name) {
+		return true
+	}
+	return errors.Is(e.querier.HealthCheck(name), syscall.ECONNREFUSED)
+}
+
+// killStandby kills a standby instance; it hasn't taken over any trading, so there is nothing to drain
+func (e *emergencyStop) killStandby(instance *live.Instance, actor live.Actor) *live.PanicStop {
+	stop := &live.PanicStop{StrategyName: instance.StrategyName, InstanceID: instance.ID, Standby: true}
+	if err := e.manager.Kill(live.WithActor(context.Background(), actor), instance.ID); err != nil {
+		stop.Error = fmt.Sprintf("kill: %v", err)
+		return stop
+	}
+	stop.Killed = true
+	return stop
+}
+
+// sweep sets up a connector for every enabled exchange in this process and drains them all
+func (e *emergencyStop) sweep(ctx context.Context, opts live.PanicOptions, report *live.PanicReport) {
+	userConnectors, err := e.settings.GetConnectors()
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("exchanges not swept, failed to read connector settings: %v", err))
+		return
+	}
+
+	ready := 0
+	for _, userConnector := range userConnectors {
+		if !userConnector.Enabled {
+			continue
+		}
+		exchange := &live.PanicExchange{Name: userConnector.Name}
+		if err := e.prepare(userConnector.Name); err != nil {
+			exchange.Error = err.Error()
+		} else {
+			ready++
+		}
+		report.Exchanges = append(report.Exchanges, exchange)
+	}
+	if ready == 0 {
+		return
+	}
+
+	drainCtx, cancel := context.WithTimeout(ctx, opts.DrainTimeout)
+	defer cancel()
+
+	report.Sweep = e.drainer.Drain(drainCtx, opts.Mode)
+	report.Sweep.StrategyName = "all exchanges"
+}
+
+// prepare initialises an exchange's connector from its settings and marks it ready for the drainer
+func (e *emergencyStop) prepare(name string) error {
+	exchange := connector.ExchangeName(name)
+
+	configs, err := e.connectors.GetConnectorConfigsForStrategy([]string{name})
+	if err != nil {
+		return err
+	}
+
+	conn, ok := e.registry.GetConnector(exchange)
+	if !ok {
+		return fmt.Errorf("no %s connector available", name)
+	}
+	if !conn.IsInitialized() {
+		if err := conn.Initialize(configs[exchange]); err != nil {
+			return fmt.Errorf("failed to initialise connector: %w", err)
+		}
+	}
+
+	return e.registry.MarkConnectorReady(exchange)
+}
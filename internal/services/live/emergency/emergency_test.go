@@ -0,0 +1,235 @@
+package emergency_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"syscall"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/emergency"
+	livemocks "github.com/backtesting-org/kronos-cli/mocks/github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	configmocks "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/config"
+	connectormocks "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	monitoringmocks "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	registrymocks "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/config"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+)
+
+var _ = Describe("EmergencyStop", func() {
+	var (
+		manager    *livemocks.InstanceManager
+		querier    *monitoringmocks.ViewQuerier
+		connectors *configmocks.ConnectorService
+		settings   *configmocks.Configuration
+		registry   *registrymocks.ConnectorRegistry
+		drainer    *livemocks.Drainer
+		stopper    live.EmergencyStop
+
+		running []*live.Instance
+		standby []*live.Instance
+		sockets []string
+		opts    live.PanicOptions
+	)
+
+	BeforeEach(func() {
+		manager = livemocks.NewInstanceManager(GinkgoT())
+		querier = monitoringmocks.NewViewQuerier(GinkgoT())
+		connectors = configmocks.NewConnectorService(GinkgoT())
+		settings = configmocks.NewConfiguration(GinkgoT())
+		registry = registrymocks.NewConnectorRegistry(GinkgoT())
+		drainer = livemocks.NewDrainer(GinkgoT())
+		stopper = emergency.NewEmergencyStop(manager, querier, connectors, settings, registry, drainer, &logging.NoOpLogger{})
+
+		running = []*live.Instance{
+			{ID: "a-1", StrategyName: "alpha", Status: live.StatusRunning},
+			{ID: "b-1", StrategyName: "beta", Status: live.StatusRunning},
+		}
+		standby = nil
+		sockets = []string{"alpha", "beta"}
+		opts = live.PanicOptions{Mode: live.StopModeLeave, DrainTimeout: time.Second}
+
+		manager.EXPECT().List(live.StatusRunning).RunAndReturn(func(live.InstanceStatus) ([]*live.Instance, error) {
+			return running, nil
+		})
+		manager.EXPECT().List(live.StatusStandby).RunAndReturn(func(live.InstanceStatus) ([]*live.Instance, error) {
+			return standby, nil
+		})
+		querier.EXPECT().ListInstances().RunAndReturn(func() ([]string, error) { return sockets, nil })
+	})
+
+	It("stops every instance in parallel", func() {
+		manager.EXPECT().StopStrategy(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, name string, stopOpts live.StopOptions) (*live.StopResult, error) {
+			time.Sleep(200 * time.Millisecond)
+			return &live.StopResult{StrategyName: name, Mode: stopOpts.Mode}, nil
+		}).Times(2)
+
+		started := time.Now()
+		report := stopper.Panic(context.Background(), opts)
+
+		Expect(time.Since(started)).To(BeNumerically("<", 400*time.Millisecond))
+		Expect(report.Stops).To(HaveLen(2))
+		Expect(report.Stops[0].StrategyName).To(Equal("alpha"))
+		Expect(report.Stops[0].Result).NotTo(BeNil())
+		Expect(report.Stops[1].StrategyName).To(Equal("beta"))
+		Expect(report.Sweep).To(BeNil())
+		Expect(report.Clean()).To(BeTrue())
+	})
+
+	It("kills an instance that can't be stopped gracefully and still stops the rest", func() {
+		manager.EXPECT().StopStrategy(mock.Anything, "alpha", mock.Anything).Return(nil, errors.New("failed to signal process"))
+		manager.EXPECT().StopStrategy(mock.Anything, "beta", mock.Anything).Return(&live.StopResult{StrategyName: "beta"}, nil)
+		manager.EXPECT().Kill(mock.Anything, "a-1").Return(nil)
+
+		report := stopper.Panic(context.Background(), opts)
+
+		Expect(report.Stops[0].Killed).To(BeTrue())
+		Expect(report.Stops[0].Error).To(BeEmpty())
+		Expect(report.Stops[1].Killed).To(BeFalse())
+		Expect(report.Clean()).To(BeTrue())
+	})
+
+	It("shuts down instances only found by their monitoring socket", func() {
+		running = running[:1]
+		sockets = []string{"alpha", "gamma"}
+		manager.EXPECT().StopStrategy(mock.Anything, "alpha", mock.Anything).Return(&live.StopResult{StrategyName: "alpha"}, nil)
+		manager.EXPECT().StopStrategy(mock.Anything, "gamma", mock.Anything).Return(nil, errors.New("no running instance found for strategy: gamma"))
+		querier.EXPECT().Shutdown("gamma").Return(errors.New("connection refused"))
+
+		report := stopper.Panic(context.Background(), opts)
+
+		Expect(report.Stops).To(HaveLen(2))
+		Expect(report.Stops[1].StrategyName).To(Equal("gamma"))
+		Expect(report.Stops[1].InstanceID).To(BeEmpty())
+		Expect(report.Stops[1].Error).To(ContainSubstring("connection refused"))
+		Expect(report.Clean()).To(BeFalse())
+	})
+
+	It("cancels a stop that timed out before killing the instance", func() {
+		DeferCleanup(emergency.SetKillGrace(50 * time.Millisecond))
+		opts.DrainTimeout = 10 * time.Millisecond
+
+		var mu sync.Mutex
+		var order []string
+		manager.EXPECT().StopStrategy(mock.Anything, "alpha", mock.Anything).RunAndReturn(func(ctx context.Context, _ string, _ live.StopOptions) (*live.StopResult, error) {
+			<-ctx.Done()
+			mu.Lock()
+			defer mu.Unlock()
+			order = append(order, "stop cancelled")
+			return nil, ctx.Err()
+		})
+		manager.EXPECT().StopStrategy(mock.Anything, "beta", mock.Anything).Return(&live.StopResult{StrategyName: "beta"}, nil)
+		manager.EXPECT().Kill(mock.Anything, "a-1").RunAndReturn(func(context.Context, string) error {
+			mu.Lock()
+			defer mu.Unlock()
+			order = append(order, "kill")
+			return nil
+		})
+
+		report := stopper.Panic(context.Background(), opts)
+
+		Expect(order).To(Equal([]string{"stop cancelled", "kill"}))
+		Expect(report.Stops[0].Killed).To(BeTrue())
+		Expect(report.Clean()).To(BeTrue())
+	})
+
+	Describe("instances only found by their monitoring socket", func() {
+		BeforeEach(func() {
+			DeferCleanup(emergency.SetKillGrace(50 * time.Millisecond))
+			running = nil
+			sockets = []string{"gamma"}
+			manager.EXPECT().StopStrategy(mock.Anything, "gamma", mock.Anything).Return(nil, errors.New("no running instance found for strategy: gamma"))
+		})
+
+		It("counts them as stopped once their socket is gone", func() {
+			querier.EXPECT().Shutdown("gamma").RunAndReturn(func(string) error {
+				sockets = nil
+				return nil
+			})
+
+			report := stopper.Panic(context.Background(), opts)
+
+			Expect(report.Stops[0].Error).To(BeEmpty())
+			Expect(report.Clean()).To(BeTrue())
+		})
+
+		It("counts a socket left behind that refuses connections as gone", func() {
+			querier.EXPECT().Shutdown("gamma").Return(nil)
+			querier.EXPECT().HealthCheck("gamma").Return(fmt.Errorf("failed to connect to instance gamma: %w", syscall.ECONNREFUSED))
+
+			report := stopper.Panic(context.Background(), opts)
+
+			Expect(report.Clean()).To(BeTrue())
+		})
+
+		It("reports them as failed while they keep serving after the shutdown", func() {
+			querier.EXPECT().Shutdown("gamma").Return(nil)
+			querier.EXPECT().HealthCheck("gamma").Return(nil)
+
+			report := stopper.Panic(context.Background(), opts)
+
+			Expect(report.Stops[0].Error).To(ContainSubstring("still serving its monitoring socket"))
+			Expect(report.Clean()).To(BeFalse())
+		})
+	})
+
+	It("kills standby instances without draining them", func() {
+		running, sockets = nil, nil
+		standby = []*live.Instance{{ID: "a-2", StrategyName: "alpha", Status: live.StatusStandby}}
+		manager.EXPECT().Kill(mock.Anything, "a-2").Return(nil)
+
+		report := stopper.Panic(context.Background(), opts)
+
+		Expect(report.Stops).To(HaveLen(1))
+		Expect(report.Stops[0].Standby).To(BeTrue())
+		Expect(report.Stops[0].Killed).To(BeTrue())
+	})
+
+	Describe("flatten", func() {
+		BeforeEach(func() {
+			running, sockets = nil, nil
+			opts.Mode = live.StopModeFlatten
+
+			settings.EXPECT().GetConnectors().Return([]config.Connector{
+				{Name: "binance", Enabled: true},
+				{Name: "kraken", Enabled: false},
+				{Name: "bybit", Enabled: true},
+			}, nil)
+		})
+
+		It("sweeps every enabled exchange and reports the ones it couldn't set up", func() {
+			binance := connectormocks.NewConnector(GinkgoT())
+			binance.EXPECT().IsInitialized().Return(false)
+			binance.EXPECT().Initialize(mock.Anything).Return(nil)
+
+			connectors.EXPECT().GetConnectorConfigsForStrategy([]string{"binance"}).Return(map[connector.ExchangeName]connector.Config{"binance": nil}, nil)
+			connectors.EXPECT().GetConnectorConfigsForStrategy([]string{"bybit"}).Return(nil, errors.New("missing credentials"))
+			registry.EXPECT().GetConnector(connector.ExchangeName("binance")).Return(binance, true)
+			registry.EXPECT().MarkConnectorReady(connector.ExchangeName("binance")).Return(nil)
+			drainer.EXPECT().Drain(mock.Anything, live.StopModeFlatten).Return(&live.StopResult{
+				Mode:            live.StopModeFlatten,
+				CancelledOrders: 3,
+				ClosedPositions: 1,
+				Snapshot:        true,
+			})
+
+			report := stopper.Panic(context.Background(), opts)
+
+			Expect(report.Exchanges).To(HaveLen(2))
+			Expect(report.Exchanges[0].Name).To(Equal("binance"))
+			Expect(report.Exchanges[0].Error).To(BeEmpty())
+			Expect(report.Exchanges[1].Name).To(Equal("bybit"))
+			Expect(report.Exchanges[1].Error).To(ContainSubstring("missing credentials"))
+			Expect(report.Sweep.ClosedPositions).To(Equal(1))
+			Expect(report.Clean()).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,10 @@
+package emergency
+
+import "time"
+
+// SetKillGrace shortens how long stops are given before the kill; the returned func restores it
+func SetKillGrace(d time.Duration) func() {
+	previous := killGrace
+	killGrace = d
+	return func() { killGrace = previous }
+}
@@ -0,0 +1,10 @@
+package emergency
+
+import "go.uber.org/fx"
+
+// Module provides the emergency stop via Fx
+var Module = fx.Module("live/emergency",
+	fx.Provide(
+		NewEmergencyStop,
+	),
+)
@@ -0,0 +1,82 @@
+package events
+
+import (
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+)
+
+// subscriberBuffer is how many events a slow subscriber may fall behind before events are dropped for it
+const subscriberBuffer = 64
+
+type eventBus struct {
+	mu          sync.RWMutex
+	state       live.StateStore
+	logger      logging.ApplicationLogger
+	actor       live.Actor
+	subscribers map[chan live.Event]struct{}
+}
+
+// NewEventBus creates an EventBus that records every event in the state store's audit log.
+// Events are attributed to the CLI until the command line says otherwise.
+func NewEventBus(state live.StateStore, logger logging.ApplicationLogger) live.EventBus {
+	return &eventBus{
+		state:       state,
+		logger:      logger,
+		actor:       live.ActorCLI,
+		subscribers: make(map[chan live.Event]struct{}),
+	}
+}
+
+// Publish records the event and hands it to subscribers without blocking on them
+func (b *eventBus) Publish(event live.Event) {
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+
+	b.mu.RLock()
+	if event.Actor == "" {
+		event.Actor = b.actor
+	}
+	for ch := range b.subscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+	b.mu.RUnlock()
+
+	// A lost audit entry must not fail the operation it describes
+	if err := b.state.AppendEvent(&event); err != nil {
+		b.logger.Warn("Failed to record event", "type", event.Type, "strategy", event.StrategyName, "error", err)
+	}
+}
+
+// Subscribe registers a buffered channel for events published in this process
+func (b *eventBus) Subscribe() (<-chan live.Event, func()) {
+	ch := make(chan live.Event, subscriberBuffer)
+
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subscribers, ch)
+			b.mu.Unlock()
+			close(ch)
+		})
+	}
+	return ch, cancel
+}
+
+// SetActor sets the default actor for events published from this process
+func (b *eventBus) SetActor(actor live.Actor) {
+	b.mu.Lock()
+	b.actor = actor
+	b.mu.Unlock()
+}
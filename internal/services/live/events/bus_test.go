@@ -0,0 +1,113 @@
+package events_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/events"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/manager"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+)
+
+var _ = Describe("EventBus", func() {
+	var (
+		state live.StateStore
+		bus   live.EventBus
+	)
+
+	BeforeEach(func() {
+		dir, err := os.MkdirTemp("", "events")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(func() {
+			_ = os.RemoveAll(dir)
+		})
+
+		state = manager.NewFileStateStoreAt(filepath.Join(dir, ".instances.json"))
+		bus = events.NewEventBus(state, &logging.NoOpLogger{})
+	})
+
+	Describe("Publish", func() {
+		It("should record the event with its time and the default actor", func() {
+			bus.Publish(live.Event{Type: live.EventStarted, StrategyName: "alpha", InstanceID: "a1"})
+
+			recorded, err := state.ListEvents(live.HistoryFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorded).To(HaveLen(1))
+			Expect(recorded[0].Type).To(Equal(live.EventStarted))
+			Expect(recorded[0].Actor).To(Equal(live.ActorCLI))
+			Expect(recorded[0].InstanceID).To(Equal("a1"))
+			Expect(recorded[0].Time).To(BeTemporally("~", time.Now(), time.Second))
+		})
+
+		It("should keep an actor the event names over the default", func() {
+			bus.SetActor(live.ActorTUI)
+			bus.Publish(live.Event{Type: live.EventStopped, StrategyName: "alpha"})
+			bus.Publish(live.Event{Type: live.EventCrashed, Actor: live.ActorDaemon, StrategyName: "alpha"})
+
+			recorded, err := state.ListEvents(live.HistoryFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorded).To(HaveLen(2))
+			Expect(recorded[0].Actor).To(Equal(live.ActorDaemon))
+			Expect(recorded[1].Actor).To(Equal(live.ActorTUI))
+		})
+	})
+
+	Describe("Subscribe", func() {
+		It("should deliver published events until cancelled", func() {
+			stream, cancel := bus.Subscribe()
+
+			bus.Publish(live.Event{Type: live.EventPromoted, StrategyName: "alpha"})
+			Eventually(stream).Should(Receive(HaveField("Type", live.EventPromoted)))
+
+			cancel()
+			Eventually(stream).Should(BeClosed())
+
+			// Publishing after cancel must not panic on the closed channel
+			bus.Publish(live.Event{Type: live.EventStopped, StrategyName: "alpha"})
+			cancel()
+		})
+	})
+
+	Describe("Follow", func() {
+		It("should send events recorded after since once each, oldest first", func() {
+			since := time.Now()
+			bus.Publish(live.Event{Time: since, Type: live.EventStarted, StrategyName: "alpha"})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			DeferCleanup(cancel)
+			stream, _ := events.Follow(ctx, state, live.HistoryFilter{}, since, 10*time.Millisecond)
+
+			bus.Publish(live.Event{Type: live.EventStopped, StrategyName: "alpha"})
+			bus.Publish(live.Event{Type: live.EventStarted, StrategyName: "beta"})
+
+			var event *live.Event
+			Eventually(stream).Should(Receive(&event))
+			Expect(event.Type).To(Equal(live.EventStopped))
+			Eventually(stream).Should(Receive(&event))
+			Expect(event.StrategyName).To(Equal("beta"))
+
+			// Later polls read the same events again and must skip them
+			Consistently(stream, 100*time.Millisecond).ShouldNot(Receive())
+		})
+
+		It("should only follow the filtered strategy", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			DeferCleanup(cancel)
+			stream, _ := events.Follow(ctx, state, live.HistoryFilter{StrategyName: "beta"}, time.Now(), 10*time.Millisecond)
+
+			bus.Publish(live.Event{Type: live.EventStarted, StrategyName: "alpha"})
+			bus.Publish(live.Event{Type: live.EventStarted, StrategyName: "beta"})
+
+			var event *live.Event
+			Eventually(stream).Should(Receive(&event))
+			Expect(event.StrategyName).To(Equal("beta"))
+			Consistently(stream, 50*time.Millisecond).ShouldNot(Receive())
+		})
+	})
+})
@@ -0,0 +1,83 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+// followBatch caps how many events one poll reads
+const followBatch = 1000
+
+// Follow polls the audit log for events recorded after since, by any kronos process, and sends them
+// oldest first until ctx is done. Errors reading the log are sent on errs without stopping.
+func Follow(ctx context.Context, state live.StateStore, filter live.HistoryFilter, since time.Time, interval time.Duration) (<-chan *live.Event, <-chan error) {
+	out := make(chan *live.Event)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(out)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		// Events sharing the last timestamp sent may still be joined by others, so that
+		// timestamp is read again and the events already sent are skipped. Until the first
+		// event is sent, everything at since counts as already seen by the caller.
+		seen := make(map[followKey]bool)
+		started := false
+		for {
+			filter.Since = since
+			filter.Limit = followBatch
+			recorded, err := state.ListEvents(filter)
+			if err != nil {
+				select {
+				case errs <- err:
+				default:
+				}
+			}
+
+			// ListEvents returns newest first
+			for i := len(recorded) - 1; i >= 0; i-- {
+				event := recorded[i]
+				key := keyOf(event)
+				if seen[key] || (!started && !event.Time.After(since)) {
+					continue
+				}
+				started = true
+				if event.Time.After(since) {
+					since = event.Time
+					seen = make(map[followKey]bool)
+				}
+				seen[key] = true
+
+				select {
+				case out <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return out, errs
+}
+
+// followKey identifies an event; decoded times are compared by instant, not by location
+type followKey struct {
+	at    int64
+	event live.Event
+}
+
+func keyOf(event *live.Event) followKey {
+	key := followKey{at: event.Time.UnixNano(), event: *event}
+	key.event.Time = time.Time{}
+	return key
+}
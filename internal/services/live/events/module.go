@@ -0,0 +1,10 @@
+package events
+
+import "go.uber.org/fx"
+
+// Module provides the lifecycle event bus via Fx
+var Module = fx.Module("live/events",
+	fx.Provide(
+		NewEventBus,
+	),
+)
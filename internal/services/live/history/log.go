@@ -0,0 +1,136 @@
+package history
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+// maxLineSize bounds a single record; sessions and events are a few hundred bytes
+const maxLineSize = 1024 * 1024
+
+// Log is an append-only JSONL file of records, used by the file state backend for
+// sessions, events and restarts
+type Log[T any] struct {
+	mu   sync.Mutex
+	path string
+
+	// strategyOf and timeOf let List filter and order records
+	strategyOf func(*T) string
+	timeOf     func(*T) time.Time
+}
+
+// NewLog creates a log at path. strategyOf and timeOf tell List which strategy a record belongs to and when it happened.
+func NewLog[T any](path string, strategyOf func(*T) string, timeOf func(*T) time.Time) *Log[T] {
+	return &Log[T]{
+		path:       path,
+		strategyOf: strategyOf,
+		timeOf:     timeOf,
+	}
+}
+
+// Path returns the path of the log file
+func (l *Log[T]) Path() string {
+	return l.path
+}
+
+// Append adds the record as one JSON line. Appends of a single small write are atomic,
+// so several kronos processes can write to the same file.
+func (l *Log[T]) Append(record *T) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to encode record: %w", err)
+	}
+	data = append(data, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0755); err != nil {
+		return fmt.Errorf("failed to create log directory: %w", err)
+	}
+
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", l.path, err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(data); err != nil {
+		return fmt.Errorf("failed to write %s: %w", l.path, err)
+	}
+	return nil
+}
+
+// List reads the log and returns the matching records, newest first.
+// Lines that can't be parsed (e.g. from a write cut short) are skipped.
+func (l *Log[T]) List(filter live.HistoryFilter) ([]*T, error) {
+	f, err := os.Open(l.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []*T{}, nil
+		}
+		return nil, fmt.Errorf("failed to open %s: %w", l.path, err)
+	}
+	defer f.Close()
+
+	records := []*T{}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	for scanner.Scan() {
+		record := new(T)
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			continue
+		}
+		if filter.StrategyName != "" && l.strategyOf(record) != filter.StrategyName {
+			continue
+		}
+		if !filter.Since.IsZero() && l.timeOf(record).Before(filter.Since) {
+			continue
+		}
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", l.path, err)
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return l.timeOf(records[i]).After(l.timeOf(records[j]))
+	})
+
+	if filter.Limit > 0 && len(records) > filter.Limit {
+		records = records[:filter.Limit]
+	}
+	return records, nil
+}
+
+// NewSessionLog creates a log of archived sessions, ordered by when they ended
+func NewSessionLog(path string) *Log[live.Session] {
+	return NewLog(path,
+		func(s *live.Session) string { return s.StrategyName },
+		func(s *live.Session) time.Time { return s.EndedAt },
+	)
+}
+
+// NewEventLog creates a log of lifecycle events
+func NewEventLog(path string) *Log[live.Event] {
+	return NewLog(path,
+		func(e *live.Event) string { return e.StrategyName },
+		func(e *live.Event) time.Time { return e.Time },
+	)
+}
+
+// NewRestartLog creates a log of strategy restarts
+func NewRestartLog(path string) *Log[live.RestartRecord] {
+	return NewLog(path,
+		func(r *live.RestartRecord) string { return r.StrategyName },
+		func(r *live.RestartRecord) time.Time { return r.Time },
+	)
+}
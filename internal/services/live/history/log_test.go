@@ -0,0 +1,114 @@
+package history_test
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/history"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+var _ = Describe("Log", func() {
+	var (
+		path    string
+		archive *history.Log[live.Session]
+		now     time.Time
+	)
+
+	session := func(id, strategy string, endedAgo time.Duration) *live.Session {
+		return &live.Session{
+			ID:           id,
+			StrategyName: strategy,
+			StartedAt:    now.Add(-endedAgo - time.Hour),
+			EndedAt:      now.Add(-endedAgo),
+			Status:       live.StatusStopped,
+		}
+	}
+
+	BeforeEach(func() {
+		dir, err := os.MkdirTemp("", "history")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(func() {
+			_ = os.RemoveAll(dir)
+		})
+
+		path = filepath.Join(dir, "nested", "history.jsonl")
+		archive = history.NewSessionLog(path)
+		now = time.Now().Truncate(time.Second)
+	})
+
+	It("should return no sessions before anything was archived", func() {
+		sessions, err := archive.List(live.HistoryFilter{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sessions).To(BeEmpty())
+	})
+
+	It("should round trip a session with its final figures", func() {
+		archived := session("a", "momentum", 0)
+		archived.Status = live.StatusCrashed
+		archived.CrashReason = live.CrashReasonMemoryLimit
+		archived.Restarts = 2
+		archived.Binary = &live.BinaryInfo{Path: "/usr/bin/kronos", Hash: "abc123"}
+		archived.Stats = &live.SessionStats{TradeCount: 42, CapturedAt: now}
+		Expect(archive.Append(archived)).To(Succeed())
+
+		sessions, err := archive.List(live.HistoryFilter{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sessions).To(HaveLen(1))
+		Expect(sessions[0].CrashReason).To(Equal(live.CrashReasonMemoryLimit))
+		Expect(sessions[0].Restarts).To(Equal(2))
+		Expect(sessions[0].Binary.Hash).To(Equal("abc123"))
+		Expect(sessions[0].Stats.TradeCount).To(Equal(42))
+		Expect(sessions[0].Duration()).To(Equal(time.Hour))
+	})
+
+	It("should list newest first and apply the filter", func() {
+		Expect(archive.Append(session("old", "momentum", 3*time.Hour))).To(Succeed())
+		Expect(archive.Append(session("other", "arb", time.Hour))).To(Succeed())
+		Expect(archive.Append(session("new", "momentum", 0))).To(Succeed())
+
+		sessions, err := archive.List(live.HistoryFilter{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ids(sessions)).To(Equal([]string{"new", "other", "old"}))
+
+		sessions, err = archive.List(live.HistoryFilter{StrategyName: "momentum"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ids(sessions)).To(Equal([]string{"new", "old"}))
+
+		sessions, err = archive.List(live.HistoryFilter{Since: now.Add(-2 * time.Hour)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ids(sessions)).To(Equal([]string{"new", "other"}))
+
+		sessions, err = archive.List(live.HistoryFilter{Limit: 1})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ids(sessions)).To(Equal([]string{"new"}))
+	})
+
+	It("should skip lines that can't be parsed", func() {
+		Expect(archive.Append(session("a", "momentum", time.Hour))).To(Succeed())
+
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = f.WriteString("{\"id\": \"trunc\n")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.Close()).To(Succeed())
+
+		Expect(archive.Append(session("b", "momentum", 0))).To(Succeed())
+
+		sessions, err := archive.List(live.HistoryFilter{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ids(sessions)).To(Equal([]string{"b", "a"}))
+	})
+})
+
+func ids(sessions []*live.Session) []string {
+	result := make([]string, 0, len(sessions))
+	for _, s := range sessions {
+		result = append(result, s.ID)
+	}
+	return result
+}
@@ -0,0 +1,10 @@
+package history
+
+import "go.uber.org/fx"
+
+// Module provides the session history store via Fx
+var Module = fx.Module("live/history",
+	fx.Provide(
+		NewHistoryStore,
+	),
+)
@@ -0,0 +1,24 @@
+package history
+
+import (
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+type stateHistoryStore struct {
+	state live.StateStore
+}
+
+// NewHistoryStore creates a HistoryStore that archives sessions in the configured state store
+func NewHistoryStore(state live.StateStore) live.HistoryStore {
+	return &stateHistoryStore{state: state}
+}
+
+// Append archives a finished session
+func (s *stateHistoryStore) Append(session *live.Session) error {
+	return s.state.AppendSession(session)
+}
+
+// List returns archived sessions matching filter, newest first
+func (s *stateHistoryStore) List(filter live.HistoryFilter) ([]*live.Session, error) {
+	return s.state.ListSessions(filter)
+}
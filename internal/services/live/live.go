@@ -4,8 +4,8 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
-	strategyTypes "github.com/backtesting-org/kronos-cli/pkg/strategy"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/config"
 
 	"github.com/backtesting-org/kronos-cli/pkg/live"
@@ -18,43 +18,43 @@ type LiveService interface {
 
 // liveService orchestrates live trading by coordinating other services
 type liveService struct {
-	connectorService config.ConnectorService
-	compile          strategyTypes.CompileService
-	logger           logging.ApplicationLogger
-	manager          live.InstanceManager
+	preflight live.Preflight
+	logger    logging.ApplicationLogger
+	manager   live.InstanceManager
+	events    live.EventBus
 }
 
 func NewLiveService(
-	connectorService config.ConnectorService,
-	compileSvc strategyTypes.CompileService,
+	preflight live.Preflight,
 	logger logging.ApplicationLogger,
 	manager live.InstanceManager,
+	events live.EventBus,
 ) LiveService {
 	return &liveService{
-		connectorService: connectorService,
-		compile:          compileSvc,
-		logger:           logger,
-		manager:          manager,
+		preflight: preflight,
+		logger:    logger,
+		manager:   manager,
+		events:    events,
 	}
 }
 
 // ExecuteStrategy runs the selected strategy with all its configured exchanges
 func (s *liveService) ExecuteStrategy(ctx context.Context, strat *config.Strategy) error {
-	// 1. Pre-validate that we have connectors for this strategy's exchanges
-	connectorConfigs, err := s.connectorService.GetConnectorConfigsForStrategy(strat.Exchanges)
-	if err != nil {
-		return fmt.Errorf("cannot start strategy '%s': %w\n\nPlease check:\n- exchanges.yml has entries for: %v\n- Required exchanges are enabled\n- Exchange connectors are available in the SDK",
-			strat.Name, err, strat.Exchanges)
+	// 1. Pre-flight: credentials, assets, risk limits, the compiled plugin, the clock and running instances
+	report := s.preflight.Run(ctx, strat)
+	if !report.Passed() {
+		var failed []string
+		for _, check := range report.Failed() {
+			failed = append(failed, fmt.Sprintf("%s: %s", check.Name, check.Detail))
+		}
+		s.startFailed(ctx, strat, "preflight: "+strings.Join(failed, "; "))
+		return fmt.Errorf("cannot start strategy '%s', pre-flight failed:\n- %s\n\nRun 'kronos preflight %s' for the full checklist",
+			strat.Name, strings.Join(failed, "\n- "), strat.Name)
 	}
 
-	s.logger.Info("Validated connector configs", "strategy", strat.Name, "connectors", len(connectorConfigs))
-
-	// 2. Compile strategy if needed
-	if err := s.compile.CompileStrategy(strat.Path); err != nil {
-		return fmt.Errorf("failed to compile strategy: %w", err)
-	}
+	s.logger.Info("Pre-flight passed", "strategy", strat.Name, "checks", len(report.Checks))
 
-	// 3. Get current working directory as framework root
+	// 2. Get current working directory as framework root
 	frameworkRoot, err := os.Getwd()
 	if err != nil {
 		return fmt.Errorf("failed to get current directory: %w", err)
@@ -63,3 +63,13 @@ func (s *liveService) ExecuteStrategy(ctx context.Context, strat *config.Strateg
 	_, err = s.manager.Start(ctx, strat, frameworkRoot)
 	return err
 }
+
+// startFailed records a strategy that couldn't get as far as being spawned; the manager records spawn failures itself
+func (s *liveService) startFailed(ctx context.Context, strat *config.Strategy, reason string) {
+	s.events.Publish(live.Event{
+		Type:         live.EventStartFailed,
+		Actor:        live.ActorFromContext(ctx),
+		StrategyName: strat.Name,
+		Reason:       reason,
+	})
+}
@@ -0,0 +1,261 @@
+package logs
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Stream identifies which instance log file to read
+type Stream string
+
+const (
+	StreamStdout Stream = "stdout"
+	StreamStderr Stream = "stderr"
+)
+
+// Level is a normalised log level
+type Level int
+
+const (
+	LevelUnknown Level = iota
+	LevelDebug
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+// String returns the display name of the level
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "DEBUG"
+	case LevelInfo:
+		return "INFO"
+	case LevelWarn:
+		return "WARN"
+	case LevelError:
+		return "ERROR"
+	default:
+		return "-"
+	}
+}
+
+// ParseLevel converts an SDK/zap level name into a Level
+func ParseLevel(s string) Level {
+	switch strings.ToUpper(strings.TrimSpace(s)) {
+	case "DEBUG":
+		return LevelDebug
+	case "INFO":
+		return LevelInfo
+	case "WARN", "WARNING":
+		return LevelWarn
+	case "ERROR", "DPANIC", "PANIC", "FATAL":
+		return LevelError
+	default:
+		return LevelUnknown
+	}
+}
+
+// Entry is a single parsed log line
+type Entry struct {
+	Time       time.Time
+	Level      Level
+	Message    string
+	Caller     string
+	Fields     map[string]interface{}
+	Raw        string
+	Structured bool
+}
+
+// InstanceLogDir returns the directory holding logs for a strategy instance (relative to the project root)
+func InstanceLogDir(strategyName string) string {
+	return filepath.Join(".kronos", "instances", strategyName)
+}
+
+// InstanceLogPath returns the path of an instance's stdout or stderr log
+func InstanceLogPath(strategyName string, stream Stream) string {
+	return StreamPath(InstanceLogDir(strategyName), stream)
+}
+
+// StreamPath returns the path of the stdout or stderr log in an instance log directory
+func StreamPath(logDir string, stream Stream) string {
+	return filepath.Join(logDir, string(stream)+".log")
+}
+
+// ParseLine parses a log line. The SDK logs JSON objects via zap; anything else is kept as plain text.
+func ParseLine(line string) Entry {
+	entry := Entry{Raw: line, Message: line}
+
+	trimmed := strings.TrimSpace(line)
+	if !strings.HasPrefix(trimmed, "{") {
+		entry.Level = sniffLevel(trimmed)
+		return entry
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(trimmed), &fields); err != nil {
+		entry.Level = sniffLevel(trimmed)
+		return entry
+	}
+
+	entry.Structured = true
+	entry.Message = ""
+
+	if v, ok := fields["level"].(string); ok {
+		entry.Level = ParseLevel(v)
+		delete(fields, "level")
+	}
+	for _, key := range []string{"timestamp", "ts", "time"} {
+		if v, ok := fields[key].(string); ok {
+			if t, err := parseTime(v); err == nil {
+				entry.Time = t
+			}
+			delete(fields, key)
+			break
+		}
+	}
+	if v, ok := fields["msg"].(string); ok {
+		entry.Message = v
+		delete(fields, "msg")
+	}
+	if v, ok := fields["caller"].(string); ok {
+		entry.Caller = v
+		delete(fields, "caller")
+	}
+	delete(fields, "stacktrace")
+
+	entry.Fields = fields
+	return entry
+}
+
+func parseTime(v string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.000Z0700"} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognised time format: %s", v)
+}
+
+// sniffLevel finds a level keyword in plain-text lines such as "2026/01/02 ERROR something"
+func sniffLevel(line string) Level {
+	upper := strings.ToUpper(line)
+	for _, candidate := range []struct {
+		token string
+		level Level
+	}{
+		{"ERROR", LevelError},
+		{"FATAL", LevelError},
+		{"PANIC", LevelError},
+		{"WARN", LevelWarn},
+		{"INFO", LevelInfo},
+		{"DEBUG", LevelDebug},
+	} {
+		if strings.Contains(upper, candidate.token) {
+			return candidate.level
+		}
+	}
+	return LevelUnknown
+}
+
+// FormatFields renders structured fields as sorted key=value pairs
+func (e Entry) FormatFields() string {
+	if len(e.Fields) == 0 {
+		return ""
+	}
+
+	keys := make([]string, 0, len(e.Fields))
+	for k := range e.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s=%v", k, e.Fields[k]))
+	}
+	return strings.Join(parts, " ")
+}
+
+// Matches reports whether the entry passes a minimum level and a case-insensitive search term
+func (e Entry) Matches(minLevel Level, search string) bool {
+	if minLevel != LevelUnknown && e.Level < minLevel {
+		return false
+	}
+	if search == "" {
+		return true
+	}
+	return strings.Contains(strings.ToLower(e.Raw), strings.ToLower(search))
+}
+
+// tailChunkSize is how much is read from the end of the file per step when tailing
+const tailChunkSize = 64 * 1024
+
+// Tail returns up to n parsed entries from the end of the log at path
+func Tail(path string, n int) ([]Entry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	// Read backwards in chunks until we have enough lines (or hit the start)
+	offset := info.Size()
+	var buf []byte
+	for offset > 0 && strings.Count(string(buf), "\n") <= n {
+		step := int64(tailChunkSize)
+		if offset < step {
+			step = offset
+		}
+		offset -= step
+
+		chunk := make([]byte, step)
+		if _, err := f.ReadAt(chunk, offset); err != nil && err != io.EOF {
+			return nil, err
+		}
+		buf = append(chunk, buf...)
+	}
+
+	var lines []string
+	scanner := bufio.NewScanner(strings.NewReader(string(buf)))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	// The first line may be partial if we didn't read from the start
+	if offset > 0 && len(lines) > 0 {
+		lines = lines[1:]
+	}
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+
+	entries := make([]Entry, 0, len(lines))
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		entries = append(entries, ParseLine(line))
+	}
+
+	return entries, nil
+}
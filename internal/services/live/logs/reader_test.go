@@ -0,0 +1,84 @@
+package logs_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/logs"
+)
+
+var _ = Describe("Reader", func() {
+	Describe("ParseLine", func() {
+		It("should parse SDK structured log lines", func() {
+			line := `{"level":"WARN","timestamp":"2026-01-15T10:30:00.123Z","caller":"runtime/runtime.go:42","msg":"Order rejected","asset":"BTC"}`
+
+			entry := logs.ParseLine(line)
+			Expect(entry.Structured).To(BeTrue())
+			Expect(entry.Level).To(Equal(logs.LevelWarn))
+			Expect(entry.Message).To(Equal("Order rejected"))
+			Expect(entry.Caller).To(Equal("runtime/runtime.go:42"))
+			Expect(entry.Time.IsZero()).To(BeFalse())
+			Expect(entry.FormatFields()).To(Equal("asset=BTC"))
+		})
+
+		It("should keep plain lines as raw text", func() {
+			entry := logs.ParseLine("🚀 Starting live trading")
+			Expect(entry.Structured).To(BeFalse())
+			Expect(entry.Message).To(Equal("🚀 Starting live trading"))
+			Expect(entry.Level).To(Equal(logs.LevelUnknown))
+		})
+
+		It("should sniff levels from plain lines", func() {
+			Expect(logs.ParseLine("2026/01/15 ERROR boom").Level).To(Equal(logs.LevelError))
+		})
+	})
+
+	Describe("Matches", func() {
+		It("should filter by minimum level and search term", func() {
+			entry := logs.ParseLine(`{"level":"INFO","msg":"Filled order"}`)
+			Expect(entry.Matches(logs.LevelInfo, "")).To(BeTrue())
+			Expect(entry.Matches(logs.LevelWarn, "")).To(BeFalse())
+			Expect(entry.Matches(logs.LevelUnknown, "filled")).To(BeTrue())
+			Expect(entry.Matches(logs.LevelUnknown, "cancel")).To(BeFalse())
+		})
+	})
+
+	Describe("Tail", func() {
+		var tmpDir string
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = os.MkdirTemp("", "reader-test-*")
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(func() {
+				_ = os.RemoveAll(tmpDir)
+			})
+		})
+
+		It("should return the last n lines", func() {
+			var b strings.Builder
+			for i := 0; i < 100; i++ {
+				b.WriteString(fmt.Sprintf("line %d\n", i))
+			}
+			path := filepath.Join(tmpDir, "stderr.log")
+			Expect(os.WriteFile(path, []byte(b.String()), 0644)).To(Succeed())
+
+			entries, err := logs.Tail(path, 3)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(3))
+			Expect(entries[0].Raw).To(Equal("line 97"))
+			Expect(entries[2].Raw).To(Equal("line 99"))
+		})
+
+		It("should return nothing for a missing file", func() {
+			entries, err := logs.Tail(filepath.Join(tmpDir, "missing.log"), 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+		})
+	})
+})
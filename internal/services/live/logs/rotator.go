@@ -0,0 +1,249 @@
+package logs
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+// backupTimeFormat is embedded in rotated file names so they sort chronologically
+const backupTimeFormat = "20060102T150405"
+
+// Rotator rotates append-only log files in place using copy-truncate.
+//
+// The strategy process inherits its stdout/stderr file descriptors from the
+// spawner, so the files can't be swapped underneath it. Instead the current
+// contents are copied to a timestamped backup and the original is truncated;
+// because the files are opened with O_APPEND, subsequent writes land at the
+// new end of file.
+type Rotator struct {
+	cfg live.LogConfig
+
+	mu          sync.Mutex
+	lastRotated map[string]time.Time
+	now         func() time.Time
+}
+
+// NewRotator creates a rotator for the given log settings
+func NewRotator(cfg live.LogConfig) *Rotator {
+	return &Rotator{
+		cfg:         cfg,
+		lastRotated: make(map[string]time.Time),
+		now:         time.Now,
+	}
+}
+
+// Run checks the given log files every interval until ctx is cancelled
+func (r *Rotator) Run(ctx context.Context, interval time.Duration, paths ...string) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, path := range paths {
+				_, _ = r.RotateIfNeeded(path)
+			}
+		}
+	}
+}
+
+// RotateIfNeeded rotates path when it exceeds the size limit or the rotation interval has elapsed.
+// It returns true if the file was rotated.
+func (r *Rotator) RotateIfNeeded(path string) (bool, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if info.Size() == 0 {
+		return false, nil
+	}
+
+	if !r.shouldRotate(path, info.Size()) {
+		return false, nil
+	}
+
+	if err := r.Rotate(path); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *Rotator) shouldRotate(path string, size int64) bool {
+	if r.cfg.MaxSizeMB > 0 && size >= int64(r.cfg.MaxSizeMB)*1024*1024 {
+		return true
+	}
+
+	if r.cfg.RotateEvery <= 0 {
+		return false
+	}
+
+	return r.now().Sub(r.segmentStart(path)) >= r.cfg.RotateEvery
+}
+
+// segmentStart returns when the current log segment began: the last rotation
+// performed by this rotator, else the newest backup on disk, else now.
+func (r *Rotator) segmentStart(path string) time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if t, ok := r.lastRotated[path]; ok {
+		return t
+	}
+
+	start := r.now()
+	if backups, err := ListBackups(path); err == nil && len(backups) > 0 {
+		if info, err := os.Stat(backups[len(backups)-1]); err == nil {
+			start = info.ModTime()
+		}
+	}
+
+	r.lastRotated[path] = start
+	return start
+}
+
+// Rotate copies the current contents of path to a timestamped backup, truncates it and prunes old backups
+func (r *Rotator) Rotate(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open log for rotation: %w", err)
+	}
+	defer src.Close()
+
+	backupPath := r.backupName(path)
+	if err := r.copyTo(src, backupPath); err != nil {
+		_ = os.Remove(backupPath)
+		return err
+	}
+
+	if err := os.Truncate(path, 0); err != nil {
+		return fmt.Errorf("failed to truncate log: %w", err)
+	}
+
+	r.mu.Lock()
+	r.lastRotated[path] = r.now()
+	r.mu.Unlock()
+
+	return r.Prune(path)
+}
+
+func (r *Rotator) backupName(path string) string {
+	ext := filepath.Ext(path)
+	base := strings.TrimSuffix(path, ext)
+	name := fmt.Sprintf("%s-%s%s", base, r.now().Format(backupTimeFormat), ext)
+	if r.cfg.Compress {
+		name += ".gz"
+	}
+
+	// Avoid clobbering a backup made within the same second
+	candidate := name
+	for i := 1; ; i++ {
+		if _, err := os.Stat(candidate); os.IsNotExist(err) {
+			return candidate
+		}
+		candidate = fmt.Sprintf("%s.%d", name, i)
+	}
+}
+
+func (r *Rotator) copyTo(src io.Reader, backupPath string) error {
+	dst, err := os.OpenFile(backupPath, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to create log backup: %w", err)
+	}
+	defer dst.Close()
+
+	if !r.cfg.Compress {
+		if _, err := io.Copy(dst, src); err != nil {
+			return fmt.Errorf("failed to copy log backup: %w", err)
+		}
+		return nil
+	}
+
+	gz := gzip.NewWriter(dst)
+	if _, err := io.Copy(gz, src); err != nil {
+		_ = gz.Close()
+		return fmt.Errorf("failed to compress log backup: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("failed to compress log backup: %w", err)
+	}
+
+	return nil
+}
+
+// Prune removes backups of path beyond MaxBackups or older than MaxAge
+func (r *Rotator) Prune(path string) error {
+	backups, err := ListBackups(path)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	cutoff := r.now().Add(-r.cfg.MaxAge)
+
+	for i, backup := range backups {
+		remaining := len(backups) - i
+		expired := false
+
+		if r.cfg.MaxAge > 0 {
+			if info, err := os.Stat(backup); err == nil && info.ModTime().Before(cutoff) {
+				expired = true
+			}
+		}
+
+		if expired || (r.cfg.MaxBackups > 0 && remaining > r.cfg.MaxBackups) {
+			if err := os.Remove(backup); err != nil && !os.IsNotExist(err) {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to prune log backups: %v", errs)
+	}
+
+	return nil
+}
+
+// ListBackups returns rotated backups of path, oldest first
+func ListBackups(path string) ([]string, error) {
+	ext := filepath.Ext(path)
+	prefix := filepath.Base(strings.TrimSuffix(path, ext)) + "-"
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var backups []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, prefix) || !strings.Contains(name, ext) {
+			continue
+		}
+		backups = append(backups, filepath.Join(filepath.Dir(path), name))
+	}
+
+	// Timestamps are fixed width, so lexical order is chronological
+	sort.Strings(backups)
+	return backups, nil
+}
@@ -0,0 +1,168 @@
+package logs_test
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/logs"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+var _ = Describe("Rotator", func() {
+	var (
+		tmpDir  string
+		logPath string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = os.MkdirTemp("", "rotator-test-*")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(func() {
+			_ = os.RemoveAll(tmpDir)
+		})
+
+		logPath = filepath.Join(tmpDir, "stdout.log")
+	})
+
+	writeLog := func(content string) {
+		Expect(os.WriteFile(logPath, []byte(content), 0644)).To(Succeed())
+	}
+
+	Describe("RotateIfNeeded", func() {
+		It("should not rotate a missing file", func() {
+			rotator := logs.NewRotator(live.LogConfig{MaxSizeMB: 1})
+			rotated, err := rotator.RotateIfNeeded(logPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rotated).To(BeFalse())
+		})
+
+		It("should not rotate a file under the size limit", func() {
+			writeLog("small\n")
+			rotator := logs.NewRotator(live.LogConfig{MaxSizeMB: 1})
+
+			rotated, err := rotator.RotateIfNeeded(logPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rotated).To(BeFalse())
+		})
+
+		It("should rotate a file over the size limit and truncate it", func() {
+			writeLog(strings.Repeat("x", 1024*1024+1))
+			rotator := logs.NewRotator(live.LogConfig{MaxSizeMB: 1})
+
+			rotated, err := rotator.RotateIfNeeded(logPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rotated).To(BeTrue())
+
+			info, err := os.Stat(logPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Size()).To(BeZero())
+
+			backups, err := logs.ListBackups(logPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(backups).To(HaveLen(1))
+		})
+
+		It("should rotate on the first check when the interval is already due", func() {
+			writeLog("line\n")
+			rotator := logs.NewRotator(live.LogConfig{RotateEvery: time.Nanosecond})
+
+			// First check records the segment start, the next one is past the interval
+			_, err := rotator.RotateIfNeeded(logPath)
+			Expect(err).NotTo(HaveOccurred())
+			time.Sleep(time.Millisecond)
+
+			rotated, err := rotator.RotateIfNeeded(logPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rotated).To(BeTrue())
+		})
+	})
+
+	Describe("Rotate", func() {
+		It("should gzip backups when compression is enabled", func() {
+			writeLog("hello world\n")
+			rotator := logs.NewRotator(live.LogConfig{Compress: true})
+
+			Expect(rotator.Rotate(logPath)).To(Succeed())
+
+			backups, err := logs.ListBackups(logPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(backups).To(HaveLen(1))
+			Expect(backups[0]).To(HaveSuffix(".gz"))
+
+			f, err := os.Open(backups[0])
+			Expect(err).NotTo(HaveOccurred())
+			defer f.Close()
+
+			gz, err := gzip.NewReader(f)
+			Expect(err).NotTo(HaveOccurred())
+			content, err := io.ReadAll(gz)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal("hello world\n"))
+		})
+
+		It("should keep appending to the original file after rotation", func() {
+			writeLog("before\n")
+			f, err := os.OpenFile(logPath, os.O_WRONLY|os.O_APPEND, 0644)
+			Expect(err).NotTo(HaveOccurred())
+			defer f.Close()
+
+			rotator := logs.NewRotator(live.LogConfig{})
+			Expect(rotator.Rotate(logPath)).To(Succeed())
+
+			_, err = f.WriteString("after\n")
+			Expect(err).NotTo(HaveOccurred())
+
+			content, err := os.ReadFile(logPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal("after\n"))
+		})
+	})
+
+	Describe("Prune", func() {
+		It("should keep only MaxBackups newest backups", func() {
+			for _, ts := range []string{"20260101T000000", "20260102T000000", "20260103T000000"} {
+				Expect(os.WriteFile(filepath.Join(tmpDir, "stdout-"+ts+".log"), []byte("x"), 0644)).To(Succeed())
+			}
+
+			rotator := logs.NewRotator(live.LogConfig{MaxBackups: 2})
+			Expect(rotator.Prune(logPath)).To(Succeed())
+
+			backups, err := logs.ListBackups(logPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(backups).To(HaveLen(2))
+			Expect(backups[0]).To(ContainSubstring("20260102"))
+		})
+
+		It("should remove backups older than MaxAge", func() {
+			old := filepath.Join(tmpDir, "stdout-20200101T000000.log")
+			Expect(os.WriteFile(old, []byte("x"), 0644)).To(Succeed())
+			past := time.Now().Add(-48 * time.Hour)
+			Expect(os.Chtimes(old, past, past)).To(Succeed())
+
+			rotator := logs.NewRotator(live.LogConfig{MaxAge: 24 * time.Hour})
+			Expect(rotator.Prune(logPath)).To(Succeed())
+
+			_, err := os.Stat(old)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		It("should not touch the other stream's backups", func() {
+			other := filepath.Join(tmpDir, "stderr-20260101T000000.log")
+			Expect(os.WriteFile(other, []byte("x"), 0644)).To(Succeed())
+
+			rotator := logs.NewRotator(live.LogConfig{MaxBackups: 1})
+			Expect(rotator.Prune(logPath)).To(Succeed())
+
+			_, err := os.Stat(other)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
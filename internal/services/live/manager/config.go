@@ -0,0 +1,64 @@
+package manager
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"gopkg.in/yaml.v3"
+)
+
+// NewSupervisorConfig loads supervisor settings from ~/.kronos/config.yml, falling back to defaults
+func NewSupervisorConfig() (*live.SupervisorConfig, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get home directory: %w", err)
+	}
+
+	return LoadSupervisorConfig(filepath.Join(homeDir, ".kronos", "config.yml"))
+}
+
+// LoadSupervisorConfig reads supervisor settings from path.
+// Missing keys keep their default values; a missing file yields the defaults.
+func LoadSupervisorConfig(path string) (*live.SupervisorConfig, error) {
+	cfg := live.DefaultSupervisorConfig()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cfg, nil
+		}
+		return nil, fmt.Errorf("failed to read supervisor config: %w", err)
+	}
+
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse supervisor config %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+// LoadStrategyOptions reads the CLI-specific blocks (limits, ...) from a strategy's config.yml.
+// A strategy without a config file, or without those blocks, gets zero-valued options.
+func LoadStrategyOptions(strategyPath string) (*live.StrategyOptions, error) {
+	opts := &live.StrategyOptions{}
+	if strategyPath == "" {
+		return opts, nil
+	}
+
+	path := filepath.Join(strategyPath, "config.yml")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return opts, nil
+		}
+		return nil, fmt.Errorf("failed to read strategy config: %w", err)
+	}
+
+	if err := yaml.Unmarshal(data, opts); err != nil {
+		return nil, fmt.Errorf("failed to parse strategy options %s: %w", path, err)
+	}
+
+	return opts, nil
+}
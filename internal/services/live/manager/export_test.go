@@ -0,0 +1,27 @@
+package manager
+
+import (
+	"os"
+	"time"
+)
+
+// SetExitGrace shortens how long stopped strategies get to exit; the returned func restores it
+func SetExitGrace(grace time.Duration) func() {
+	previous := exitGrace
+	exitGrace = grace
+	return func() { exitGrace = previous }
+}
+
+// SetKillProcess replaces how strategy processes are force killed; the returned func restores it
+func SetKillProcess(kill func(*os.Process) error) func() {
+	previous := killProcess
+	killProcess = kill
+	return func() { killProcess = previous }
+}
+
+// SetMonitorInterval changes how often reattached processes are checked; the returned func restores it
+func SetMonitorInterval(interval time.Duration) func() {
+	previous := monitorInterval
+	monitorInterval = interval
+	return func() { monitorInterval = previous }
+}
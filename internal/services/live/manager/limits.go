@@ -0,0 +1,102 @@
+package manager
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/logs"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+// alwaysAllowedEnv are kept even with an allowlist - the strategy can't start without them
+var alwaysAllowedEnv = []string{"HOME", "PATH", "USER", "TMPDIR"}
+
+// FilterEnv returns the entries of environ whose names match the allowlist.
+// Patterns ending in "*" match by prefix. An empty allowlist returns environ unchanged.
+func FilterEnv(environ []string, allowlist []string) []string {
+	if len(allowlist) == 0 {
+		return environ
+	}
+
+	patterns := append(append([]string{}, alwaysAllowedEnv...), allowlist...)
+
+	var out []string
+	for _, kv := range environ {
+		name, _, _ := strings.Cut(kv, "=")
+		for _, pattern := range patterns {
+			if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+				if strings.HasPrefix(name, prefix) {
+					out = append(out, kv)
+					break
+				}
+			} else if name == pattern {
+				out = append(out, kv)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// processLimitsEnv carries the limits a spawned strategy applies to itself before exec (see ExecWithLimits)
+const processLimitsEnv = "KRONOS_PROCESS_LIMITS"
+
+// crashTailLines is how many stderr lines are scanned for limit-related runtime errors
+const crashTailLines = 50
+
+// ClassifyExit works out why an instance exited. state may be nil for reattached processes whose
+// exit status can't be collected. A clean exit (code 0) returns CrashReasonNone.
+func ClassifyExit(instance *live.Instance, state *os.ProcessState) (live.CrashReason, string) {
+	if instance.CgroupPath != "" && cgroupOOMKilled(instance.CgroupPath) {
+		return live.CrashReasonMemoryLimit, fmt.Sprintf("killed by the OOM killer (memory.max %d MB)", limitsOf(instance).MaxMemoryMB)
+	}
+
+	if state != nil {
+		if ws, ok := state.Sys().(syscall.WaitStatus); ok && ws.Signaled() {
+			return live.CrashReasonSignal, fmt.Sprintf("killed by signal: %s", ws.Signal())
+		}
+		if state.ExitCode() == 0 {
+			return live.CrashReasonNone, ""
+		}
+	}
+
+	// The Go runtime reports RLIMIT_AS and RLIMIT_NOFILE exhaustion on stderr before exiting
+	if reason, detail := scanStderrForLimits(instance); reason != live.CrashReasonNone {
+		return reason, detail
+	}
+
+	if state == nil {
+		return live.CrashReasonLost, "process exited while not supervised"
+	}
+	return live.CrashReasonExit, fmt.Sprintf("exited with code %d", state.ExitCode())
+}
+
+func scanStderrForLimits(instance *live.Instance) (live.CrashReason, string) {
+	path := filepath.Join(instance.FrameworkRoot, logs.InstanceLogPath(instance.StrategyName, logs.StreamStderr))
+	entries, err := logs.Tail(path, crashTailLines)
+	if err != nil {
+		return live.CrashReasonNone, ""
+	}
+
+	limits := limitsOf(instance)
+	for i := len(entries) - 1; i >= 0; i-- {
+		line := strings.ToLower(entries[i].Raw)
+		switch {
+		case limits.MaxMemoryMB > 0 && (strings.Contains(line, "out of memory") || strings.Contains(line, "cannot allocate memory")):
+			return live.CrashReasonMemoryLimit, fmt.Sprintf("memory limit of %d MB exhausted", limits.MaxMemoryMB)
+		case limits.MaxOpenFiles > 0 && strings.Contains(line, "too many open files"):
+			return live.CrashReasonFileLimit, fmt.Sprintf("open file limit of %d exhausted", limits.MaxOpenFiles)
+		}
+	}
+	return live.CrashReasonNone, ""
+}
+
+func limitsOf(instance *live.Instance) live.ResourceLimits {
+	if instance.Limits == nil {
+		return live.ResourceLimits{}
+	}
+	return *instance.Limits
+}
@@ -0,0 +1,221 @@
+//go:build linux
+
+package manager
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+// cpuPeriodMicros is the cgroup cpu.max period the quota is expressed against
+const cpuPeriodMicros = 100000
+
+// cgroupMount is where the cgroup v2 hierarchy is mounted
+const cgroupMount = "/sys/fs/cgroup"
+
+// prepareCgroup creates a fresh cgroup v2 leaf for the strategy under root and writes its limits.
+// An empty root means a kronos subtree of our own cgroup.
+// The returned directory handle is passed to the child via CgroupFD so it starts inside the cgroup.
+func prepareCgroup(root, name string, limits live.ResourceLimits) (string, *os.File, error) {
+	if root == "" {
+		own, err := ownCgroup()
+		if err != nil {
+			return "", nil, err
+		}
+		root = filepath.Join(own, "kronos")
+	}
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return "", nil, fmt.Errorf("failed to create cgroup root: %w", err)
+	}
+	if _, err := os.Stat(filepath.Join(root, "cgroup.controllers")); err != nil {
+		return "", nil, fmt.Errorf("%s is not a cgroup v2 directory", root)
+	}
+
+	// Delegate the controllers down to our leaves; this fails harmlessly if already enabled
+	controllers := "+memory +cpu"
+	_ = os.WriteFile(filepath.Join(filepath.Dir(root), "cgroup.subtree_control"), []byte(controllers), 0644)
+	_ = os.WriteFile(filepath.Join(root, "cgroup.subtree_control"), []byte(controllers), 0644)
+
+	path, err := resetLeaf(root, name)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if limits.MaxMemoryMB > 0 {
+		value := strconv.FormatInt(int64(limits.MaxMemoryMB)*1024*1024, 10)
+		if err := os.WriteFile(filepath.Join(path, "memory.max"), []byte(value), 0644); err != nil {
+			_ = os.Remove(path)
+			return "", nil, fmt.Errorf("memory controller not available: %w", err)
+		}
+		// Without this the kernel swaps instead of enforcing the limit
+		_ = os.WriteFile(filepath.Join(path, "memory.swap.max"), []byte("0"), 0644)
+	}
+
+	if limits.CPUQuota > 0 {
+		quota := int64(limits.CPUQuota * cpuPeriodMicros)
+		value := fmt.Sprintf("%d %d", quota, cpuPeriodMicros)
+		if err := os.WriteFile(filepath.Join(path, "cpu.max"), []byte(value), 0644); err != nil {
+			_ = os.Remove(path)
+			return "", nil, fmt.Errorf("cpu controller not available: %w", err)
+		}
+	}
+
+	dir, err := os.Open(path)
+	if err != nil {
+		_ = os.Remove(path)
+		return "", nil, fmt.Errorf("failed to open cgroup: %w", err)
+	}
+
+	return path, dir, nil
+}
+
+// ownCgroup returns the cgroup v2 directory this process runs in, so strategies are only ever limited
+// within what we were delegated and the host's root cgroup is left alone
+func ownCgroup() (string, error) {
+	data, err := os.ReadFile("/proc/self/cgroup")
+	if err != nil {
+		return "", fmt.Errorf("failed to read own cgroup: %w", err)
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		// The unified hierarchy is listed as "0::<path>"
+		if path, ok := strings.CutPrefix(line, "0::"); ok {
+			return filepath.Join(cgroupMount, path), nil
+		}
+	}
+	return "", errors.New("not running under cgroup v2")
+}
+
+// resetLeaf recreates the strategy's leaf cgroup so memory.events counters start from zero for this run.
+// While a reload runs the new instance next to the old one, the old leaf is still populated,
+// so the standby leaf is used instead; the two alternate across reloads.
+func resetLeaf(root, name string) (string, error) {
+	var err error
+	for _, leaf := range []string{name, name + ".standby"} {
+		path := filepath.Join(root, leaf)
+		if err = os.Remove(path); err != nil && !os.IsNotExist(err) {
+			if errors.Is(err, syscall.EBUSY) {
+				continue
+			}
+			return "", fmt.Errorf("failed to reset cgroup %s: %w", path, err)
+		}
+		if err = os.Mkdir(path, 0755); err != nil {
+			return "", fmt.Errorf("failed to create cgroup: %w", err)
+		}
+		return path, nil
+	}
+	return "", fmt.Errorf("failed to reset cgroup for %s: %w", name, err)
+}
+
+// useCgroup makes the child start inside the cgroup referred to by dir
+func useCgroup(attr *syscall.SysProcAttr, dir *os.File) {
+	attr.UseCgroupFD = true
+	attr.CgroupFD = int(dir.Fd())
+}
+
+// inheritLimits hands the strategy's rlimits and niceness to the child through its environment.
+// ExecWithLimits applies them in the child and re-execs before any strategy code runs.
+// RLIMIT_AS is only used for memory when the process isn't already in a cgroup.
+func inheritLimits(cmd *exec.Cmd, limits live.ResourceLimits, inCgroup bool) {
+	var fields []string
+	if limits.MaxOpenFiles > 0 {
+		fields = append(fields, "nofile="+strconv.FormatUint(limits.MaxOpenFiles, 10))
+	}
+	if limits.MaxMemoryMB > 0 && !inCgroup {
+		fields = append(fields, "as="+strconv.FormatUint(uint64(limits.MaxMemoryMB)*1024*1024, 10))
+	}
+	if limits.Nice != 0 {
+		fields = append(fields, "nice="+strconv.Itoa(limits.Nice))
+	}
+	if len(fields) == 0 {
+		return
+	}
+
+	if cmd.Env == nil {
+		cmd.Env = os.Environ()
+	}
+	cmd.Env = append(cmd.Env, processLimitsEnv+"="+strings.Join(fields, ","))
+}
+
+// ExecWithLimits applies the limits handed down by inheritLimits to this process and re-execs it without them,
+// so the strategy starts already constrained. It returns immediately when there are none.
+// It must run before anything else in main: until the exec, other threads keep the niceness they started with.
+func ExecWithLimits() error {
+	value, ok := os.LookupEnv(processLimitsEnv)
+	if !ok {
+		return nil
+	}
+
+	// Niceness is per thread on Linux; the exec'd process inherits it from the thread calling exec
+	runtime.LockOSThread()
+
+	for _, field := range strings.Split(value, ",") {
+		key, raw, _ := strings.Cut(field, "=")
+		switch key {
+		case "nofile", "as":
+			n, err := strconv.ParseUint(raw, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid %s limit %q: %w", key, raw, err)
+			}
+			resource, name := syscall.RLIMIT_NOFILE, "open file"
+			if key == "as" {
+				resource, name = syscall.RLIMIT_AS, "memory"
+			}
+			if err := syscall.Setrlimit(resource, &syscall.Rlimit{Cur: n, Max: n}); err != nil {
+				return fmt.Errorf("failed to set %s limit: %w", name, err)
+			}
+		case "nice":
+			n, err := strconv.Atoi(raw)
+			if err != nil {
+				return fmt.Errorf("invalid nice level %q: %w", raw, err)
+			}
+			if err := syscall.Setpriority(syscall.PRIO_PROCESS, syscall.Gettid(), n); err != nil {
+				return fmt.Errorf("failed to set nice level: %w", err)
+			}
+		}
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to resolve running executable: %w", err)
+	}
+
+	env := slices.DeleteFunc(os.Environ(), func(kv string) bool {
+		return strings.HasPrefix(kv, processLimitsEnv+"=")
+	})
+	return syscall.Exec(exe, os.Args, env)
+}
+
+// applyProcessLimits has nothing left to do on linux: the child applied its limits before exec (see inheritLimits)
+func applyProcessLimits(_ int, _ live.ResourceLimits, _ bool) error {
+	return nil
+}
+
+// cgroupOOMKilled reports whether the kernel OOM-killed a process in the cgroup
+func cgroupOOMKilled(path string) bool {
+	f, err := os.Open(filepath.Join(path, "memory.events"))
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), " ")
+		if ok && key == "oom_kill" {
+			n, _ := strconv.Atoi(value)
+			return n > 0
+		}
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+//go:build linux
+
+package manager_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"syscall"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/config"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/manager"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+// limitsProbeEnv makes the test binary act as a spawned strategy that reports the limits it started with
+const limitsProbeEnv = "KRONOS_LIMITS_PROBE"
+
+func init() {
+	if os.Getenv(limitsProbeEnv) == "" {
+		return
+	}
+	if err := manager.ExecWithLimits(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	var rlimit syscall.Rlimit
+	_ = syscall.Getrlimit(syscall.RLIMIT_NOFILE, &rlimit)
+	// The raw syscall reports 20 - nice
+	priority, _ := syscall.Getpriority(syscall.PRIO_PROCESS, 0)
+	fmt.Printf("nofile=%d nice=%d limits_env=%q\n", rlimit.Cur, 20-priority, os.Getenv("KRONOS_PROCESS_LIMITS"))
+	os.Exit(0)
+}
+
+var _ = Describe("Limits applied before exec", func() {
+	It("should start the strategy with its rlimits and nice level already set", func() {
+		tmpDir, err := os.MkdirTemp("", "limits-exec-test-*")
+		Expect(err).NotTo(HaveOccurred())
+		originalDir, _ := os.Getwd()
+		Expect(os.Chdir(tmpDir)).To(Succeed())
+		DeferCleanup(func() {
+			_ = os.Chdir(originalDir)
+			_ = os.RemoveAll(tmpDir)
+		})
+
+		cfg := live.DefaultSupervisorConfig()
+		cfg.Isolation.DefaultLimits = live.ResourceLimits{MaxOpenFiles: 64, Nice: 5}
+		spawner := manager.NewProcessSpawnerWithConfig(&logging.NoOpLogger{}, cfg)
+
+		// The spawned executable is this test binary, which the probe turns into a stand-in strategy
+		cmd, err := spawner.Spawn(context.Background(), &config.Strategy{Name: "momentum", Path: "./strategies/momentum"})
+		Expect(err).NotTo(HaveOccurred())
+		cmd.Env = append(cmd.Env, limitsProbeEnv+"=1")
+		var out bytes.Buffer
+		cmd.Stdout = &out
+
+		Expect(cmd.Run()).To(Succeed())
+		instance := &live.Instance{StrategyName: "momentum", Cmd: cmd}
+		Expect(spawner.ApplyLimits(instance)).To(Succeed())
+
+		Expect(out.String()).To(Equal("nofile=64 nice=5 limits_env=\"\"\n"))
+		Expect(instance.Limits.MaxOpenFiles).To(Equal(uint64(64)))
+	})
+})
@@ -0,0 +1,41 @@
+//go:build !linux
+
+package manager
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"syscall"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+func prepareCgroup(_, _ string, _ live.ResourceLimits) (string, *os.File, error) {
+	return "", nil, errors.New("cgroups are only supported on linux")
+}
+
+func useCgroup(_ *syscall.SysProcAttr, _ *os.File) {}
+
+func inheritLimits(_ *exec.Cmd, _ live.ResourceLimits, _ bool) {}
+
+// ExecWithLimits has nothing to do outside linux, where limits are applied after the process starts
+func ExecWithLimits() error {
+	return nil
+}
+
+// applyProcessLimits only supports niceness outside linux; rlimits can't be set on another process.
+// The strategy runs at the supervisor's niceness until this is called just after it starts.
+func applyProcessLimits(pid int, limits live.ResourceLimits, _ bool) error {
+	if limits.MaxOpenFiles > 0 || limits.MaxMemoryMB > 0 {
+		return errors.New("memory and open file limits are only supported on linux")
+	}
+	if limits.Nice != 0 {
+		return syscall.Setpriority(syscall.PRIO_PROCESS, pid, limits.Nice)
+	}
+	return nil
+}
+
+func cgroupOOMKilled(_ string) bool {
+	return false
+}
@@ -0,0 +1,189 @@
+package manager_test
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/config"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/logs"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/manager"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+var _ = Describe("Resource limits", func() {
+	var tmpDir string
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = os.MkdirTemp("", "limits-test-*")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(func() {
+			_ = os.RemoveAll(tmpDir)
+		})
+	})
+
+	Describe("LoadStrategyOptions", func() {
+		It("should read the limits block from config.yml", func() {
+			content := `name: momentum
+exchanges: [paradex]
+limits:
+  max_memory_mb: 512
+  cpu_quota: 0.5
+  nice: 10
+  max_open_files: 1024
+  env_allowlist: ["KRONOS_*"]
+`
+			Expect(os.WriteFile(filepath.Join(tmpDir, "config.yml"), []byte(content), 0644)).To(Succeed())
+
+			opts, err := manager.LoadStrategyOptions(tmpDir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(opts.Limits.MaxMemoryMB).To(Equal(512))
+			Expect(opts.Limits.CPUQuota).To(Equal(0.5))
+			Expect(opts.Limits.Nice).To(Equal(10))
+			Expect(opts.Limits.MaxOpenFiles).To(Equal(uint64(1024)))
+			Expect(opts.Limits.EnvAllowlist).To(Equal([]string{"KRONOS_*"}))
+		})
+
+		It("should return empty options when there is no config", func() {
+			opts, err := manager.LoadStrategyOptions(filepath.Join(tmpDir, "missing"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(opts.Limits.IsZero()).To(BeTrue())
+		})
+	})
+
+	Describe("Merge", func() {
+		It("should fill unset fields from the defaults", func() {
+			limits := live.ResourceLimits{MaxMemoryMB: 256}.Merge(live.ResourceLimits{MaxMemoryMB: 1024, Nice: 5})
+			Expect(limits.MaxMemoryMB).To(Equal(256))
+			Expect(limits.Nice).To(Equal(5))
+		})
+	})
+
+	Describe("FilterEnv", func() {
+		environ := []string{"HOME=/home/u", "PATH=/bin", "KRONOS_KEY=abc", "AWS_SECRET=xyz", "LANG=C"}
+
+		It("should inherit everything without an allowlist", func() {
+			Expect(manager.FilterEnv(environ, nil)).To(Equal(environ))
+		})
+
+		It("should keep allowed names, prefix matches and the essentials", func() {
+			Expect(manager.FilterEnv(environ, []string{"KRONOS_*", "LANG"})).To(ConsistOf(
+				"HOME=/home/u", "PATH=/bin", "KRONOS_KEY=abc", "LANG=C",
+			))
+		})
+	})
+
+	Describe("ClassifyExit", func() {
+		run := func(script string) *os.ProcessState {
+			cmd := exec.Command("sh", "-c", script)
+			_ = cmd.Run()
+			return cmd.ProcessState
+		}
+
+		var instance *live.Instance
+
+		BeforeEach(func() {
+			instance = &live.Instance{StrategyName: "momentum", FrameworkRoot: tmpDir}
+		})
+
+		It("should treat a clean exit as no crash", func() {
+			reason, _ := manager.ClassifyExit(instance, run("exit 0"))
+			Expect(reason).To(Equal(live.CrashReasonNone))
+		})
+
+		It("should report a non-zero exit code", func() {
+			reason, detail := manager.ClassifyExit(instance, run("exit 3"))
+			Expect(reason).To(Equal(live.CrashReasonExit))
+			Expect(detail).To(ContainSubstring("code 3"))
+		})
+
+		It("should report signals", func() {
+			reason, _ := manager.ClassifyExit(instance, run("kill -TERM $$"))
+			Expect(reason).To(Equal(live.CrashReasonSignal))
+		})
+
+		Context("when the strategy logged a limit error", func() {
+			writeStderr := func(line string) {
+				path := filepath.Join(tmpDir, logs.InstanceLogPath("momentum", logs.StreamStderr))
+				Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+				Expect(os.WriteFile(path, []byte(line+"\n"), 0644)).To(Succeed())
+			}
+
+			It("should report memory limit breaches", func() {
+				instance.Limits = &live.ResourceLimits{MaxMemoryMB: 128}
+				writeStderr("fatal error: runtime: out of memory")
+
+				reason, _ := manager.ClassifyExit(instance, run("exit 2"))
+				Expect(reason).To(Equal(live.CrashReasonMemoryLimit))
+			})
+
+			It("should report open file limit breaches", func() {
+				instance.Limits = &live.ResourceLimits{MaxOpenFiles: 64}
+				writeStderr(`{"level":"ERROR","msg":"accept: too many open files"}`)
+
+				reason, _ := manager.ClassifyExit(instance, run("exit 1"))
+				Expect(reason).To(Equal(live.CrashReasonFileLimit))
+			})
+
+			It("should not blame a limit that isn't configured", func() {
+				writeStderr("fatal error: runtime: out of memory")
+
+				reason, _ := manager.ClassifyExit(instance, run("exit 2"))
+				Expect(reason).To(Equal(live.CrashReasonExit))
+			})
+		})
+	})
+
+	Describe("Spawn with limits", func() {
+		var (
+			spawner  live.ProcessSpawner
+			strategy *config.Strategy
+		)
+
+		BeforeEach(func() {
+			originalDir, _ := os.Getwd()
+			Expect(os.Chdir(tmpDir)).To(Succeed())
+			DeferCleanup(func() {
+				_ = os.Chdir(originalDir)
+			})
+
+			strategyDir := filepath.Join(tmpDir, "strategies", "momentum")
+			Expect(os.MkdirAll(strategyDir, 0755)).To(Succeed())
+			content := `name: momentum
+limits:
+  work_dir: run/momentum
+  env_allowlist: ["KRONOS_*"]
+`
+			Expect(os.WriteFile(filepath.Join(strategyDir, "config.yml"), []byte(content), 0644)).To(Succeed())
+
+			spawner = manager.NewProcessSpawner(&logging.NoOpLogger{})
+			strategy = &config.Strategy{Name: "momentum", Path: strategyDir}
+		})
+
+		It("should run in the work directory and point back at the project", func() {
+			cmd, err := spawner.Spawn(context.Background(), strategy)
+			Expect(err).NotTo(HaveOccurred())
+
+			projectDir, _ := os.Getwd()
+			Expect(cmd.Dir).To(Equal(filepath.Join(projectDir, "run", "momentum")))
+			Expect(cmd.Dir).To(BeADirectory())
+			Expect(cmd.Args).To(ContainElements("--project-dir", projectDir))
+		})
+
+		It("should restrict the environment to the allowlist", func() {
+			GinkgoT().Setenv("KRONOS_TEST_VAR", "1")
+			GinkgoT().Setenv("SECRET_TEST_VAR", "1")
+
+			cmd, err := spawner.Spawn(context.Background(), strategy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cmd.Env).To(ContainElement("KRONOS_TEST_VAR=1"))
+			Expect(cmd.Env).NotTo(ContainElement("SECRET_TEST_VAR=1"))
+		})
+	})
+})
@@ -0,0 +1,14 @@
+//go:build !unix
+
+package manager
+
+// lockFile is a no-op where flock isn't available; writes are still atomic, but concurrent
+// read-merge-write updates from several processes may lose changes
+func lockFile(path string, exclusive bool) (func(), error) {
+	return func() {}, nil
+}
+
+// TryLockFile always succeeds where flock isn't available, so every process acts as the only one
+func TryLockFile(path string) (func(), bool, error) {
+	return func() {}, true, nil
+}
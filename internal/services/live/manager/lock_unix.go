@@ -0,0 +1,67 @@
+//go:build unix
+
+package manager
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// lockFile takes an advisory flock on path, shared for readers and exclusive for writers, blocking until it is
+// granted. The returned func releases it. The lock is tied to the open file, so it is dropped if the process dies.
+func lockFile(path string, exclusive bool) (func(), error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open lock file: %w", err)
+	}
+
+	how := syscall.LOCK_SH
+	if exclusive {
+		how = syscall.LOCK_EX
+	}
+
+	for {
+		err = syscall.Flock(int(f.Fd()), how)
+		if err != syscall.EINTR {
+			break
+		}
+	}
+	if err != nil {
+		_ = f.Close()
+		return nil, fmt.Errorf("failed to lock %s: %w", path, err)
+	}
+
+	return func() {
+		_ = syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+		_ = f.Close()
+	}, nil
+}
+
+// TryLockFile takes an exclusive flock on path without waiting. ok is false if another process holds it.
+func TryLockFile(path string) (release func(), ok bool, err error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to open lock file: %w", err)
+	}
+
+	for {
+		err = syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+		if err != syscall.EINTR {
+			break
+		}
+	}
+	if err == syscall.EWOULDBLOCK {
+		_ = f.Close()
+		return nil, false, nil
+	}
+	if err != nil {
+		_ = f.Close()
+		return nil, false, fmt.Errorf("failed to lock %s: %w", path, err)
+	}
+
+	return func() {
+		_ = syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+		_ = f.Close()
+	}, true, nil
+}
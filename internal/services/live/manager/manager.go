@@ -4,13 +4,18 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"sort"
 	"sync"
 	"syscall"
 	"time"
 
+	"github.com/backtesting-org/kronos-cli/internal/services/live/binary"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/control"
 	"github.com/backtesting-org/kronos-cli/pkg/live"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/config"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
 	"github.com/google/uuid"
 )
 
@@ -20,7 +25,19 @@ type instanceManager struct {
 	stateStore  live.StateStore
 	spawner     live.ProcessSpawner
 	logger      logging.ApplicationLogger
+	controller  live.InstanceController
+	history     live.HistoryStore
+	querier     monitoring.ViewQuerier
+	events      live.EventBus
+	stopConfig  live.StopOptions
 	monitorDone chan struct{}
+
+	// exited is closed once a spawned instance's process has been reaped
+	exited map[string]chan struct{}
+	// stopping marks instances being stopped on purpose, so their exit isn't reported as a crash
+	stopping map[string]bool
+	// forgotten are stale instances dropped from memory that must not be merged back from the state file
+	forgotten map[string]bool
 }
 
 // NewInstanceManager creates a new instance manager
@@ -28,23 +45,96 @@ func NewInstanceManager(
 	stateStore live.StateStore,
 	spawner live.ProcessSpawner,
 	logger logging.ApplicationLogger,
+	controller live.InstanceController,
+	history live.HistoryStore,
+	querier monitoring.ViewQuerier,
+	events live.EventBus,
+	cfg *live.SupervisorConfig,
 ) live.InstanceManager {
 	return &instanceManager{
 		instances:   make(map[string]*live.Instance),
 		stateStore:  stateStore,
 		spawner:     spawner,
 		logger:      logger,
+		controller:  controller,
+		history:     history,
+		querier:     querier,
+		events:      events,
+		stopConfig:  cfg.Stop,
 		monitorDone: make(chan struct{}),
+		exited:      make(map[string]chan struct{}),
+		stopping:    make(map[string]bool),
+		forgotten:   make(map[string]bool),
 	}
 }
 
 // Start spawns a new strategy instance
 func (im *instanceManager) Start(ctx context.Context, strategy *config.Strategy, frameworkRoot string) (*live.Instance, error) {
+	return im.start(ctx, strategy, frameworkRoot, false)
+}
+
+// StartStandby spawns an instance that loads the strategy but only trades once promoted.
+// It runs alongside the current instance of the same strategy.
+func (im *instanceManager) StartStandby(ctx context.Context, strategy *config.Strategy, frameworkRoot string) (*live.Instance, error) {
+	return im.start(ctx, strategy, frameworkRoot, true)
+}
+
+// Promote marks a standby instance as the running one
+func (im *instanceManager) Promote(instanceID string) error {
 	im.mu.Lock()
 	defer im.mu.Unlock()
 
-	// Check if already running - verify process is actually alive
+	instance, exists := im.instances[instanceID]
+	if !exists {
+		return fmt.Errorf("instance not found: %s", instanceID)
+	}
+	if instance.Status != live.StatusStandby {
+		return fmt.Errorf("instance %s is %s, not on standby", instanceID, instance.Status)
+	}
+
+	instance.Status = live.StatusRunning
+	instance.LastStatusCheck = time.Now()
+	im.publish(live.EventPromoted, "", instance, "took over from the previous instance")
+	return im.saveStateLocked()
+}
+
+// start launches an instance and records the outcome in the event log
+func (im *instanceManager) start(ctx context.Context, strategy *config.Strategy, frameworkRoot string, standby bool) (*live.Instance, error) {
+	actor := live.ActorFromContext(ctx)
+
+	instance, err := im.launch(ctx, strategy, frameworkRoot, standby)
+	if err != nil {
+		im.events.Publish(live.Event{
+			Type:         live.EventStartFailed,
+			Actor:        actor,
+			StrategyName: strategy.Name,
+			Reason:       err.Error(),
+		})
+		return nil, err
+	}
+
+	if standby {
+		im.publish(live.EventStandbyStarted, actor, instance, "")
+	} else {
+		im.publish(live.EventStarted, actor, instance, "")
+	}
+	return instance, nil
+}
+
+func (im *instanceManager) launch(ctx context.Context, strategy *config.Strategy, frameworkRoot string, standby bool) (*live.Instance, error) {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+
+	// Check if already running - verify process is actually alive.
+	// A standby instance is meant to run next to the current one.
 	for id, inst := range im.instances {
+		if standby {
+			if inst.StrategyName == strategy.Name && inst.Status == live.StatusStandby && processAlive(inst.PID) {
+				return nil, fmt.Errorf("strategy '%s' already has a standby instance", strategy.Name)
+			}
+			continue
+		}
+
 		if inst.StrategyName == strategy.Name && inst.Status == live.StatusRunning {
 			// Verify process is actually alive
 			if inst.PID > 0 {
@@ -60,6 +150,7 @@ func (im *instanceManager) Start(ctx context.Context, strategy *config.Strategy,
 
 			inst.Status = live.StatusStopped
 			delete(im.instances, id)
+			im.forgotten[id] = true
 		}
 	}
 
@@ -69,14 +160,26 @@ func (im *instanceManager) Start(ctx context.Context, strategy *config.Strategy,
 		return nil, fmt.Errorf("failed to spawn process: %w", err)
 	}
 
+	status := live.StatusRunning
+	if standby {
+		cmd.Args = append(cmd.Args, "--standby")
+		status = live.StatusStandby
+	}
+
 	// Create instance
 	instCtx, cancel := context.WithCancel(ctx)
+	instanceID := uuid.New().String()
+	if cmd.Env == nil {
+		cmd.Env = os.Environ()
+	}
+	cmd.Env = append(cmd.Env, live.InstanceIDEnv+"="+instanceID)
+
 	instance := &live.Instance{
-		ID:              uuid.New().String(),
+		ID:              instanceID,
 		StrategyName:    strategy.Name,
 		StrategyPath:    strategy.Path,
 		FrameworkRoot:   frameworkRoot,
-		Status:          live.StatusRunning,
+		Status:          status,
 		StartedAt:       time.Now(),
 		LastStatusCheck: time.Now(),
 		Context:         instCtx,
@@ -84,19 +187,46 @@ func (im *instanceManager) Start(ctx context.Context, strategy *config.Strategy,
 		Cmd:             cmd,
 	}
 
+	// Record which build is running so upgrades can be detected
+	if info, err := binary.Identify(cmd.Path); err == nil {
+		instance.Binary = info
+	} else {
+		im.logger.Warn("Failed to identify strategy executable", "path", cmd.Path, "error", err)
+	}
+	if hash, err := binary.Hash(filepath.Join(strategy.Path, "config.yml")); err == nil {
+		instance.ConfigHash = hash
+	}
+
 	// Start process
 	if err := cmd.Start(); err != nil {
+		_ = im.spawner.ApplyLimits(instance)
 		cancel()
 		return nil, fmt.Errorf("failed to start process: %w", err)
 	}
 
 	instance.PID = cmd.Process.Pid
 
+	// Never leave a strategy running without the limits it asked for
+	if err := im.spawner.ApplyLimits(instance); err != nil {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+		cancel()
+		return nil, fmt.Errorf("failed to apply resource limits: %w", err)
+	}
+
+	if !standby {
+		previous := im.previousInstance(instance.StrategyName)
+		im.recordRestart(ctx, instance, previous)
+		im.recordConfigChange(ctx, instance, previous)
+	}
+
 	// Track instance
 	im.instances[instance.ID] = instance
 
-	// Monitor process in background
-	go im.monitorProcess(instance)
+	// Reap the process in background and record why it exited
+	exited := make(chan struct{})
+	im.exited[instance.ID] = exited
+	go im.waitProcess(instance, exited)
 
 	// Save state
 	_ = im.saveStateLocked()
@@ -105,81 +235,218 @@ func (im *instanceManager) Start(ctx context.Context, strategy *config.Strategy,
 }
 
 // Stop gracefully terminates an instance
-func (im *instanceManager) Stop(instanceID string) error {
+func (im *instanceManager) Stop(ctx context.Context, instanceID string) error {
+	return im.stop(context.Background(), instanceID, im.captureStats(instanceID), live.ActorFromContext(ctx))
+}
+
+// stop signals the instance to exit and kills it if it doesn't in time; ending ctx abandons the stop
+func (im *instanceManager) stop(ctx context.Context, instanceID string, stats *live.SessionStats, actor live.Actor) error {
 	im.mu.Lock()
 	instance, exists := im.instances[instanceID]
 	if !exists {
 		im.mu.Unlock()
 		return fmt.Errorf("instance not found: %s", instanceID)
 	}
+	im.stopping[instanceID] = true
+	exited := im.exited[instanceID]
 	im.mu.Unlock()
 
 	instance.Cancel()
 
-	// Get process handle - either from Cmd (if we spawned it) or by PID (if reattached)
-	var process *os.Process
-	var err error
-
-	if instance.Cmd != nil && instance.Cmd.Process != nil {
-		// We spawned this process - use the Cmd's process handle
-		process = instance.Cmd.Process
-	} else if instance.PID > 0 {
-		// We reattached to this process - find it by PID
-		process, err = os.FindProcess(instance.PID)
-		if err != nil {
-			return fmt.Errorf("failed to find process: %w", err)
-		}
-	} else {
-		return fmt.Errorf("instance has no valid process reference")
+	process, err := processOf(instance)
+	if err != nil {
+		im.clearStopping(instanceID)
+		return err
 	}
 
 	// Send SIGTERM
 	if err := process.Signal(os.Interrupt); err != nil {
+		im.clearStopping(instanceID)
 		return fmt.Errorf("failed to signal process: %w", err)
 	}
 
-	// Wait for graceful exit with timeout
-	done := make(chan error, 1)
-	go func() {
-		if instance.Cmd != nil {
-			// If we have Cmd, use Wait() which is cleaner
-			done <- instance.Cmd.Wait()
-		} else {
-			// Otherwise poll for process exit
-			for i := 0; i < 100; i++ { // 10 seconds (100 * 100ms)
-				time.Sleep(100 * time.Millisecond)
-				// Try to signal with signal 0 to check if process exists
-				if err := process.Signal(syscall.Signal(0)); err != nil {
-					// Process is gone
-					done <- nil
-					return
-				}
-			}
-			done <- fmt.Errorf("timeout waiting for process to exit")
+	// The strategy drains with its configured stop mode before exiting, so allow for the drain timeout
+	if !waitExit(ctx, process, exited, im.stopConfig.DrainTimeout+exitGrace) {
+		if ctx.Err() != nil {
+			return im.abandonStop(ctx, instance)
 		}
-	}()
 
-	select {
-	case <-time.After(10 * time.Second):
 		// Force kill if not exited
 		im.logger.Warn("Graceful stop timeout, force killing", "instance", instanceID)
-		if err := process.Kill(); err != nil {
-			return fmt.Errorf("failed to kill process: %w", err)
+		if err := killProcess(process); err != nil {
+			return im.failStop(instance, stats, "", actor, err)
+		}
+		if exited != nil {
+			<-exited
 		}
-	case <-done:
 	}
 
 	im.mu.Lock()
-	instance.Status = live.StatusStopped
-	instance.PID = 0
+	im.endSessionLocked(instance, live.StatusStopped, stats, "")
+	delete(im.stopping, instanceID)
 	_ = im.saveStateLocked()
 	im.mu.Unlock()
 
+	im.publish(live.EventStopped, actor, instance, "SIGTERM")
+
 	return nil
 }
 
+// StopStrategy asks the strategy to drain over its control socket and waits for the process to exit.
+// When the control socket can't be used, it falls back to a signal stop and the last recorded result.
+func (im *instanceManager) StopStrategy(ctx context.Context, strategyName string, opts live.StopOptions) (*live.StopResult, error) {
+	if opts.Mode == "" {
+		opts.Mode = im.stopConfig.Mode
+	}
+	if opts.DrainTimeout <= 0 {
+		opts.DrainTimeout = im.stopConfig.DrainTimeout
+	}
+
+	im.mu.Lock()
+	var instance *live.Instance
+	for _, inst := range im.instances {
+		if inst.StrategyName == strategyName && inst.Status == live.StatusRunning {
+			instance = inst
+			break
+		}
+	}
+	var exited chan struct{}
+	if instance != nil {
+		im.stopping[instance.ID] = true
+		exited = im.exited[instance.ID]
+	}
+	im.mu.Unlock()
+
+	if instance == nil && !im.controller.Available(strategyName) {
+		return nil, fmt.Errorf("no running instance found for strategy: %s", strategyName)
+	}
+
+	var stats *live.SessionStats
+	if instance != nil {
+		stats = im.captureStats(instance.ID)
+	}
+
+	started := time.Now()
+	result, err := im.controller.Stop(ctx, strategyName, opts)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, im.abandonStop(ctx, instance)
+		}
+		if instance == nil {
+			return nil, fmt.Errorf("failed to stop strategy %s: %w", strategyName, err)
+		}
+
+		im.logger.Warn("Control socket stop failed, falling back to signal",
+			"strategy", strategyName,
+			"error", err,
+		)
+		im.clearStopping(instance.ID)
+		if err := im.stop(ctx, instance.ID, stats, opts.Actor); err != nil {
+			return nil, err
+		}
+		return signalStopResult(instance, started), nil
+	}
+
+	// Without a tracked instance there is no process to wait for
+	if instance == nil {
+		return result, nil
+	}
+
+	process, err := processOf(instance)
+	if err == nil && !waitExit(ctx, process, exited, exitGrace) {
+		if ctx.Err() != nil {
+			return nil, im.abandonStop(ctx, instance)
+		}
+		im.logger.Warn("Strategy did not exit after draining, force killing", "strategy", strategyName)
+		if err := killProcess(process); err != nil {
+			return result, im.failStop(instance, stats, result.Mode, opts.Actor, err)
+		}
+		if exited != nil {
+			<-exited
+		}
+		result.Forced = true
+	}
+
+	// The strategy's own figures are taken after the drain, so they include what flattening cost
+	if result.PnL != nil {
+		final := &live.SessionStats{PnL: result.PnL, CapturedAt: result.StoppedAt}
+		if stats != nil {
+			final.TradeCount = stats.TradeCount
+		}
+		stats = final
+	}
+
+	im.mu.Lock()
+	instance.Cancel()
+	im.endSessionLocked(instance, live.StatusStopped, stats, result.Mode)
+	delete(im.stopping, instance.ID)
+	_ = im.saveStateLocked()
+	im.mu.Unlock()
+
+	reason := fmt.Sprintf("%s stop", result.Mode)
+	if result.Forced {
+		reason += ", force killed after draining"
+	}
+	im.publish(live.EventStopped, opts.Actor, instance, reason)
+
+	im.logger.Info("Stopped instance",
+		"strategy", strategyName,
+		"mode", result.Mode,
+		"cancelled_orders", result.CancelledOrders,
+		"closed_positions", result.ClosedPositions,
+	)
+
+	return result, nil
+}
+
+// signalStopResult returns the result the strategy recorded while handling the signal, if it is from this stop
+func signalStopResult(instance *live.Instance, started time.Time) *live.StopResult {
+	result, err := control.LoadResult(instance.FrameworkRoot, instance.StrategyName)
+	if err == nil && !result.StoppedAt.Before(started) {
+		return result
+	}
+	return &live.StopResult{
+		StrategyName: instance.StrategyName,
+		Errors:       []string{"the strategy did not report its final state"},
+		StoppedAt:    time.Now(),
+		Duration:     time.Since(started),
+	}
+}
+
+// failStop ends a stop whose force kill failed. The instance is no longer treated as stopping and its session
+// ends, with the error recorded on it since the process may still be running.
+func (im *instanceManager) failStop(instance *live.Instance, stats *live.SessionStats, mode live.StopMode, actor live.Actor, killErr error) error {
+	err := fmt.Errorf("failed to kill process: %w", killErr)
+
+	im.mu.Lock()
+	instance.Cancel()
+	instance.Error = err.Error()
+	im.endSessionLocked(instance, live.StatusStopped, stats, mode)
+	delete(im.stopping, instance.ID)
+	_ = im.saveStateLocked()
+	im.mu.Unlock()
+
+	im.publish(live.EventStopped, actor, instance, err.Error())
+	return err
+}
+
+// abandonStop hands back an instance whose stop was cancelled, with its process left as it is
+func (im *instanceManager) abandonStop(ctx context.Context, instance *live.Instance) error {
+	if instance == nil {
+		return fmt.Errorf("stop abandoned: %w", ctx.Err())
+	}
+	im.clearStopping(instance.ID)
+	return fmt.Errorf("stop of %s abandoned: %w", instance.StrategyName, ctx.Err())
+}
+
+func (im *instanceManager) clearStopping(instanceID string) {
+	im.mu.Lock()
+	delete(im.stopping, instanceID)
+	im.mu.Unlock()
+}
+
 // StopByStrategyName gracefully terminates an instance by strategy name
-func (im *instanceManager) StopByStrategyName(strategyName string) error {
+func (im *instanceManager) StopByStrategyName(ctx context.Context, strategyName string) error {
 	im.mu.RLock()
 	var instanceID string
 
@@ -205,31 +472,46 @@ func (im *instanceManager) StopByStrategyName(strategyName string) error {
 			strategyName, len(im.instances))
 	}
 
-	return im.Stop(instanceID)
+	return im.Stop(ctx, instanceID)
 }
 
 // Kill forcefully terminates an instance
-func (im *instanceManager) Kill(instanceID string) error {
+func (im *instanceManager) Kill(ctx context.Context, instanceID string) error {
 	im.mu.Lock()
 	instance, exists := im.instances[instanceID]
 	if !exists {
 		im.mu.Unlock()
 		return fmt.Errorf("instance not found: %s", instanceID)
 	}
+	im.stopping[instanceID] = true
+	exited := im.exited[instanceID]
 	im.mu.Unlock()
 
 	instance.Cancel()
 
-	if err := instance.Cmd.Process.Kill(); err != nil {
+	// Instances reattached from the state file have no Cmd, only a PID
+	process, err := processOf(instance)
+	if err != nil {
+		im.clearStopping(instanceID)
+		return err
+	}
+	if err := killProcess(process); err != nil {
+		im.clearStopping(instanceID)
 		return fmt.Errorf("failed to kill process: %w", err)
 	}
+	if exited != nil {
+		<-exited
+	} else {
+		waitExit(context.Background(), process, nil, exitGrace)
+	}
 
 	im.mu.Lock()
-	instance.Status = live.StatusStopped
-	instance.PID = 0
+	im.endSessionLocked(instance, live.StatusStopped, nil, "")
+	delete(im.stopping, instanceID)
 	_ = im.saveStateLocked()
 	im.mu.Unlock()
 
+	im.publish(live.EventKilled, live.ActorFromContext(ctx), instance, "SIGKILL")
 	im.logger.Info("Killed instance", "strategy", instance.StrategyName, "id", instanceID)
 
 	return nil
@@ -266,21 +548,31 @@ func (im *instanceManager) List(status live.InstanceStatus) ([]*live.Instance, e
 
 // LoadRunning loads instances from state file (after restart)
 func (im *instanceManager) LoadRunning(ctx context.Context) error {
-	instances, err := im.stateStore.Load()
-	if err != nil {
-		return err
-	}
-
 	im.mu.Lock()
 	defer im.mu.Unlock()
 
-	for _, instance := range instances {
-		if instance.Status == live.StatusRunning {
-			// Verify process still exists
-			_, err := os.FindProcess(instance.PID)
-			if err != nil {
-				instance.Status = live.StatusCrashed
-				instance.Error = "Process not found after restart"
+	// The history and the event log may live in the same store, which can't be written to from inside
+	// its own update, so crashes are archived and published once the update is done
+	var crashed []*live.Instance
+	var sessions []*live.Session
+
+	err := im.stateStore.Update(func(saved []*live.Instance) ([]*live.Instance, error) {
+		crashed, sessions = nil, nil
+
+		for _, instance := range saved {
+			if instance.Status != live.StatusRunning || im.instances[instance.ID] != nil {
+				continue
+			}
+
+			// Verify process still exists; the crash is recorded in the state file for everyone
+			if !processAlive(instance.PID) {
+				reason, detail := ClassifyExit(instance, nil)
+				instance.CrashReason = reason
+				instance.Error = "Process not found after restart: " + detail
+				if session := closeSession(instance, live.StatusCrashed, nil, ""); session != nil {
+					sessions = append(sessions, session)
+				}
+				crashed = append(crashed, instance)
 				continue
 			}
 
@@ -292,9 +584,20 @@ func (im *instanceManager) LoadRunning(ctx context.Context) error {
 
 			go im.monitorProcess(instance)
 		}
+
+		return mergeInstances(saved, im.instances, im.forgotten), nil
+	})
+	if err != nil {
+		return err
 	}
 
-	return im.saveStateLocked()
+	for _, session := range sessions {
+		im.archive(session)
+	}
+	for _, instance := range crashed {
+		im.publish(live.EventCrashed, live.ActorDaemon, instance, fmt.Sprintf("%s: %s", instance.CrashReason, instance.Error))
+	}
+	return nil
 }
 
 // SaveState persists current state to disk
@@ -305,14 +608,66 @@ func (im *instanceManager) SaveState() error {
 	return im.saveStateLocked()
 }
 
-// saveStateLocked persists state (must be called with lock held)
+// saveStateLocked persists state (must be called with lock held). Other kronos processes (the TUI,
+// scripts) record their instances in the same file, so ours are merged into what is there.
 func (im *instanceManager) saveStateLocked() error {
-	instances := make([]*live.Instance, 0, len(im.instances))
-	for _, inst := range im.instances {
-		instances = append(instances, inst)
+	return im.stateStore.Update(func(saved []*live.Instance) ([]*live.Instance, error) {
+		return mergeInstances(saved, im.instances, im.forgotten), nil
+	})
+}
+
+// mergeInstances combines the instances this process tracks with those recorded by others.
+// Our own records win, except a live one over a record another process has since ended. Foreign instances that stopped are dropped (their sessions are archived),
+// and so are crashes superseded by a newer instance of the same strategy.
+func mergeInstances(saved []*live.Instance, own map[string]*live.Instance, forgotten map[string]bool) []*live.Instance {
+	recorded := make(map[string]*live.Instance, len(saved))
+	for _, inst := range saved {
+		recorded[inst.ID] = inst
+	}
+
+	merged := make([]*live.Instance, 0, len(saved)+len(own))
+	for _, inst := range own {
+		// Another process may have ended an instance we still think is live; the ended record stands
+		if prev := recorded[inst.ID]; prev != nil && isLive(inst.Status) && !isLive(prev.Status) {
+			merged = append(merged, prev)
+			continue
+		}
+		merged = append(merged, inst)
+	}
+
+	for _, inst := range saved {
+		if own[inst.ID] != nil || forgotten[inst.ID] || inst.Status == live.StatusStopped {
+			continue
+		}
+		merged = append(merged, inst)
 	}
 
-	return im.stateStore.Save(instances)
+	// Keep the latest start per strategy to tell which crashes are superseded
+	latest := make(map[string]time.Time, len(merged))
+	for _, inst := range merged {
+		if inst.StartedAt.After(latest[inst.StrategyName]) {
+			latest[inst.StrategyName] = inst.StartedAt
+		}
+	}
+
+	result := make([]*live.Instance, 0, len(merged))
+	for _, inst := range merged {
+		if own[inst.ID] == nil && inst.Status == live.StatusCrashed && inst.StartedAt.Before(latest[inst.StrategyName]) {
+			continue
+		}
+		result = append(result, inst)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].StartedAt.Before(result[j].StartedAt)
+	})
+
+	return result
+}
+
+// isLive reports whether an instance with the status has a process that may be running
+func isLive(status live.InstanceStatus) bool {
+	return status == live.StatusRunning || status == live.StatusStandby
 }
 
 // Shutdown gracefully terminates all instances
@@ -332,7 +687,7 @@ func (im *instanceManager) Shutdown(ctx context.Context, timeout time.Duration)
 	// Stop all in parallel
 	for _, id := range instanceIDs {
 		go func(instID string) {
-			done <- im.Stop(instID)
+			done <- im.Stop(ctx, instID)
 		}(id)
 	}
 
@@ -356,9 +711,50 @@ func (im *instanceManager) Shutdown(ctx context.Context, timeout time.Duration)
 	return nil
 }
 
-// monitorProcess monitors a running process for crashes
+// waitProcess reaps a spawned process and records whether it stopped cleanly or crashed
+func (im *instanceManager) waitProcess(instance *live.Instance, exited chan struct{}) {
+	defer close(exited)
+
+	_ = instance.Cmd.Wait()
+	state := instance.Cmd.ProcessState
+
+	im.mu.Lock()
+	defer im.mu.Unlock()
+
+	if im.stopping[instance.ID] {
+		// Stop/Kill finish the bookkeeping
+		return
+	}
+
+	instance.LastStatusCheck = time.Now()
+
+	reason, detail := ClassifyExit(instance, state)
+	if reason == live.CrashReasonNone {
+		// Clean exit, e.g. shut down through the monitoring socket
+		if !im.recordExitLocked(instance, live.StatusStopped, reason, detail) {
+			return
+		}
+		im.publish(live.EventExited, live.ActorDaemon, instance, "exit status 0")
+		im.logger.Info("Instance exited", "strategy", instance.StrategyName, "id", instance.ID)
+		return
+	}
+
+	if !im.recordExitLocked(instance, live.StatusCrashed, reason, detail) {
+		return
+	}
+	im.publish(live.EventCrashed, live.ActorDaemon, instance, fmt.Sprintf("%s: %s", reason, detail))
+
+	im.logger.Error("Instance crashed",
+		"strategy", instance.StrategyName,
+		"id", instance.ID,
+		"reason", reason,
+		"error", detail,
+	)
+}
+
+// monitorProcess polls a reattached process (one we can't Wait on) for crashes
 func (im *instanceManager) monitorProcess(instance *live.Instance) {
-	ticker := time.NewTicker(5 * time.Second)
+	ticker := time.NewTicker(monitorInterval)
 	defer ticker.Stop()
 
 	for {
@@ -367,14 +763,27 @@ func (im *instanceManager) monitorProcess(instance *live.Instance) {
 			return
 		case <-ticker.C:
 			// Check if process still alive
-			if _, err := os.FindProcess(instance.PID); err != nil {
+			if !processAlive(instance.PID) {
 				im.mu.Lock()
-				instance.Status = live.StatusCrashed
-				instance.Error = "Process exited unexpectedly"
-				_ = im.saveStateLocked()
+				if im.stopping[instance.ID] {
+					im.mu.Unlock()
+					return
+				}
+				reason, detail := ClassifyExit(instance, nil)
+				recorded := im.recordExitLocked(instance, live.StatusCrashed, reason, detail)
 				im.mu.Unlock()
+				if !recorded {
+					return
+				}
 
-				im.logger.Error("Instance crashed", "strategy", instance.StrategyName, "id", instance.ID)
+				im.publish(live.EventCrashed, live.ActorDaemon, instance, fmt.Sprintf("%s: %s", reason, detail))
+
+				im.logger.Error("Instance crashed",
+					"strategy", instance.StrategyName,
+					"id", instance.ID,
+					"reason", reason,
+					"error", detail,
+				)
 				return
 			}
 
@@ -384,3 +793,250 @@ func (im *instanceManager) monitorProcess(instance *live.Instance) {
 		}
 	}
 }
+
+// recordExitLocked ends the session of an instance whose process exited by itself (must be called with lock held).
+// Every kronos process tracking the instance notices the exit, so only the one whose update still finds it
+// recorded with the same status and PID archives it; the others forget their copy and take the recorded outcome.
+// It reports whether this process recorded the exit, and so should publish it.
+func (im *instanceManager) recordExitLocked(instance *live.Instance, status live.InstanceStatus, reason live.CrashReason, detail string) bool {
+	var session *live.Session
+	recorded := false
+
+	err := im.stateStore.Update(func(saved []*live.Instance) ([]*live.Instance, error) {
+		recorded = false
+		for _, inst := range saved {
+			if inst.ID == instance.ID && inst.Status == instance.Status && inst.PID == instance.PID {
+				recorded = true
+			}
+		}
+
+		if recorded {
+			if status == live.StatusCrashed {
+				instance.CrashReason = reason
+				instance.Error = detail
+			}
+			session = closeSession(instance, status, nil, "")
+		} else {
+			delete(im.instances, instance.ID)
+		}
+		return mergeInstances(saved, im.instances, im.forgotten), nil
+	})
+	if err != nil {
+		im.logger.Warn("Failed to record instance exit", "strategy", instance.StrategyName, "id", instance.ID, "error", err)
+		return false
+	}
+
+	// The history may live in the same store, which can't be written to from inside its own update
+	if session != nil {
+		im.archive(session)
+	}
+	return recorded
+}
+
+// previousInstance returns the strategy's latest recorded instance that isn't on standby, or nil
+func (im *instanceManager) previousInstance(strategyName string) *live.Instance {
+	saved, err := im.stateStore.Query(live.InstanceFilter{StrategyName: strategyName})
+	if err != nil {
+		return nil
+	}
+
+	var previous *live.Instance
+	for _, inst := range saved {
+		if inst.Status != live.StatusStandby && (previous == nil || inst.StartedAt.After(previous.StartedAt)) {
+			previous = inst
+		}
+	}
+	return previous
+}
+
+// recordRestart counts a start as a restart when the strategy's last instance crashed
+func (im *instanceManager) recordRestart(ctx context.Context, instance, previous *live.Instance) {
+	if previous == nil || previous.Status != live.StatusCrashed {
+		return
+	}
+
+	instance.Restarts = previous.Restarts + 1
+	record := &live.RestartRecord{
+		Time:         instance.StartedAt,
+		StrategyName: instance.StrategyName,
+		InstanceID:   instance.ID,
+		PreviousID:   previous.ID,
+		Attempt:      instance.Restarts,
+		Reason:       previous.CrashReason,
+	}
+	if err := im.stateStore.AppendRestart(record); err != nil {
+		im.logger.Warn("Failed to record restart", "strategy", instance.StrategyName, "error", err)
+	}
+
+	im.publish(live.EventRestarted, live.ActorFromContext(ctx), instance,
+		fmt.Sprintf("attempt %d after %s", instance.Restarts, previous.CrashReason))
+}
+
+// recordConfigChange notes when a strategy starts with a different config.yml than its previous instance
+func (im *instanceManager) recordConfigChange(ctx context.Context, instance, previous *live.Instance) {
+	if previous == nil || previous.ConfigHash == "" || instance.ConfigHash == "" || previous.ConfigHash == instance.ConfigHash {
+		return
+	}
+
+	im.publish(live.EventConfigChanged, live.ActorFromContext(ctx), instance,
+		fmt.Sprintf("config.yml %s -> %s", shortHash(previous.ConfigHash), shortHash(instance.ConfigHash)))
+}
+
+// publish emits a lifecycle event about instance; an empty actor means the process default
+func (im *instanceManager) publish(eventType live.EventType, actor live.Actor, instance *live.Instance, reason string) {
+	im.events.Publish(live.Event{
+		Type:         eventType,
+		Actor:        actor,
+		StrategyName: instance.StrategyName,
+		InstanceID:   instance.ID,
+		Reason:       reason,
+	})
+}
+
+// shortHash abbreviates a sha256 for display
+func shortHash(hash string) string {
+	if len(hash) > 12 {
+		return hash[:12]
+	}
+	return hash
+}
+
+// captureStats queries a running instance for its final figures before it is stopped.
+// Returns nil if the instance isn't running or can't be queried.
+func (im *instanceManager) captureStats(instanceID string) *live.SessionStats {
+	im.mu.RLock()
+	instance, exists := im.instances[instanceID]
+	running := exists && instance.Status == live.StatusRunning
+	im.mu.RUnlock()
+	if !running {
+		return nil
+	}
+
+	pnl, err := im.querier.QueryPnL(instance.StrategyName)
+	if err != nil {
+		im.logger.Warn("Failed to capture final PnL", "strategy", instance.StrategyName, "error", err)
+		return nil
+	}
+	stats := &live.SessionStats{PnL: pnl, CapturedAt: time.Now()}
+
+	if trades, err := im.querier.QueryRecentTrades(instance.StrategyName, tradeCountLimit); err == nil {
+		stats.TradeCount = len(trades)
+	}
+
+	return stats
+}
+
+// endSessionLocked marks an instance as no longer running and archives its session (must be called with lock held).
+// A standby instance that was never promoted didn't trade, so it isn't archived.
+func (im *instanceManager) endSessionLocked(instance *live.Instance, status live.InstanceStatus, stats *live.SessionStats, mode live.StopMode) {
+	if session := closeSession(instance, status, stats, mode); session != nil {
+		im.archive(session)
+	}
+}
+
+// closeSession marks an instance as no longer running and returns its session to archive,
+// or nil for a standby instance that was never promoted
+func closeSession(instance *live.Instance, status live.InstanceStatus, stats *live.SessionStats, mode live.StopMode) *live.Session {
+	promoted := instance.Status != live.StatusStandby
+	instance.Status = status
+	instance.PID = 0
+	if !promoted {
+		return nil
+	}
+
+	session := &live.Session{
+		ID:           instance.ID,
+		StrategyName: instance.StrategyName,
+		StartedAt:    instance.StartedAt,
+		EndedAt:      time.Now(),
+		Status:       status,
+		Restarts:     instance.Restarts,
+		StopMode:     mode,
+		Binary:       instance.Binary,
+		Stats:        stats,
+	}
+	if status == live.StatusCrashed {
+		session.CrashReason = instance.CrashReason
+		session.Error = instance.Error
+	}
+	return session
+}
+
+// archive appends a finished session to the history
+func (im *instanceManager) archive(session *live.Session) {
+	if err := im.history.Append(session); err != nil {
+		im.logger.Warn("Failed to archive session", "strategy", session.StrategyName, "error", err)
+	}
+}
+
+// tradeCountLimit caps how many trades are fetched to count a session's trades
+const tradeCountLimit = 10000
+
+// exitGrace is how long a strategy gets to exit on its own once it has drained
+var exitGrace = 10 * time.Second
+
+// monitorInterval is how often reattached processes are checked for having exited
+var monitorInterval = 5 * time.Second
+
+// killProcess force kills a strategy process
+var killProcess = (*os.Process).Kill
+
+// processOf returns the process handle - either from Cmd (if we spawned it) or by PID (if reattached)
+func processOf(instance *live.Instance) (*os.Process, error) {
+	if instance.Cmd != nil && instance.Cmd.Process != nil {
+		return instance.Cmd.Process, nil
+	}
+	if instance.PID > 0 {
+		process, err := os.FindProcess(instance.PID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find process: %w", err)
+		}
+		return process, nil
+	}
+	return nil, fmt.Errorf("instance has no valid process reference")
+}
+
+// waitExit waits up to timeout, or until ctx ends, for the process to exit. Spawned processes are reaped
+// by waitProcess, which closes exited; reattached ones are polled.
+func waitExit(ctx context.Context, process *os.Process, exited chan struct{}, timeout time.Duration) bool {
+	deadline := time.After(timeout)
+
+	if exited != nil {
+		select {
+		case <-exited:
+			return true
+		case <-deadline:
+			return false
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-deadline:
+			return false
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+			// Signal 0 fails once the process is gone
+			if err := process.Signal(syscall.Signal(0)); err != nil {
+				return true
+			}
+		}
+	}
+}
+
+// processAlive reports whether a process with the given PID exists (os.FindProcess never fails on Unix)
+func processAlive(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	return process.Signal(syscall.Signal(0)) == nil
+}
@@ -0,0 +1,213 @@
+package manager_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	monitoringmocks "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/events"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/history"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/manager"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+var _ = Describe("InstanceManager", func() {
+	backends := map[string]func(dir string) live.StateStore{
+		"file": func(dir string) live.StateStore {
+			return manager.NewFileStateStoreAt(filepath.Join(dir, ".instances.json"))
+		},
+		"bolt": func(dir string) live.StateStore {
+			return manager.NewBoltStateStoreAt(filepath.Join(dir, "state.db"))
+		},
+	}
+
+	for name, newStore := range backends {
+		Describe("LoadRunning with the "+name+" store", func() {
+			var (
+				store live.StateStore
+				im    live.InstanceManager
+			)
+
+			BeforeEach(func() {
+				dir, err := os.MkdirTemp("", "manager-"+name)
+				Expect(err).NotTo(HaveOccurred())
+				DeferCleanup(func() {
+					_ = os.RemoveAll(dir)
+				})
+
+				store = newStore(dir)
+				logger := &logging.NoOpLogger{}
+				im = manager.NewInstanceManager(store, nil, logger, nil, history.NewHistoryStore(store), nil,
+					events.NewEventBus(store, logger), &live.SupervisorConfig{})
+			})
+
+			It("should record instances whose process is gone as crashed", func() {
+				Expect(store.Save([]*live.Instance{{
+					ID:           "a",
+					StrategyName: "momentum",
+					Status:       live.StatusRunning,
+					PID:          deadPID(),
+					StartedAt:    time.Now().Add(-time.Hour),
+				}})).To(Succeed())
+
+				done := make(chan error, 1)
+				go func() {
+					done <- im.LoadRunning(context.Background())
+				}()
+				Eventually(done, 5*time.Second).Should(Receive(BeNil()))
+
+				saved, err := store.Load()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(saved).To(HaveLen(1))
+				Expect(saved[0].Status).To(Equal(live.StatusCrashed))
+
+				sessions, err := store.ListSessions(live.HistoryFilter{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sessions).To(HaveLen(1))
+				Expect(sessions[0].Status).To(Equal(live.StatusCrashed))
+
+				recorded, err := store.ListEvents(live.HistoryFilter{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(recorded).To(HaveLen(1))
+				Expect(recorded[0].Type).To(Equal(live.EventCrashed))
+				Expect(recorded[0].Actor).To(Equal(live.ActorDaemon))
+			})
+		})
+
+		Describe("crash of an instance several processes track with the "+name+" store", func() {
+			It("should be recorded once", func() {
+				dir := GinkgoT().TempDir()
+				store := newStore(dir)
+				DeferCleanup(manager.SetMonitorInterval(20 * time.Millisecond))
+
+				process := exec.Command("sleep", "30")
+				Expect(process.Start()).To(Succeed())
+				DeferCleanup(func() {
+					_ = process.Process.Kill()
+					_ = process.Wait()
+				})
+				Expect(store.Save([]*live.Instance{{
+					ID:           "a",
+					StrategyName: "momentum",
+					Status:       live.StatusRunning,
+					PID:          process.Process.Pid,
+					StartedAt:    time.Now().Add(-time.Hour),
+				}})).To(Succeed())
+
+				// Every kronos process - the TUI, serve, alerts watch - reattaches to running instances
+				for range 3 {
+					logger := &logging.NoOpLogger{}
+					im := manager.NewInstanceManager(newStore(dir), nil, logger, nil, history.NewHistoryStore(store), nil,
+						events.NewEventBus(store, logger), &live.SupervisorConfig{})
+					Expect(im.LoadRunning(context.Background())).To(Succeed())
+					DeferCleanup(func() {
+						instance, err := im.Get("a")
+						if err == nil && instance.Cancel != nil {
+							instance.Cancel()
+						}
+					})
+				}
+
+				Expect(process.Process.Kill()).To(Succeed())
+				_ = process.Wait()
+
+				Eventually(func() ([]*live.Session, error) {
+					return store.ListSessions(live.HistoryFilter{})
+				}, 2*time.Second).Should(HaveLen(1))
+				Consistently(func() ([]*live.Event, error) {
+					return store.ListEvents(live.HistoryFilter{})
+				}, 200*time.Millisecond).Should(HaveLen(1))
+
+				saved, err := store.Load()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(saved).To(HaveLen(1))
+				Expect(saved[0].Status).To(Equal(live.StatusCrashed))
+
+				sessions, err := store.ListSessions(live.HistoryFilter{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sessions).To(HaveLen(1))
+			})
+		})
+	}
+})
+
+var _ = Describe("InstanceManager stop", func() {
+	var (
+		store   live.StateStore
+		im      live.InstanceManager
+		process *exec.Cmd
+	)
+
+	BeforeEach(func() {
+		dir := GinkgoT().TempDir()
+		store = manager.NewFileStateStoreAt(filepath.Join(dir, ".instances.json"))
+
+		querier := monitoringmocks.NewViewQuerier(GinkgoT())
+		querier.EXPECT().QueryPnL("momentum").Return(nil, errors.New("no socket")).Maybe()
+
+		cfg := live.DefaultSupervisorConfig()
+		cfg.Stop.DrainTimeout = 10 * time.Millisecond
+		logger := &logging.NoOpLogger{}
+		im = manager.NewInstanceManager(store, nil, logger, nil, history.NewHistoryStore(store), querier,
+			events.NewEventBus(store, logger), cfg)
+		DeferCleanup(manager.SetExitGrace(200 * time.Millisecond))
+
+		// A strategy that ignores SIGINT, so the stop has to force kill it
+		process = exec.Command("sh", "-c", "trap '' INT; sleep 30")
+		Expect(process.Start()).To(Succeed())
+		DeferCleanup(func() {
+			_ = process.Process.Kill()
+			_ = process.Wait()
+		})
+
+		Expect(store.Save([]*live.Instance{{
+			ID:           "a",
+			StrategyName: "momentum",
+			Status:       live.StatusRunning,
+			PID:          process.Process.Pid,
+			StartedAt:    time.Now().Add(-time.Hour),
+		}})).To(Succeed())
+		Expect(im.LoadRunning(context.Background())).To(Succeed())
+	})
+
+	Context("when the force kill fails", func() {
+		BeforeEach(func() {
+			DeferCleanup(manager.SetKillProcess(func(*os.Process) error {
+				return errors.New("operation not permitted")
+			}))
+		})
+
+		It("should end the session and no longer treat the instance as stopping", func() {
+			Expect(im.Stop(context.Background(), "a")).To(MatchError(ContainSubstring("failed to kill process")))
+
+			instance, err := im.Get("a")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(instance.Status).To(Equal(live.StatusStopped))
+			Expect(instance.Error).To(ContainSubstring("operation not permitted"))
+
+			sessions, err := store.ListSessions(live.HistoryFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sessions).To(HaveLen(1))
+			Expect(sessions[0].Status).To(Equal(live.StatusStopped))
+
+			saved, err := store.Load()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(saved[0].Status).To(Equal(live.StatusStopped))
+		})
+	})
+})
+
+// deadPID returns the PID of a process that has already exited
+func deadPID() int {
+	cmd := exec.Command("true")
+	Expect(cmd.Run()).To(Succeed())
+	return cmd.Process.Pid
+}
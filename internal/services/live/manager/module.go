@@ -5,6 +5,7 @@ import (
 
 	"github.com/backtesting-org/kronos-cli/pkg/live"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
 	"go.uber.org/fx"
 )
 
@@ -12,8 +13,9 @@ import (
 var Module = fx.Module(
 	"live/manager",
 	fx.Provide(
-		NewFileStateStore,
-		NewProcessSpawner,
+		NewSupervisorConfig,
+		NewStateStore,
+		NewProcessSpawnerWithConfig,
 		provideInstanceManager,
 	),
 	fx.Invoke(initializeInstanceManager),
@@ -24,10 +26,15 @@ type instanceManagerParams struct {
 	StateStore live.StateStore
 	Spawner    live.ProcessSpawner
 	Logger     logging.ApplicationLogger
+	Controller live.InstanceController
+	History    live.HistoryStore
+	Querier    monitoring.ViewQuerier
+	Events     live.EventBus
+	Config     *live.SupervisorConfig
 }
 
 func provideInstanceManager(params instanceManagerParams) live.InstanceManager {
-	return NewInstanceManager(params.StateStore, params.Spawner, params.Logger)
+	return NewInstanceManager(params.StateStore, params.Spawner, params.Logger, params.Controller, params.History, params.Querier, params.Events, params.Config)
 }
 
 // initializeInstanceManager loads running instances from state file on startup
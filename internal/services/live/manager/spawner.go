@@ -5,29 +5,91 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"sync"
 	"syscall"
 
+	"github.com/backtesting-org/kronos-cli/internal/services/live/binary"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/logs"
 	"github.com/backtesting-org/kronos-cli/pkg/live"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/config"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
 )
 
 type processSpawner struct {
-	logger logging.ApplicationLogger
+	logger     logging.ApplicationLogger
+	rotator    *logs.Rotator
+	isolation  live.IsolationConfig
+	executable string // empty spawns the running executable
+
+	mu      sync.Mutex
+	pending map[*exec.Cmd]*spawnedLimits // limits awaiting ApplyLimits, keyed by command
+}
+
+// spawnedLimits carries the resolved limits of a spawned command until it is started
+type spawnedLimits struct {
+	limits     live.ResourceLimits
+	cgroupPath string
+	cgroupDir  *os.File
 }
 
-// NewProcessSpawner creates a new process spawner
+// NewProcessSpawner creates a new process spawner with default log settings
 func NewProcessSpawner(logger logging.ApplicationLogger) live.ProcessSpawner {
+	return NewProcessSpawnerWithConfig(logger, live.DefaultSupervisorConfig())
+}
+
+// NewProcessSpawnerWithConfig creates a process spawner using the given supervisor settings
+func NewProcessSpawnerWithConfig(logger logging.ApplicationLogger, cfg *live.SupervisorConfig) live.ProcessSpawner {
 	return &processSpawner{
-		logger: logger,
+		logger:     logger,
+		rotator:    logs.NewRotator(cfg.Logs),
+		isolation:  cfg.Isolation,
+		executable: cfg.Executable,
+		pending:    make(map[*exec.Cmd]*spawnedLimits),
 	}
 }
 
 // Spawn creates a new kronos run-strategy process
 func (ps *processSpawner) Spawn(ctx context.Context, strategy *config.Strategy) (*exec.Cmd, error) {
+	opts, err := LoadStrategyOptions(strategy.Path)
+	if err != nil {
+		return nil, err
+	}
+	limits := opts.Limits.Merge(ps.isolation.DefaultLimits)
+
+	// Run the same binary as the parent rather than whatever "kronos" is first on $PATH,
+	// so ./kronos, go run and side-by-side installs spawn the build that launched them
+	executable, err := binary.Resolve(ps.executable)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build command: kronos run-strategy --strategy <name>
 	// The run-strategy command will look in ./strategies/{strategyName}
-	cmd := exec.CommandContext(ctx, "kronos", "run-strategy", "--strategy", strategy.Name)
+	args := []string{"run-strategy", "--strategy", strategy.Name}
+
+	var workDir string
+	if limits.WorkDir != "" {
+		projectDir, err := os.Getwd()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get working directory: %w", err)
+		}
+		workDir = limits.WorkDir
+		if !filepath.IsAbs(workDir) {
+			workDir = filepath.Join(projectDir, workDir)
+		}
+		if err := os.MkdirAll(workDir, 0755); err != nil {
+			return nil, fmt.Errorf("failed to create strategy work directory: %w", err)
+		}
+		// The strategy runs elsewhere, so tell it where the project lives
+		args = append(args, "--project-dir", projectDir)
+	}
+
+	cmd := exec.CommandContext(ctx, executable, args...)
+	cmd.Dir = workDir
+	if len(limits.EnvAllowlist) > 0 {
+		cmd.Env = FilterEnv(os.Environ(), limits.EnvAllowlist)
+	}
 
 	// Create new process group (survive parent exit)
 	cmd.SysProcAttr = &syscall.SysProcAttr{
@@ -35,14 +97,22 @@ func (ps *processSpawner) Spawn(ctx context.Context, strategy *config.Strategy)
 	}
 
 	// Create instance log directory
-	instanceLogDir := fmt.Sprintf(".kronos/instances/%s", strategy.Name)
+	instanceLogDir := logs.InstanceLogDir(strategy.Name)
 	if err := os.MkdirAll(instanceLogDir, 0755); err != nil {
 		return nil, fmt.Errorf("failed to create instance log directory: %w", err)
 	}
 
 	// Redirect stdout/stderr to log files (NOT to TUI)
-	stdoutLog := fmt.Sprintf("%s/stdout.log", instanceLogDir)
-	stderrLog := fmt.Sprintf("%s/stderr.log", instanceLogDir)
+	stdoutLog := logs.InstanceLogPath(strategy.Name, logs.StreamStdout)
+	stderrLog := logs.InstanceLogPath(strategy.Name, logs.StreamStderr)
+
+	// Start each session on a fresh segment if the previous one is due for rotation.
+	// While running, the strategy process rotates its own logs (see run-strategy).
+	for _, path := range []string{stdoutLog, stderrLog} {
+		if _, err := ps.rotator.RotateIfNeeded(path); err != nil {
+			ps.logger.Warn("Failed to rotate instance log", "path", path, "error", err)
+		}
+	}
 
 	stdoutFile, err := os.OpenFile(stdoutLog, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
 	if err != nil {
@@ -55,11 +125,35 @@ func (ps *processSpawner) Spawn(ctx context.Context, strategy *config.Strategy)
 		return nil, fmt.Errorf("failed to open stderr log: %w", err)
 	}
 
+	// Limits are resolved last, so nothing after this can fail and leave the cgroup and its open directory behind
+	if !limits.IsZero() {
+		spawned := &spawnedLimits{limits: limits}
+		if limits.MaxMemoryMB > 0 || limits.CPUQuota > 0 {
+			path, dir, err := prepareCgroup(ps.isolation.CgroupRoot, strategy.Name, limits)
+			if err != nil {
+				ps.logger.Warn("cgroup v2 unavailable, falling back to rlimits", "strategy", strategy.Name, "error", err)
+				if limits.CPUQuota > 0 {
+					ps.logger.Warn("CPU quota requires cgroup v2 and will not be enforced", "strategy", strategy.Name)
+				}
+			} else {
+				useCgroup(cmd.SysProcAttr, dir)
+				spawned.cgroupPath = path
+				spawned.cgroupDir = dir
+			}
+		}
+		inheritLimits(cmd, limits, spawned.cgroupPath != "")
+
+		ps.mu.Lock()
+		ps.pending[cmd] = spawned
+		ps.mu.Unlock()
+	}
+
 	cmd.Stdout = stdoutFile
 	cmd.Stderr = stderrFile
 
 	ps.logger.Info("Spawning strategy process",
 		"strategy", strategy.Name,
+		"executable", executable,
 		"stdout_log", stdoutLog,
 		"stderr_log", stderrLog,
 	)
@@ -67,6 +161,50 @@ func (ps *processSpawner) Spawn(ctx context.Context, strategy *config.Strategy)
 	return cmd, nil
 }
 
+// ApplyLimits records the limits resolved at spawn time on a started instance, enforcing any the process
+// couldn't take on itself before exec.
+// It must also be called when the command failed to start, to release spawn-time resources.
+func (ps *processSpawner) ApplyLimits(instance *live.Instance) error {
+	if instance.Cmd == nil {
+		return fmt.Errorf("command not set on instance")
+	}
+
+	ps.mu.Lock()
+	spawned, ok := ps.pending[instance.Cmd]
+	delete(ps.pending, instance.Cmd)
+	ps.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+	if spawned.cgroupDir != nil {
+		_ = spawned.cgroupDir.Close()
+	}
+	if instance.Cmd.Process == nil {
+		return nil
+	}
+
+	limits := spawned.limits
+	instance.Limits = &limits
+	instance.CgroupPath = spawned.cgroupPath
+
+	if err := applyProcessLimits(instance.Cmd.Process.Pid, limits, spawned.cgroupPath != ""); err != nil {
+		return err
+	}
+
+	ps.logger.Info("Applied resource limits",
+		"strategy", instance.StrategyName,
+		"pid", instance.Cmd.Process.Pid,
+		"max_memory_mb", limits.MaxMemoryMB,
+		"cpu_quota", limits.CPUQuota,
+		"nice", limits.Nice,
+		"max_open_files", limits.MaxOpenFiles,
+		"cgroup", spawned.cgroupPath,
+	)
+
+	return nil
+}
+
 // AttachMonitor starts monitoring process for crashes
 func (ps *processSpawner) AttachMonitor(instance *live.Instance) error {
 	if instance.Cmd == nil {
@@ -62,10 +62,12 @@ var _ = Describe("ProcessSpawner", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(cmd).NotTo(BeNil())
 
-			// Verify command path and args
-			Expect(cmd.Path).To(ContainSubstring("kronos"))
+			// Verify command path and args - the running executable is spawned, not $PATH's kronos
+			executable, err := os.Executable()
+			Expect(err).NotTo(HaveOccurred())
+			executable, _ = filepath.EvalSymlinks(executable)
+			Expect(cmd.Path).To(Equal(executable))
 			Expect(cmd.Args).To(ContainElements(
-				ContainSubstring("kronos"),
 				"run-strategy",
 				"--strategy",
 				"test-momentum",
@@ -211,6 +213,29 @@ var _ = Describe("ProcessSpawner", func() {
 		})
 	})
 
+	Describe("Executable override", func() {
+		It("should spawn the configured executable", func() {
+			fake := filepath.Join(tmpDir, "kronos-v2")
+			Expect(os.WriteFile(fake, []byte("#!/bin/sh\n"), 0755)).To(Succeed())
+
+			cfg := live.DefaultSupervisorConfig()
+			cfg.Executable = fake
+			cmd, err := manager.NewProcessSpawnerWithConfig(logger, cfg).Spawn(ctx, testStrategy)
+			Expect(err).NotTo(HaveOccurred())
+
+			resolved, _ := filepath.EvalSymlinks(fake)
+			Expect(cmd.Path).To(Equal(resolved))
+		})
+
+		It("should fail when the configured executable is missing", func() {
+			cfg := live.DefaultSupervisorConfig()
+			cfg.Executable = filepath.Join(tmpDir, "missing")
+			_, err := manager.NewProcessSpawnerWithConfig(logger, cfg).Spawn(ctx, testStrategy)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("executable not found"))
+		})
+	})
+
 	Describe("AttachMonitor", func() {
 		var (
 			instance *live.Instance
@@ -8,31 +8,92 @@ import (
 	"sync"
 	"time"
 
+	"github.com/backtesting-org/kronos-cli/internal/services/live/history"
 	"github.com/backtesting-org/kronos-cli/pkg/live"
 )
 
+// stateVersion is the schema version written to the state file
+const stateVersion = 2
+
+// stateMigrations upgrade a state file one version at a time; the entry at index i upgrades version i+1.
+// Files written before the version field existed are version 1.
+var stateMigrations = []func(state map[string]json.RawMessage) error{
+	// 1 -> 2: only adds the version field
+	func(state map[string]json.RawMessage) error { return nil },
+}
+
+type stateFile struct {
+	Version   int              `json:"version"`
+	Instances []*live.Instance `json:"instances"`
+	LastSaved time.Time        `json:"last_saved"`
+}
+
+// fileStateStore keeps instance state in a JSON file shared by every kronos process of the user.
+// The in-process mutex orders goroutines; an advisory lock on a sidecar file orders processes.
+// Sessions, events and restarts go to JSONL files next to it.
 type fileStateStore struct {
-	mu   sync.RWMutex
-	path string
+	mu       sync.RWMutex
+	path     string
+	lockPath string
+
+	sessions *history.Log[live.Session]
+	events   *history.Log[live.Event]
+	restarts *history.Log[live.RestartRecord]
 }
 
-// NewFileStateStore creates a new file-based state store at ~/.kronos/.instances.json
-func NewFileStateStore() (live.StateStore, error) {
+// NewStateStore creates the state store selected by the state block of the supervisor config
+func NewStateStore(cfg *live.SupervisorConfig) (live.StateStore, error) {
+	switch cfg.State.Backend {
+	case live.StateBackendFile, "":
+		if cfg.State.Path != "" {
+			return NewFileStateStoreAt(cfg.State.Path), nil
+		}
+		return NewFileStateStore()
+	case live.StateBackendBolt:
+		if cfg.State.Path != "" {
+			return NewBoltStateStoreAt(cfg.State.Path), nil
+		}
+		return NewBoltStateStore()
+	default:
+		return nil, fmt.Errorf("unknown state backend %q (expected %s or %s)", cfg.State.Backend, live.StateBackendFile, live.StateBackendBolt)
+	}
+}
+
+// kronosDir returns ~/.kronos, creating it if needed
+func kronosDir() (string, error) {
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get home directory: %w", err)
+		return "", fmt.Errorf("failed to get home directory: %w", err)
 	}
 
-	kronosDir := filepath.Join(homeDir, ".kronos")
-	if err := os.MkdirAll(kronosDir, 0755); err != nil {
-		return nil, fmt.Errorf("failed to create kronos directory: %w", err)
+	dir := filepath.Join(homeDir, ".kronos")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create kronos directory: %w", err)
 	}
+	return dir, nil
+}
 
-	stateFile := filepath.Join(kronosDir, ".instances.json")
+// NewFileStateStore creates a new file-based state store at ~/.kronos/.instances.json
+func NewFileStateStore() (live.StateStore, error) {
+	dir, err := kronosDir()
+	if err != nil {
+		return nil, err
+	}
 
+	return NewFileStateStoreAt(filepath.Join(dir, ".instances.json")), nil
+}
+
+// NewFileStateStoreAt creates a file-based state store at a custom path.
+// history.jsonl, events.jsonl and restarts.jsonl are kept in the same directory.
+func NewFileStateStoreAt(path string) live.StateStore {
+	dir := filepath.Dir(path)
 	return &fileStateStore{
-		path: stateFile,
-	}, nil
+		path:     path,
+		lockPath: path + ".lock",
+		sessions: history.NewSessionLog(filepath.Join(dir, "history.jsonl")),
+		events:   history.NewEventLog(filepath.Join(dir, "events.jsonl")),
+		restarts: history.NewRestartLog(filepath.Join(dir, "restarts.jsonl")),
+	}
 }
 
 // Load reads persisted state from disk
@@ -40,6 +101,107 @@ func (fss *fileStateStore) Load() ([]*live.Instance, error) {
 	fss.mu.RLock()
 	defer fss.mu.RUnlock()
 
+	unlock, err := lockFile(fss.lockPath, false)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	return fss.read()
+}
+
+// Save writes current state to disk, replacing whatever other processes recorded
+func (fss *fileStateStore) Save(instances []*live.Instance) error {
+	fss.mu.Lock()
+	defer fss.mu.Unlock()
+
+	unlock, err := lockFile(fss.lockPath, true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return fss.write(instances)
+}
+
+// Update reads the current state, lets fn merge its changes in and writes the result,
+// holding the lock throughout so no other process can write in between
+func (fss *fileStateStore) Update(fn func(instances []*live.Instance) ([]*live.Instance, error)) error {
+	fss.mu.Lock()
+	defer fss.mu.Unlock()
+
+	unlock, err := lockFile(fss.lockPath, true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	instances, err := fss.read()
+	if err != nil {
+		return err
+	}
+
+	updated, err := fn(instances)
+	if err != nil {
+		return err
+	}
+
+	return fss.write(updated)
+}
+
+// GetPath returns the path to the state file
+func (fss *fileStateStore) GetPath() string {
+	return fss.path
+}
+
+// Query returns the persisted instances matching filter. The file backend has no indexes, so it scans.
+func (fss *fileStateStore) Query(filter live.InstanceFilter) ([]*live.Instance, error) {
+	instances, err := fss.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	matched := []*live.Instance{}
+	for _, instance := range instances {
+		if filter.Matches(instance) {
+			matched = append(matched, instance)
+		}
+	}
+	return matched, nil
+}
+
+// AppendSession archives a finished session
+func (fss *fileStateStore) AppendSession(session *live.Session) error {
+	return fss.sessions.Append(session)
+}
+
+// ListSessions returns archived sessions matching filter, newest first
+func (fss *fileStateStore) ListSessions(filter live.HistoryFilter) ([]*live.Session, error) {
+	return fss.sessions.List(filter)
+}
+
+// AppendEvent records a lifecycle event
+func (fss *fileStateStore) AppendEvent(event *live.Event) error {
+	return fss.events.Append(event)
+}
+
+// ListEvents returns recorded events matching filter, newest first
+func (fss *fileStateStore) ListEvents(filter live.HistoryFilter) ([]*live.Event, error) {
+	return fss.events.List(filter)
+}
+
+// AppendRestart records a restart of a strategy
+func (fss *fileStateStore) AppendRestart(record *live.RestartRecord) error {
+	return fss.restarts.Append(record)
+}
+
+// ListRestarts returns recorded restarts matching filter, newest first
+func (fss *fileStateStore) ListRestarts(filter live.HistoryFilter) ([]*live.RestartRecord, error) {
+	return fss.restarts.List(filter)
+}
+
+// read decodes the state file, migrating it from older versions (must be called with the lock held)
+func (fss *fileStateStore) read() ([]*live.Instance, error) {
 	data, err := os.ReadFile(fss.path)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -48,27 +210,60 @@ func (fss *fileStateStore) Load() ([]*live.Instance, error) {
 		return nil, err
 	}
 
-	var state struct {
-		Instances []*live.Instance `json:"instances"`
-		LastSaved time.Time        `json:"last_saved"`
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal state: %w", err)
+	}
+
+	if err := migrateState(raw); err != nil {
+		return nil, err
+	}
+
+	data, err = json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to re-encode migrated state: %w", err)
 	}
 
+	var state stateFile
 	if err := json.Unmarshal(data, &state); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal state: %w", err)
 	}
 
+	if state.Instances == nil {
+		return []*live.Instance{}, nil
+	}
 	return state.Instances, nil
 }
 
-// Save writes current state to disk (atomic)
-func (fss *fileStateStore) Save(instances []*live.Instance) error {
-	fss.mu.Lock()
-	defer fss.mu.Unlock()
+// migrateState upgrades a decoded state file in place to stateVersion
+func migrateState(raw map[string]json.RawMessage) error {
+	version := 1
+	if v, ok := raw["version"]; ok {
+		if err := json.Unmarshal(v, &version); err != nil {
+			return fmt.Errorf("invalid state version: %w", err)
+		}
+	}
+
+	if version > stateVersion {
+		return fmt.Errorf("state file is version %d, this kronos only understands up to %d - upgrade kronos", version, stateVersion)
+	}
+
+	for ; version < stateVersion; version++ {
+		if err := stateMigrations[version-1](raw); err != nil {
+			return fmt.Errorf("failed to migrate state from version %d: %w", version, err)
+		}
+	}
+
+	raw["version"] = json.RawMessage(fmt.Sprintf("%d", stateVersion))
+	return nil
+}
 
-	state := struct {
-		Instances []*live.Instance `json:"instances"`
-		LastSaved time.Time        `json:"last_saved"`
-	}{
+// write replaces the state file atomically (must be called with the lock held).
+// Every write goes through its own temp file, which is synced before the rename so a crash
+// leaves either the old or the new state, never a truncated file.
+func (fss *fileStateStore) write(instances []*live.Instance) error {
+	state := stateFile{
+		Version:   stateVersion,
 		Instances: instances,
 		LastSaved: time.Now(),
 	}
@@ -78,11 +273,31 @@ func (fss *fileStateStore) Save(instances []*live.Instance) error {
 		return fmt.Errorf("failed to marshal state: %w", err)
 	}
 
-	// Write to temp file first (atomic write)
-	tmpPath := fss.path + ".tmp"
-	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+	dir := filepath.Dir(fss.path)
+	tmp, err := os.CreateTemp(dir, filepath.Base(fss.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temp state file: %w", err)
+	}
+	tmpPath := tmp.Name()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmpPath)
 		return fmt.Errorf("failed to write temp state file: %w", err)
 	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to sync temp state file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to close temp state file: %w", err)
+	}
+	if err := os.Chmod(tmpPath, 0644); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to set state file permissions: %w", err)
+	}
 
 	// Atomic rename
 	if err := os.Rename(tmpPath, fss.path); err != nil {
@@ -90,10 +305,11 @@ func (fss *fileStateStore) Save(instances []*live.Instance) error {
 		return fmt.Errorf("failed to rename state file: %w", err)
 	}
 
-	return nil
-}
+	// Persist the rename itself
+	if d, err := os.Open(dir); err == nil {
+		_ = d.Sync()
+		_ = d.Close()
+	}
 
-// GetPath returns the path to the state file
-func (fss *fileStateStore) GetPath() string {
-	return fss.path
+	return nil
 }
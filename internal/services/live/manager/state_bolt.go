@@ -0,0 +1,430 @@
+package manager
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// boltVersion is the schema version stored in the meta bucket
+	boltVersion = 1
+
+	// boltLockTimeout bounds how long an operation waits for another process to release the database
+	boltLockTimeout = 5 * time.Second
+)
+
+var (
+	bucketMeta                = []byte("meta")
+	bucketInstances           = []byte("instances")
+	bucketInstancesByStrategy = []byte("instances_by_strategy")
+	bucketInstancesByStatus   = []byte("instances_by_status")
+	bucketSessions            = []byte("sessions")
+	bucketEvents              = []byte("events")
+	bucketRestarts            = []byte("restarts")
+
+	keyVersion = []byte("version")
+
+	// logBuckets hold append-only records keyed by time, each with a by-strategy index
+	logBuckets = [][]byte{bucketSessions, bucketEvents, bucketRestarts}
+)
+
+// boltStateStore keeps instances, sessions, events and restarts in an embedded bbolt database.
+//
+// Instances are indexed by strategy and by status. Sessions, events and restarts are keyed by
+// time and indexed by strategy, so listing the latest records of one strategy doesn't scan the rest.
+//
+// bbolt locks the database file for as long as it is open, so it is opened per operation:
+// other kronos processes wait at most boltLockTimeout for their turn.
+type boltStateStore struct {
+	mu   sync.RWMutex
+	path string
+}
+
+// NewBoltStateStore creates a bolt-backed state store at ~/.kronos/state.db
+func NewBoltStateStore() (live.StateStore, error) {
+	dir, err := kronosDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBoltStateStoreAt(filepath.Join(dir, "state.db")), nil
+}
+
+// NewBoltStateStoreAt creates a bolt-backed state store at a custom path
+func NewBoltStateStoreAt(path string) live.StateStore {
+	return &boltStateStore{path: path}
+}
+
+// GetPath returns the path to the database
+func (bs *boltStateStore) GetPath() string {
+	return bs.path
+}
+
+// Load returns all persisted instances
+func (bs *boltStateStore) Load() ([]*live.Instance, error) {
+	instances := []*live.Instance{}
+	err := bs.view(func(tx *bolt.Tx) error {
+		var err error
+		instances, err = loadInstances(tx)
+		return err
+	})
+	return instances, err
+}
+
+// Save replaces all persisted instances
+func (bs *boltStateStore) Save(instances []*live.Instance) error {
+	return bs.update(func(tx *bolt.Tx) error {
+		return replaceInstances(tx, instances)
+	})
+}
+
+// Update reads the instances, lets fn merge its changes in and writes the result in one transaction
+func (bs *boltStateStore) Update(fn func(instances []*live.Instance) ([]*live.Instance, error)) error {
+	return bs.update(func(tx *bolt.Tx) error {
+		instances, err := loadInstances(tx)
+		if err != nil {
+			return err
+		}
+
+		updated, err := fn(instances)
+		if err != nil {
+			return err
+		}
+
+		return replaceInstances(tx, updated)
+	})
+}
+
+// Query returns the persisted instances matching filter, using the strategy or status index
+func (bs *boltStateStore) Query(filter live.InstanceFilter) ([]*live.Instance, error) {
+	matched := []*live.Instance{}
+	err := bs.view(func(tx *bolt.Tx) error {
+		var index []byte
+		var value string
+		switch {
+		case filter.StrategyName != "":
+			index, value = bucketInstancesByStrategy, filter.StrategyName
+		case filter.Status != "":
+			index, value = bucketInstancesByStatus, string(filter.Status)
+		default:
+			all, err := loadInstances(tx)
+			if err != nil {
+				return err
+			}
+			matched = all
+			return nil
+		}
+
+		instances := tx.Bucket(bucketInstances)
+		prefix := indexPrefix(value)
+		c := tx.Bucket(index).Cursor()
+		for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+			data := instances.Get(k[len(prefix):])
+			if data == nil {
+				continue
+			}
+			var instance live.Instance
+			if err := json.Unmarshal(data, &instance); err != nil {
+				return fmt.Errorf("failed to decode instance: %w", err)
+			}
+			if filter.Matches(&instance) {
+				matched = append(matched, &instance)
+			}
+		}
+		return nil
+	})
+	return matched, err
+}
+
+// AppendSession archives a finished session
+func (bs *boltStateStore) AppendSession(session *live.Session) error {
+	return bs.appendRecord(bucketSessions, session.StrategyName, session.EndedAt, session)
+}
+
+// ListSessions returns archived sessions matching filter, newest first
+func (bs *boltStateStore) ListSessions(filter live.HistoryFilter) ([]*live.Session, error) {
+	sessions := []*live.Session{}
+	err := bs.listRecords(bucketSessions, filter, func(data []byte) error {
+		var session live.Session
+		if err := json.Unmarshal(data, &session); err != nil {
+			return err
+		}
+		sessions = append(sessions, &session)
+		return nil
+	})
+	return sessions, err
+}
+
+// AppendEvent records a lifecycle event
+func (bs *boltStateStore) AppendEvent(event *live.Event) error {
+	return bs.appendRecord(bucketEvents, event.StrategyName, event.Time, event)
+}
+
+// ListEvents returns recorded events matching filter, newest first
+func (bs *boltStateStore) ListEvents(filter live.HistoryFilter) ([]*live.Event, error) {
+	events := []*live.Event{}
+	err := bs.listRecords(bucketEvents, filter, func(data []byte) error {
+		var event live.Event
+		if err := json.Unmarshal(data, &event); err != nil {
+			return err
+		}
+		events = append(events, &event)
+		return nil
+	})
+	return events, err
+}
+
+// AppendRestart records a restart of a strategy
+func (bs *boltStateStore) AppendRestart(record *live.RestartRecord) error {
+	return bs.appendRecord(bucketRestarts, record.StrategyName, record.Time, record)
+}
+
+// ListRestarts returns recorded restarts matching filter, newest first
+func (bs *boltStateStore) ListRestarts(filter live.HistoryFilter) ([]*live.RestartRecord, error) {
+	restarts := []*live.RestartRecord{}
+	err := bs.listRecords(bucketRestarts, filter, func(data []byte) error {
+		var record live.RestartRecord
+		if err := json.Unmarshal(data, &record); err != nil {
+			return err
+		}
+		restarts = append(restarts, &record)
+		return nil
+	})
+	return restarts, err
+}
+
+// view runs fn in a read-only transaction. fn isn't called when the database doesn't exist yet.
+func (bs *boltStateStore) view(fn func(tx *bolt.Tx) error) error {
+	bs.mu.RLock()
+	defer bs.mu.RUnlock()
+
+	if _, err := os.Stat(bs.path); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	db, err := bolt.Open(bs.path, 0644, &bolt.Options{Timeout: boltLockTimeout, ReadOnly: true})
+	if err != nil {
+		return fmt.Errorf("failed to open state database: %w", err)
+	}
+	defer db.Close()
+
+	return db.View(func(tx *bolt.Tx) error {
+		if tx.Bucket(bucketMeta) == nil {
+			return nil
+		}
+		if err := checkBoltVersion(tx); err != nil {
+			return err
+		}
+		return fn(tx)
+	})
+}
+
+// update runs fn in a read-write transaction, creating the database and its buckets on first use
+func (bs *boltStateStore) update(fn func(tx *bolt.Tx) error) error {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(bs.path), 0755); err != nil {
+		return fmt.Errorf("failed to create state directory: %w", err)
+	}
+
+	db, err := bolt.Open(bs.path, 0644, &bolt.Options{Timeout: boltLockTimeout})
+	if err != nil {
+		return fmt.Errorf("failed to open state database: %w", err)
+	}
+	defer db.Close()
+
+	return db.Update(func(tx *bolt.Tx) error {
+		if err := initBolt(tx); err != nil {
+			return err
+		}
+		return fn(tx)
+	})
+}
+
+// initBolt creates missing buckets and stamps or checks the schema version
+func initBolt(tx *bolt.Tx) error {
+	meta, err := tx.CreateBucketIfNotExists(bucketMeta)
+	if err != nil {
+		return err
+	}
+	if meta.Get(keyVersion) == nil {
+		if err := meta.Put(keyVersion, encodeUint(boltVersion)); err != nil {
+			return err
+		}
+	}
+	if err := checkBoltVersion(tx); err != nil {
+		return err
+	}
+
+	buckets := [][]byte{bucketInstances, bucketInstancesByStrategy, bucketInstancesByStatus}
+	for _, name := range logBuckets {
+		buckets = append(buckets, name, strategyIndex(name))
+	}
+	for _, name := range buckets {
+		if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+			return fmt.Errorf("failed to create bucket %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func checkBoltVersion(tx *bolt.Tx) error {
+	data := tx.Bucket(bucketMeta).Get(keyVersion)
+	if len(data) != 8 {
+		return fmt.Errorf("state database has no valid schema version")
+	}
+	if version := binary.BigEndian.Uint64(data); version > boltVersion {
+		return fmt.Errorf("state database is version %d, this kronos only understands up to %d - upgrade kronos", version, boltVersion)
+	}
+	return nil
+}
+
+func loadInstances(tx *bolt.Tx) ([]*live.Instance, error) {
+	instances := []*live.Instance{}
+	err := tx.Bucket(bucketInstances).ForEach(func(_, data []byte) error {
+		var instance live.Instance
+		if err := json.Unmarshal(data, &instance); err != nil {
+			return fmt.Errorf("failed to decode instance: %w", err)
+		}
+		instances = append(instances, &instance)
+		return nil
+	})
+	return instances, err
+}
+
+// replaceInstances rewrites the instances and their indexes
+func replaceInstances(tx *bolt.Tx, instances []*live.Instance) error {
+	for _, name := range [][]byte{bucketInstances, bucketInstancesByStrategy, bucketInstancesByStatus} {
+		if err := tx.DeleteBucket(name); err != nil {
+			return err
+		}
+		if _, err := tx.CreateBucket(name); err != nil {
+			return err
+		}
+	}
+
+	byID := tx.Bucket(bucketInstances)
+	byStrategy := tx.Bucket(bucketInstancesByStrategy)
+	byStatus := tx.Bucket(bucketInstancesByStatus)
+
+	for _, instance := range instances {
+		data, err := json.Marshal(instance)
+		if err != nil {
+			return fmt.Errorf("failed to encode instance: %w", err)
+		}
+		id := []byte(instance.ID)
+		if err := byID.Put(id, data); err != nil {
+			return err
+		}
+		if err := byStrategy.Put(append(indexPrefix(instance.StrategyName), id...), []byte{}); err != nil {
+			return err
+		}
+		if err := byStatus.Put(append(indexPrefix(string(instance.Status)), id...), []byte{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendRecord stores record in a log bucket under a time-ordered key and indexes it by strategy
+func (bs *boltStateStore) appendRecord(bucket []byte, strategyName string, at time.Time, record any) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to encode record: %w", err)
+	}
+
+	return bs.update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucket)
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		// Time first so a cursor walks records chronologically; the sequence keeps keys unique
+		key := append(encodeUint(uint64(at.UnixNano())), encodeUint(seq)...)
+		if err := b.Put(key, data); err != nil {
+			return err
+		}
+		return tx.Bucket(strategyIndex(bucket)).Put(append(indexPrefix(strategyName), key...), []byte{})
+	})
+}
+
+// listRecords walks a log bucket newest first, through the strategy index when filtering by strategy
+func (bs *boltStateStore) listRecords(bucket []byte, filter live.HistoryFilter, decode func(data []byte) error) error {
+	return bs.view(func(tx *bolt.Tx) error {
+		records := tx.Bucket(bucket)
+
+		var prefix []byte
+		c := records.Cursor()
+		if filter.StrategyName != "" {
+			prefix = indexPrefix(filter.StrategyName)
+			c = tx.Bucket(strategyIndex(bucket)).Cursor()
+		}
+
+		count := 0
+		for k := lastWithPrefix(c, prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Prev() {
+			key := k[len(prefix):]
+			if !filter.Since.IsZero() && int64(binary.BigEndian.Uint64(key[:8])) < filter.Since.UnixNano() {
+				break
+			}
+
+			data := records.Get(key)
+			if data == nil {
+				continue
+			}
+			// Skip records that can't be decoded, like the file backend skips broken lines
+			if err := decode(data); err != nil {
+				continue
+			}
+
+			count++
+			if filter.Limit > 0 && count >= filter.Limit {
+				break
+			}
+		}
+		return nil
+	})
+}
+
+// lastWithPrefix positions c on the last key starting with prefix (any key when prefix is empty)
+func lastWithPrefix(c *bolt.Cursor, prefix []byte) []byte {
+	if len(prefix) == 0 {
+		k, _ := c.Last()
+		return k
+	}
+
+	// Log keys are 16 bytes, so prefix followed by 0xff... sorts after every key with that prefix
+	upper := append(append([]byte{}, prefix...), bytes.Repeat([]byte{0xff}, 17)...)
+	if k, _ := c.Seek(upper); k == nil {
+		k, _ = c.Last()
+		return k
+	}
+	k, _ := c.Prev()
+	return k
+}
+
+func strategyIndex(bucket []byte) []byte {
+	return append(append([]byte{}, bucket...), "_by_strategy"...)
+}
+
+// indexPrefix separates the indexed value from the key with a NUL, which names can't contain
+func indexPrefix(value string) []byte {
+	return append([]byte(value), 0)
+}
+
+func encodeUint(v uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, v)
+	return b
+}
@@ -0,0 +1,273 @@
+package manager_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/manager"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+var _ = Describe("FileStateStore", func() {
+	var (
+		dir   string
+		path  string
+		store live.StateStore
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "state")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(func() {
+			_ = os.RemoveAll(dir)
+		})
+
+		path = filepath.Join(dir, ".instances.json")
+		store = manager.NewFileStateStoreAt(path)
+	})
+
+	It("should return no instances before anything was saved", func() {
+		instances, err := store.Load()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(instances).To(BeEmpty())
+	})
+
+	It("should write the schema version and leave no temp files behind", func() {
+		Expect(store.Save([]*live.Instance{{ID: "a", StrategyName: "momentum"}})).To(Succeed())
+
+		data, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		var state map[string]any
+		Expect(json.Unmarshal(data, &state)).To(Succeed())
+		Expect(state["version"]).To(BeEquivalentTo(2))
+
+		tmps, err := filepath.Glob(filepath.Join(dir, "*.tmp"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tmps).To(BeEmpty())
+	})
+
+	It("should migrate a state file written before versioning", func() {
+		legacy := `{"instances": [{"id": "a", "strategy_name": "momentum", "status": "running"}], "last_saved": "2026-01-01T00:00:00Z"}`
+		Expect(os.WriteFile(path, []byte(legacy), 0644)).To(Succeed())
+
+		instances, err := store.Load()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(instances).To(HaveLen(1))
+		Expect(instances[0].StrategyName).To(Equal("momentum"))
+	})
+
+	It("should refuse a state file from a newer version", func() {
+		Expect(os.WriteFile(path, []byte(`{"version": 99, "instances": []}`), 0644)).To(Succeed())
+
+		_, err := store.Load()
+		Expect(err).To(MatchError(ContainSubstring("upgrade kronos")))
+	})
+
+	It("should leave the state untouched when the update fails", func() {
+		Expect(store.Save([]*live.Instance{{ID: "a"}})).To(Succeed())
+
+		err := store.Update(func(instances []*live.Instance) ([]*live.Instance, error) {
+			return nil, fmt.Errorf("boom")
+		})
+		Expect(err).To(MatchError("boom"))
+
+		instances, err := store.Load()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(instances).To(HaveLen(1))
+	})
+})
+
+var _ = Describe("StateStore backends", func() {
+	backends := map[string]func(dir string) live.StateStore{
+		"file": func(dir string) live.StateStore {
+			return manager.NewFileStateStoreAt(filepath.Join(dir, ".instances.json"))
+		},
+		"bolt": func(dir string) live.StateStore {
+			return manager.NewBoltStateStoreAt(filepath.Join(dir, "state.db"))
+		},
+	}
+
+	for name, newStore := range backends {
+		Describe(name, func() {
+			var (
+				dir   string
+				store live.StateStore
+				now   time.Time
+			)
+
+			BeforeEach(func() {
+				var err error
+				dir, err = os.MkdirTemp("", "state-"+name)
+				Expect(err).NotTo(HaveOccurred())
+				DeferCleanup(func() {
+					_ = os.RemoveAll(dir)
+				})
+
+				store = newStore(dir)
+				now = time.Now().Truncate(time.Second)
+			})
+
+			It("should read nothing from a fresh store", func() {
+				instances, err := store.Load()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(instances).To(BeEmpty())
+
+				sessions, err := store.ListSessions(live.HistoryFilter{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sessions).To(BeEmpty())
+			})
+
+			It("should query instances by strategy and status", func() {
+				Expect(store.Save([]*live.Instance{
+					{ID: "a", StrategyName: "momentum", Status: live.StatusRunning},
+					{ID: "b", StrategyName: "momentum", Status: live.StatusCrashed},
+					{ID: "c", StrategyName: "arb", Status: live.StatusRunning},
+				})).To(Succeed())
+
+				running, err := store.Query(live.InstanceFilter{Status: live.StatusRunning})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(instanceIDs(running)).To(ConsistOf("a", "c"))
+
+				momentum, err := store.Query(live.InstanceFilter{StrategyName: "momentum"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(instanceIDs(momentum)).To(ConsistOf("a", "b"))
+
+				crashed, err := store.Query(live.InstanceFilter{StrategyName: "momentum", Status: live.StatusCrashed})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(instanceIDs(crashed)).To(ConsistOf("b"))
+			})
+
+			It("should keep the indexes in step with updates", func() {
+				Expect(store.Save([]*live.Instance{{ID: "a", StrategyName: "momentum", Status: live.StatusRunning}})).To(Succeed())
+
+				Expect(store.Update(func(instances []*live.Instance) ([]*live.Instance, error) {
+					instances[0].Status = live.StatusStopped
+					return instances, nil
+				})).To(Succeed())
+
+				running, err := store.Query(live.InstanceFilter{Status: live.StatusRunning})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(running).To(BeEmpty())
+
+				stopped, err := store.Query(live.InstanceFilter{Status: live.StatusStopped})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(instanceIDs(stopped)).To(ConsistOf("a"))
+			})
+
+			It("should not lose concurrent updates from separate stores", func() {
+				const writers = 8
+				var wg sync.WaitGroup
+				for i := 0; i < writers; i++ {
+					wg.Add(1)
+					go func(i int) {
+						defer GinkgoRecover()
+						defer wg.Done()
+
+						// Each store stands in for another process
+						Expect(newStore(dir).Update(func(instances []*live.Instance) ([]*live.Instance, error) {
+							return append(instances, &live.Instance{ID: fmt.Sprintf("inst-%d", i)}), nil
+						})).To(Succeed())
+					}(i)
+				}
+				wg.Wait()
+
+				instances, err := store.Load()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(instances).To(HaveLen(writers))
+			})
+
+			It("should list sessions newest first with filters", func() {
+				for i, strategy := range []string{"momentum", "arb", "momentum", "momentum"} {
+					Expect(store.AppendSession(&live.Session{
+						ID:           fmt.Sprintf("s%d", i),
+						StrategyName: strategy,
+						EndedAt:      now.Add(time.Duration(i) * time.Hour),
+					})).To(Succeed())
+				}
+
+				sessions, err := store.ListSessions(live.HistoryFilter{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sessionIDs(sessions)).To(Equal([]string{"s3", "s2", "s1", "s0"}))
+
+				sessions, err = store.ListSessions(live.HistoryFilter{StrategyName: "momentum", Limit: 2})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sessionIDs(sessions)).To(Equal([]string{"s3", "s2"}))
+
+				sessions, err = store.ListSessions(live.HistoryFilter{StrategyName: "momentum", Since: now.Add(90 * time.Minute)})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sessionIDs(sessions)).To(Equal([]string{"s3", "s2"}))
+
+				sessions, err = store.ListSessions(live.HistoryFilter{StrategyName: "missing"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sessions).To(BeEmpty())
+			})
+
+			It("should record events and restarts", func() {
+				Expect(store.AppendEvent(&live.Event{Time: now, Type: "started", StrategyName: "momentum"})).To(Succeed())
+				Expect(store.AppendEvent(&live.Event{Time: now.Add(time.Second), Type: "crashed", StrategyName: "momentum"})).To(Succeed())
+				Expect(store.AppendRestart(&live.RestartRecord{
+					Time:         now,
+					StrategyName: "momentum",
+					InstanceID:   "b",
+					PreviousID:   "a",
+					Attempt:      1,
+					Reason:       live.CrashReasonMemoryLimit,
+				})).To(Succeed())
+
+				events, err := store.ListEvents(live.HistoryFilter{StrategyName: "momentum"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(events).To(HaveLen(2))
+				Expect(events[0].Type).To(Equal(live.EventType("crashed")))
+
+				restarts, err := store.ListRestarts(live.HistoryFilter{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(restarts).To(HaveLen(1))
+				Expect(restarts[0].Reason).To(Equal(live.CrashReasonMemoryLimit))
+			})
+		})
+	}
+})
+
+var _ = Describe("NewStateStore", func() {
+	It("should select the backend from the config", func() {
+		dir, err := os.MkdirTemp("", "state")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(func() {
+			_ = os.RemoveAll(dir)
+		})
+
+		cfg := live.DefaultSupervisorConfig()
+		cfg.State = live.StateConfig{Backend: live.StateBackendBolt, Path: filepath.Join(dir, "state.db")}
+		store, err := manager.NewStateStore(cfg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(store.GetPath()).To(Equal(cfg.State.Path))
+
+		cfg.State.Backend = "sqlite"
+		_, err = manager.NewStateStore(cfg)
+		Expect(err).To(MatchError(ContainSubstring("unknown state backend")))
+	})
+})
+
+func instanceIDs(instances []*live.Instance) []string {
+	ids := make([]string, 0, len(instances))
+	for _, instance := range instances {
+		ids = append(ids, instance.ID)
+	}
+	return ids
+}
+
+func sessionIDs(sessions []*live.Session) []string {
+	ids := make([]string, 0, len(sessions))
+	for _, session := range sessions {
+		ids = append(ids, session.ID)
+	}
+	return ids
+}
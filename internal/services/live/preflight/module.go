@@ -0,0 +1,10 @@
+package preflight
+
+import "go.uber.org/fx"
+
+// Module provides the pre-flight checks via Fx
+var Module = fx.Module("live/preflight",
+	fx.Provide(
+		NewPreflight,
+	),
+)
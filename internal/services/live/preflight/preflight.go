@@ -0,0 +1,382 @@
+package preflight
+
+import (
+	"bytes"
+	"context"
+	"debug/buildinfo"
+	"errors"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/binary"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/manager"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-cli/pkg/strategy"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/config"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/version"
+)
+
+const (
+	// sdkModule is the module path strategies are built against
+	sdkModule = "github.com/backtesting-org/kronos-sdk"
+
+	// clockTimeout bounds the request used to measure clock skew
+	clockTimeout = 5 * time.Second
+)
+
+// placeholders are values left over from config templates
+var placeholders = []string{"changeme", "placeholder", "todo", "xxx", "your_", "your-", "<", "..."}
+
+type preflight struct {
+	connectors config.ConnectorService
+	settings   config.Configuration
+	registry   registry.ConnectorRegistry
+	compiler   strategy.CompileService
+	controller live.InstanceController
+	querier    monitoring.ViewQuerier
+	cfg        *live.SupervisorConfig
+}
+
+// NewPreflight creates the pre-flight checks
+func NewPreflight(
+	connectors config.ConnectorService,
+	settings config.Configuration,
+	registry registry.ConnectorRegistry,
+	compiler strategy.CompileService,
+	controller live.InstanceController,
+	querier monitoring.ViewQuerier,
+	cfg *live.SupervisorConfig,
+) live.Preflight {
+	return &preflight{
+		connectors: connectors,
+		settings:   settings,
+		registry:   registry,
+		compiler:   compiler,
+		controller: controller,
+		querier:    querier,
+		cfg:        cfg,
+	}
+}
+
+// Run checks the strategy in the order a start would hit problems: what is running, exchange access,
+// risk settings, the build, and finally the clock
+func (p *preflight) Run(ctx context.Context, strat *config.Strategy) *live.PreflightReport {
+	report := &live.PreflightReport{StrategyName: strat.Name}
+	add := func(check live.PreflightCheck) {
+		report.Checks = append(report.Checks, check)
+	}
+
+	add(p.checkConflicts(strat))
+
+	userConnectors, err := p.settings.GetConnectors()
+	for _, exchange := range strat.Exchanges {
+		if err != nil {
+			add(fail("credentials ("+exchange+")", fmt.Sprintf("failed to read connector settings: %v", err)))
+			continue
+		}
+		conn := findConnector(userConnectors, exchange)
+		add(p.checkCredentials(exchange, conn))
+		add(p.checkAssets(strat, exchange, conn))
+	}
+
+	add(checkRisk(strat))
+
+	compiled := p.checkCompiled(strat)
+	add(compiled)
+
+	sdk := skipped("SDK version", "the strategy isn't compiled")
+	if compiled.Status == live.CheckPass {
+		sdk = checkSDKVersion(pluginPath(strat))
+	}
+	add(sdk)
+
+	loads := skipped("plugin loads", "the SDK version check didn't pass")
+	if sdk.Status == live.CheckPass {
+		loads = p.checkPluginLoads(ctx, strat)
+	}
+	add(loads)
+
+	add(p.checkClock(ctx))
+
+	return report
+}
+
+// checkConflicts fails if the strategy already runs, or a reload of it is underway, in any kronos process
+func (p *preflight) checkConflicts(strat *config.Strategy) live.PreflightCheck {
+	const name = "no conflicting instance"
+
+	switch {
+	case p.controller.StandbyAvailable(strat.Name):
+		return fail(name, "a standby instance is loaded - a reload is in progress")
+	case p.controller.Available(strat.Name), p.querier.HealthCheck(strat.Name) == nil:
+		return fail(name, fmt.Sprintf("%s is already running", strat.Name))
+	}
+	return pass(name, "")
+}
+
+// checkCredentials requires every credential field the connector asks for, in a usable form,
+// and the SDK's own validation of the connector config
+func (p *preflight) checkCredentials(exchange string, conn *config.Connector) live.PreflightCheck {
+	name := "credentials (" + exchange + ")"
+
+	if conn == nil {
+		return fail(name, "no connector configured for "+exchange)
+	}
+	if !conn.Enabled {
+		return fail(name, "the "+exchange+" connector is disabled")
+	}
+
+	required := p.connectors.GetRequiredCredentialFields(exchange)
+	sort.Strings(required)
+
+	var problems []string
+	for _, field := range required {
+		if problem := credentialProblem(conn.Credentials[field]); problem != "" {
+			problems = append(problems, field+" "+problem)
+		}
+	}
+	if len(problems) > 0 {
+		return fail(name, strings.Join(problems, "; "))
+	}
+
+	if err := p.connectors.ValidateConnectorConfig(connector.ExchangeName(exchange), *conn); err != nil {
+		return fail(name, err.Error())
+	}
+
+	return pass(name, fmt.Sprintf("%d fields set", len(required)))
+}
+
+// credentialProblem describes what is wrong with a credential value, or returns ""
+func credentialProblem(value string) string {
+	if value == "" {
+		return "is missing"
+	}
+	if strings.TrimSpace(value) != value {
+		return "has leading or trailing whitespace"
+	}
+
+	lower := strings.ToLower(value)
+	for _, placeholder := range placeholders {
+		if strings.Contains(lower, placeholder) {
+			return "looks like a placeholder"
+		}
+	}
+	return ""
+}
+
+// checkAssets requires every asset the strategy trades on an exchange to be offered by its connector.
+// Connectors that don't list their symbols are checked against the assets configured for them.
+func (p *preflight) checkAssets(strat *config.Strategy, exchange string, conn *config.Connector) live.PreflightCheck {
+	name := "assets (" + exchange + ")"
+
+	wanted := strat.Assets[exchange]
+	if len(wanted) == 0 {
+		return warn(name, "the strategy lists no assets for "+exchange)
+	}
+
+	supported := make(map[string]bool)
+	if registered, ok := p.registry.GetConnector(connector.ExchangeName(exchange)); ok {
+		if info := registered.GetConnectorInfo(); info != nil {
+			for _, asset := range info.SupportedSymbols {
+				supported[strings.ToUpper(asset.Symbol())] = true
+			}
+		}
+	}
+	if len(supported) == 0 && conn != nil {
+		for _, symbol := range conn.Assets {
+			supported[strings.ToUpper(symbol)] = true
+		}
+	}
+	if len(supported) == 0 {
+		return warn(name, exchange+" doesn't list its symbols, so they can't be verified")
+	}
+
+	var missing []string
+	for _, asset := range wanted {
+		if !supported[strings.ToUpper(asset.Symbol)] {
+			missing = append(missing, asset.Symbol)
+		}
+	}
+	if len(missing) > 0 {
+		return fail(name, "not available on "+exchange+": "+strings.Join(missing, ", "))
+	}
+
+	return pass(name, fmt.Sprintf("%d assets available", len(wanted)))
+}
+
+// checkRisk requires the risk block of the strategy's config.yml
+func checkRisk(strat *config.Strategy) live.PreflightCheck {
+	const name = "risk limits"
+
+	opts, err := manager.LoadStrategyOptions(strat.Path)
+	if err != nil {
+		return fail(name, err.Error())
+	}
+
+	var missing []string
+	if opts.Risk.MaxPositionSize <= 0 {
+		missing = append(missing, "risk.max_position_size")
+	}
+	if opts.Risk.MaxDailyLoss <= 0 {
+		missing = append(missing, "risk.max_daily_loss")
+	}
+	if len(missing) > 0 {
+		return fail(name, "set "+strings.Join(missing, " and ")+" in config.yml")
+	}
+
+	return pass(name, fmt.Sprintf("max position %.2f, max daily loss %.2f", opts.Risk.MaxPositionSize, opts.Risk.MaxDailyLoss))
+}
+
+// checkCompiled compiles the strategy if its source changed since the last build
+func (p *preflight) checkCompiled(strat *config.Strategy) live.PreflightCheck {
+	const name = "compiled"
+
+	if err := p.compiler.CompileStrategy(strat.Path); err != nil {
+		return fail(name, err.Error())
+	}
+	return pass(name, filepath.Base(pluginPath(strat)))
+}
+
+// checkSDKVersion compares the SDK the plugin was built against with the one this kronos runs.
+// The plugin loader only accepts an exact match.
+func checkSDKVersion(path string) live.PreflightCheck {
+	const name = "SDK version"
+
+	info, err := buildinfo.ReadFile(path)
+	if err != nil {
+		return fail(name, fmt.Sprintf("failed to read build info: %v", err))
+	}
+
+	for _, dep := range info.Deps {
+		if dep == nil || dep.Path != sdkModule {
+			continue
+		}
+		if dep.Replace != nil || dep.Version == "" || dep.Version == "(devel)" {
+			return fail(name, "built with a local copy of the SDK - require a released version in go.mod")
+		}
+		if dep.Version != version.SDKVersion {
+			return fail(name, fmt.Sprintf("built with %s, kronos runs %s - update the strategy's go.mod and recompile", dep.Version, version.SDKVersion))
+		}
+		return pass(name, dep.Version)
+	}
+
+	return fail(name, "the plugin doesn't depend on "+sdkModule)
+}
+
+// checkPluginLoads loads the plugin in a separate kronos process, as a strategy process would, since a Go
+// plugin can't be unloaded once this process has opened it
+func (p *preflight) checkPluginLoads(ctx context.Context, strat *config.Strategy) live.PreflightCheck {
+	const name = "plugin loads"
+
+	executable, err := binary.Resolve(p.cfg.Executable)
+	if err != nil {
+		return fail(name, err.Error())
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.cfg.Preflight.PluginTimeout)
+	defer cancel()
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, executable, "run-strategy", "--strategy", strat.Name, "--check-plugin")
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fail(name, fmt.Sprintf("loading the plugin took longer than %s", p.cfg.Preflight.PluginTimeout))
+		}
+		if line := lastLine(stderr.String()); line != "" {
+			return fail(name, line)
+		}
+		return fail(name, err.Error())
+	}
+
+	return pass(name, "exports NewStrategy")
+}
+
+// checkClock compares the local clock with the Date header of a well-known server.
+// The header has a resolution of one second, so skew is measured from the middle of that second.
+func (p *preflight) checkClock(ctx context.Context) live.PreflightCheck {
+	const name = "clock skew"
+
+	ctx, cancel := context.WithTimeout(ctx, clockTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, p.cfg.Preflight.TimeURL, nil)
+	if err != nil {
+		return warn(name, err.Error())
+	}
+
+	sent := time.Now()
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return warn(name, fmt.Sprintf("couldn't reach %s to compare clocks: %v", p.cfg.Preflight.TimeURL, err))
+	}
+	received := time.Now()
+	_ = resp.Body.Close()
+
+	remote, err := http.ParseTime(resp.Header.Get("Date"))
+	if err != nil {
+		return warn(name, p.cfg.Preflight.TimeURL+" didn't send a usable Date header")
+	}
+
+	local := sent.Add(received.Sub(sent) / 2)
+	skew := local.Sub(remote.Add(500 * time.Millisecond)).Round(100 * time.Millisecond)
+
+	direction := "ahead"
+	if skew < 0 {
+		skew, direction = -skew, "behind"
+	}
+	if skew > p.cfg.Preflight.MaxClockSkew {
+		return fail(name, fmt.Sprintf("local clock is %s %s - check NTP", skew, direction))
+	}
+	return pass(name, fmt.Sprintf("%s %s", skew, direction))
+}
+
+func findConnector(connectors []config.Connector, exchange string) *config.Connector {
+	for i := range connectors {
+		if connectors[i].Name == exchange {
+			return &connectors[i]
+		}
+	}
+	return nil
+}
+
+// pluginPath is where the compile service writes a strategy's plugin
+func pluginPath(strat *config.Strategy) string {
+	return filepath.Join(strat.Path, filepath.Base(strat.Path)+".so")
+}
+
+// lastLine returns the last non-empty line of output, without the log package's timestamp
+func lastLine(output string) string {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	line := strings.TrimSpace(lines[len(lines)-1])
+	if len(line) > 20 {
+		if _, err := time.Parse("2006/01/02 15:04:05", line[:19]); err == nil {
+			line = line[20:]
+		}
+	}
+	return line
+}
+
+func pass(name, detail string) live.PreflightCheck {
+	return live.PreflightCheck{Name: name, Status: live.CheckPass, Detail: detail}
+}
+
+func warn(name, detail string) live.PreflightCheck {
+	return live.PreflightCheck{Name: name, Status: live.CheckWarn, Detail: detail}
+}
+
+func fail(name, detail string) live.PreflightCheck {
+	return live.PreflightCheck{Name: name, Status: live.CheckFail, Detail: detail}
+}
+
+func skipped(name, detail string) live.PreflightCheck {
+	return live.PreflightCheck{Name: name, Status: live.CheckSkipped, Detail: detail}
+}
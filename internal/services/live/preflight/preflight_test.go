@@ -0,0 +1,193 @@
+package preflight_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/preflight"
+	livemocks "github.com/backtesting-org/kronos-cli/mocks/github.com/backtesting-org/kronos-cli/pkg/live"
+	strategymocks "github.com/backtesting-org/kronos-cli/mocks/github.com/backtesting-org/kronos-cli/pkg/strategy"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	configmocks "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/config"
+	connectormocks "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	monitoringmocks "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	registrymocks "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/config"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+)
+
+var _ = Describe("Preflight", func() {
+	var (
+		connectors *configmocks.ConnectorService
+		settings   *configmocks.Configuration
+		registry   *registrymocks.ConnectorRegistry
+		compiler   *strategymocks.CompileService
+		controller *livemocks.InstanceController
+		querier    *monitoringmocks.ViewQuerier
+		cfg        *live.SupervisorConfig
+		checks     live.Preflight
+
+		server     *httptest.Server
+		serverTime time.Time
+		userConn   config.Connector
+		strat      *config.Strategy
+	)
+
+	// check returns the named check of a report
+	check := func(report *live.PreflightReport, name string) live.PreflightCheck {
+		for _, c := range report.Checks {
+			if c.Name == name {
+				return c
+			}
+		}
+		Fail("no check named " + name)
+		return live.PreflightCheck{}
+	}
+
+	writeConfig := func(content string) {
+		Expect(os.WriteFile(filepath.Join(strat.Path, "config.yml"), []byte(content), 0644)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		connectors = configmocks.NewConnectorService(GinkgoT())
+		settings = configmocks.NewConfiguration(GinkgoT())
+		registry = registrymocks.NewConnectorRegistry(GinkgoT())
+		compiler = strategymocks.NewCompileService(GinkgoT())
+		controller = livemocks.NewInstanceController(GinkgoT())
+		querier = monitoringmocks.NewViewQuerier(GinkgoT())
+
+		serverTime = time.Now()
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Date", serverTime.UTC().Format(http.TimeFormat))
+		}))
+		DeferCleanup(server.Close)
+
+		cfg = live.DefaultSupervisorConfig()
+		cfg.Preflight.TimeURL = server.URL
+		checks = preflight.NewPreflight(connectors, settings, registry, compiler, controller, querier, cfg)
+
+		strat = &config.Strategy{
+			Name:      "momentum",
+			Path:      filepath.Join(GinkgoT().TempDir(), "momentum"),
+			Exchanges: []string{"binance"},
+			Assets:    map[string][]config.Asset{"binance": {{Symbol: "BTC"}, {Symbol: "ETH"}}},
+		}
+		Expect(os.MkdirAll(strat.Path, 0755)).To(Succeed())
+		writeConfig("risk:\n  max_position_size: 1.5\n  max_daily_loss: 200\n")
+
+		userConn = config.Connector{
+			Name:        "binance",
+			Enabled:     true,
+			Credentials: map[string]string{"api_key": "k3y", "api_secret": "s3cret"},
+		}
+		settings.EXPECT().GetConnectors().RunAndReturn(func() ([]config.Connector, error) {
+			return []config.Connector{userConn}, nil
+		}).Maybe()
+		connectors.EXPECT().GetRequiredCredentialFields("binance").Return([]string{"api_key", "api_secret"}).Maybe()
+		connectors.EXPECT().ValidateConnectorConfig(connector.ExchangeName("binance"), mock.Anything).Return(nil).Maybe()
+
+		exchange := connectormocks.NewConnector(GinkgoT())
+		exchange.EXPECT().GetConnectorInfo().Return(&connector.Info{
+			SupportedSymbols: []portfolio.Asset{portfolio.NewAsset("BTC"), portfolio.NewAsset("ETH")},
+		}).Maybe()
+		registry.EXPECT().GetConnector(connector.ExchangeName("binance")).Return(exchange, true).Maybe()
+
+		// The plugin checks need a real build; they are skipped once compiling fails
+		compiler.EXPECT().CompileStrategy(strat.Path).Return(errors.New("no go toolchain")).Maybe()
+
+		controller.EXPECT().StandbyAvailable("momentum").Return(false).Maybe()
+		controller.EXPECT().Available("momentum").Return(false).Maybe()
+		querier.EXPECT().HealthCheck("momentum").Return(errors.New("connection refused")).Maybe()
+	})
+
+	It("passes everything that doesn't need a build", func() {
+		report := checks.Run(context.Background(), strat)
+
+		for _, name := range []string{"no conflicting instance", "credentials (binance)", "assets (binance)", "risk limits", "clock skew"} {
+			Expect(check(report, name).Status).To(Equal(live.CheckPass), name)
+		}
+		Expect(check(report, "compiled").Status).To(Equal(live.CheckFail))
+		Expect(check(report, "SDK version").Status).To(Equal(live.CheckSkipped))
+		Expect(check(report, "plugin loads").Status).To(Equal(live.CheckSkipped))
+		Expect(report.Failed()).To(HaveLen(1))
+	})
+
+	Describe("credentials", func() {
+		DescribeTable("rejects unusable values",
+			func(secret, detail string) {
+				userConn.Credentials["api_secret"] = secret
+
+				result := check(checks.Run(context.Background(), strat), "credentials (binance)")
+				Expect(result.Status).To(Equal(live.CheckFail))
+				Expect(result.Detail).To(Equal("api_secret " + detail))
+			},
+			Entry("missing", "", "is missing"),
+			Entry("trailing newline", "s3cret\n", "has leading or trailing whitespace"),
+			Entry("template value", "<your-api-secret>", "looks like a placeholder"),
+			Entry("changeme", "CHANGEME", "looks like a placeholder"),
+		)
+
+		It("fails when the exchange has no connector configured", func() {
+			userConn.Name = "kraken"
+
+			result := check(checks.Run(context.Background(), strat), "credentials (binance)")
+			Expect(result.Status).To(Equal(live.CheckFail))
+			Expect(result.Detail).To(ContainSubstring("no connector configured"))
+		})
+	})
+
+	It("fails assets the exchange doesn't offer", func() {
+		strat.Assets["binance"] = append(strat.Assets["binance"], config.Asset{Symbol: "DOGE"})
+
+		result := check(checks.Run(context.Background(), strat), "assets (binance)")
+		Expect(result.Status).To(Equal(live.CheckFail))
+		Expect(result.Detail).To(ContainSubstring("DOGE"))
+	})
+
+	It("fails when risk limits aren't set", func() {
+		writeConfig("risk:\n  max_position_size: 1.5\n")
+
+		result := check(checks.Run(context.Background(), strat), "risk limits")
+		Expect(result.Status).To(Equal(live.CheckFail))
+		Expect(result.Detail).To(ContainSubstring("risk.max_daily_loss"))
+		Expect(result.Detail).NotTo(ContainSubstring("risk.max_position_size"))
+	})
+
+	Describe("clock skew", func() {
+		It("fails when the local clock is off by more than the limit", func() {
+			serverTime = time.Now().Add(-time.Minute)
+
+			result := check(checks.Run(context.Background(), strat), "clock skew")
+			Expect(result.Status).To(Equal(live.CheckFail))
+			Expect(result.Detail).To(ContainSubstring("ahead"))
+		})
+
+		It("only warns when the time server can't be reached", func() {
+			server.Close()
+
+			result := check(checks.Run(context.Background(), strat), "clock skew")
+			Expect(result.Status).To(Equal(live.CheckWarn))
+		})
+	})
+
+	It("fails when the strategy is already running", func() {
+		querier.ExpectedCalls = nil
+		querier.EXPECT().HealthCheck("momentum").Return(nil)
+
+		report := checks.Run(context.Background(), strat)
+		result := check(report, "no conflicting instance")
+		Expect(result.Status).To(Equal(live.CheckFail))
+		Expect(result.Detail).To(Equal("momentum is already running"))
+		Expect(report.Passed()).To(BeFalse())
+	})
+})
@@ -0,0 +1,10 @@
+package reload
+
+import "go.uber.org/fx"
+
+// Module provides the strategy hot-swap service via Fx
+var Module = fx.Module("live/reload",
+	fx.Provide(
+		NewReloader,
+	),
+)
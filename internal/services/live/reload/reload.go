@@ -0,0 +1,363 @@
+package reload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/logs"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-cli/pkg/strategy"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/config"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+)
+
+const (
+	// pollInterval is how often the standby and the promoted instance are checked
+	pollInterval = 250 * time.Millisecond
+
+	// healthInterval is how often the promoted instance is health checked
+	healthInterval = time.Second
+
+	// maxHealthFailures is how many consecutive failed health checks trigger a rollback
+	maxHealthFailures = 3
+
+	// handoffTimeout bounds the old instance's stop; it leaves orders and positions in place, so there is nothing to drain
+	handoffTimeout = 10 * time.Second
+)
+
+type reloader struct {
+	manager    live.InstanceManager
+	controller live.InstanceController
+	compiler   strategy.CompileService
+	querier    monitoring.ViewQuerier
+	logger     logging.ApplicationLogger
+}
+
+// NewReloader creates a Reloader that hot-swaps strategies through a standby instance
+func NewReloader(
+	manager live.InstanceManager,
+	controller live.InstanceController,
+	compiler strategy.CompileService,
+	querier monitoring.ViewQuerier,
+	logger logging.ApplicationLogger,
+) live.Reloader {
+	return &reloader{
+		manager:    manager,
+		controller: controller,
+		compiler:   compiler,
+		querier:    querier,
+		logger:     logger,
+	}
+}
+
+// Reload recompiles the strategy, starts the new build on standby next to the running instance and,
+// once the standby is connected and warmed up, retires the old process and hands the exchange exposure over. If the new build doesn't come up
+// healthy, the previous build is restored and restarted.
+func (r *reloader) Reload(ctx context.Context, strategyName string, opts live.ReloadOptions) (*live.ReloadResult, error) {
+	started := time.Now()
+	result := &live.ReloadResult{StrategyName: strategyName}
+	defer func() {
+		result.Duration = time.Since(started)
+	}()
+
+	old, err := r.runningInstance(strategyName)
+	if err != nil {
+		return nil, err
+	}
+	if !r.controller.Available(strategyName) {
+		return nil, fmt.Errorf("instance %s has no control socket - restart it once with this kronos version to enable reload", strategyName)
+	}
+	result.OldPID = old.PID
+
+	strat := &config.Strategy{Name: old.StrategyName, Path: old.StrategyPath}
+
+	// Keep the build the old process runs so a failed reload can bring it back
+	backup, err := backupPlugin(old)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = os.Remove(backup)
+	}()
+
+	// 1. Recompile. The running process keeps its copy of the old plugin mapped.
+	result.Recompiled = r.compiler.NeedsRecompile(old.StrategyPath)
+	if err := r.compiler.CompileStrategy(old.StrategyPath); err != nil {
+		return r.abort(result, live.ReloadPhaseCompile, err, old, backup)
+	}
+
+	// 2. Start the new build on standby and wait until it has connected and warmed up, with its signals held back
+	standby, err := r.manager.StartStandby(ctx, strat, old.FrameworkRoot)
+	if err != nil {
+		return r.abort(result, live.ReloadPhaseStandby, err, old, backup)
+	}
+	if err := r.awaitStandby(ctx, strategyName, standby.ID, opts.ReadyTimeout); err != nil {
+		_ = r.manager.Kill(ctx, standby.ID)
+		return r.abort(result, live.ReloadPhaseStandby, err, old, backup)
+	}
+
+	// 3. Hand over: the old instance stops trading and exits, leaving its orders and positions in place,
+	// and the standby, already running, releases its signals and takes over the exposure
+	handoff, err := r.manager.StopStrategy(ctx, strategyName, live.StopOptions{
+		Mode:         live.StopModeLeave,
+		DrainTimeout: handoffTimeout,
+	})
+	if err != nil {
+		_ = r.manager.Kill(ctx, standby.ID)
+		return r.abort(result, live.ReloadPhaseHandoff, err, old, backup)
+	}
+	result.Handoff = handoff
+
+	adopted, err := r.controller.Promote(strategyName)
+	if err == nil {
+		err = r.manager.Promote(standby.ID)
+	}
+	if err != nil {
+		return r.rollback(ctx, result, live.ReloadPhasePromote, err, standby, strat, backup)
+	}
+	result.NewPID = standby.PID
+	result.Adopted = adopted
+	result.Warnings = append(result.Warnings, compareExposure(handoff, adopted)...)
+
+	// 4. The new build must stay up and healthy before the old build is discarded
+	if err := r.checkHealth(ctx, strategyName, standby.ID, opts.HealthTimeout); err != nil {
+		return r.rollback(ctx, result, live.ReloadPhaseHealth, err, standby, strat, backup)
+	}
+
+	r.logger.Info("Reloaded strategy",
+		"strategy", strategyName,
+		"old_pid", result.OldPID,
+		"new_pid", result.NewPID,
+		"recompiled", result.Recompiled,
+	)
+
+	return result, nil
+}
+
+func (r *reloader) runningInstance(strategyName string) (*live.Instance, error) {
+	instances, err := r.manager.List(live.StatusRunning)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list instances: %w", err)
+	}
+	for _, instance := range instances {
+		if instance.StrategyName == strategyName {
+			return instance, nil
+		}
+	}
+	return nil, fmt.Errorf("no running instance found for strategy: %s", strategyName)
+}
+
+// abort restores the previous build while the old instance is still trading
+func (r *reloader) abort(
+	result *live.ReloadResult,
+	phase live.ReloadPhase,
+	cause error,
+	old *live.Instance,
+	backup string,
+) (*live.ReloadResult, error) {
+	result.FailedPhase = phase
+	result.Error = cause.Error()
+
+	if err := restorePlugin(old.StrategyPath, old.StrategyName, backup); err != nil {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("failed to restore previous build: %v", err))
+	}
+
+	r.logger.Warn("Reload aborted, the running instance was left untouched",
+		"strategy", result.StrategyName,
+		"phase", phase,
+		"error", cause,
+	)
+	return result, fmt.Errorf("reload failed during %s: %w", phase, cause)
+}
+
+// rollback replaces a new instance that failed after taking over with the previous build
+func (r *reloader) rollback(
+	ctx context.Context,
+	result *live.ReloadResult,
+	phase live.ReloadPhase,
+	cause error,
+	failed *live.Instance,
+	strat *config.Strategy,
+	backup string,
+) (*live.ReloadResult, error) {
+	result.FailedPhase = phase
+	result.Error = cause.Error()
+
+	r.logger.Error("New build failed, rolling back",
+		"strategy", result.StrategyName,
+		"phase", phase,
+		"error", cause,
+	)
+
+	// Retire the failed instance without touching the exposure the previous build will take back.
+	// It may never have been promoted, in which case it isn't trading and can simply be killed.
+	if instance, err := r.manager.Get(failed.ID); err == nil {
+		switch instance.Status {
+		case live.StatusRunning:
+			if _, err := r.manager.StopStrategy(ctx, strat.Name, live.StopOptions{
+				Mode:         live.StopModeLeave,
+				DrainTimeout: handoffTimeout,
+			}); err != nil {
+				_ = r.manager.Kill(ctx, failed.ID)
+			}
+		case live.StatusStandby:
+			_ = r.manager.Kill(ctx, failed.ID)
+		}
+	}
+
+	if err := restorePlugin(strat.Path, strat.Name, backup); err != nil {
+		return result, fmt.Errorf("reload failed during %s (%v) and the previous build could not be restored: %w", phase, cause, err)
+	}
+
+	// kronos brings the previous build back by itself, so the restart is attributed to it
+	restored, err := r.manager.Start(live.WithActor(ctx, live.ActorAutoRestart), strat, failed.FrameworkRoot)
+	if err != nil {
+		return result, fmt.Errorf("reload failed during %s (%v) and the previous build could not be restarted: %w", phase, cause, err)
+	}
+
+	result.RolledBack = true
+	result.NewPID = restored.PID
+	return result, fmt.Errorf("reload failed during %s, rolled back to the previous build: %w", phase, cause)
+}
+
+// awaitStandby waits for the standby instance to open its control socket, which it does once its connectors
+// are initialised and the strategy is running with its signals held back
+func (r *reloader) awaitStandby(ctx context.Context, strategyName, instanceID string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if r.controller.StandbyAvailable(strategyName) {
+			return nil
+		}
+		instance, err := r.manager.Get(instanceID)
+		if err != nil {
+			return err
+		}
+		if instance.Status != live.StatusStandby {
+			return fmt.Errorf("standby instance exited before it was ready: %s", exitDetail(instance))
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("standby instance not ready after %s", timeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkHealth requires the promoted instance to stay alive and pass health checks for the whole timeout
+func (r *reloader) checkHealth(ctx context.Context, strategyName, instanceID string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(healthInterval)
+	defer ticker.Stop()
+
+	failures := 0
+	var lastErr error
+	for {
+		instance, err := r.manager.Get(instanceID)
+		if err != nil {
+			return err
+		}
+		if instance.Status != live.StatusRunning {
+			return fmt.Errorf("new instance exited: %s", exitDetail(instance))
+		}
+
+		if err := r.querier.HealthCheck(strategyName); err != nil {
+			failures++
+			lastErr = err
+			if failures >= maxHealthFailures {
+				return fmt.Errorf("health check failed %d times: %w", failures, lastErr)
+			}
+		} else {
+			failures = 0
+		}
+
+		select {
+		case <-ctx.Done():
+			if failures > 0 {
+				return fmt.Errorf("still unhealthy when the health timeout expired: %w", lastErr)
+			}
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func exitDetail(instance *live.Instance) string {
+	if instance.Error != "" {
+		return fmt.Sprintf("%s (%s)", instance.Status, instance.Error)
+	}
+	return string(instance.Status)
+}
+
+// compareExposure warns when the new instance doesn't see what the old one handed over
+func compareExposure(handoff *live.StopResult, adopted *live.Exposure) []string {
+	if handoff == nil || adopted == nil || !handoff.Snapshot || !adopted.Complete {
+		return []string{"could not confirm the handed over orders and positions"}
+	}
+
+	var warnings []string
+	if handoff.OpenOrders != adopted.OpenOrders {
+		warnings = append(warnings, fmt.Sprintf("handed over %d open orders, new instance sees %d", handoff.OpenOrders, adopted.OpenOrders))
+	}
+	if len(handoff.Positions) != len(adopted.Positions) {
+		warnings = append(warnings, fmt.Sprintf("handed over %d positions, new instance sees %d", len(handoff.Positions), len(adopted.Positions)))
+	}
+	return warnings
+}
+
+func pluginPath(strategyPath, strategyName string) string {
+	return filepath.Join(strategyPath, strategyName+".so")
+}
+
+// backupPlugin copies the strategy's current plugin next to its logs and returns the copy's path
+func backupPlugin(instance *live.Instance) (string, error) {
+	backup := filepath.Join(instance.FrameworkRoot, logs.InstanceLogDir(instance.StrategyName), "rollback.so")
+	if err := os.MkdirAll(filepath.Dir(backup), 0755); err != nil {
+		return "", fmt.Errorf("failed to create instance directory: %w", err)
+	}
+	if err := copyFile(pluginPath(instance.StrategyPath, instance.StrategyName), backup); err != nil {
+		return "", fmt.Errorf("failed to back up the running build: %w", err)
+	}
+	return backup, nil
+}
+
+// restorePlugin puts the backed up build back in place of a failed one
+func restorePlugin(strategyPath, strategyName, backup string) error {
+	return copyFile(backup, pluginPath(strategyPath, strategyName))
+}
+
+// copyFile writes src to dst through a temporary file, so dst is never half-written
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	tmp := dst + ".tmp"
+	out, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0755)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		return errors.Join(err, out.Close(), os.Remove(tmp))
+	}
+	if err := out.Close(); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, dst)
+}
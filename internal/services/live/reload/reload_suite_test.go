@@ -0,0 +1,13 @@
+package reload_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestReload(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Reload Suite")
+}
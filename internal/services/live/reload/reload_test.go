@@ -0,0 +1,204 @@
+package reload_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/reload"
+	livemocks "github.com/backtesting-org/kronos-cli/mocks/github.com/backtesting-org/kronos-cli/pkg/live"
+	strategymocks "github.com/backtesting-org/kronos-cli/mocks/github.com/backtesting-org/kronos-cli/pkg/strategy"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	monitoringmocks "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+)
+
+var _ = Describe("Reloader", func() {
+	var (
+		manager    *livemocks.InstanceManager
+		controller *livemocks.InstanceController
+		compiler   *strategymocks.CompileService
+		querier    *monitoringmocks.ViewQuerier
+		reloader   live.Reloader
+
+		projectDir  string
+		strategyDir string
+		pluginFile  string
+		old         *live.Instance
+		standby     *live.Instance
+		opts        live.ReloadOptions
+		hasSocket   bool
+	)
+
+	BeforeEach(func() {
+		manager = livemocks.NewInstanceManager(GinkgoT())
+		controller = livemocks.NewInstanceController(GinkgoT())
+		compiler = strategymocks.NewCompileService(GinkgoT())
+		querier = monitoringmocks.NewViewQuerier(GinkgoT())
+		reloader = reload.NewReloader(manager, controller, compiler, querier, &logging.NoOpLogger{})
+
+		projectDir = GinkgoT().TempDir()
+		strategyDir = filepath.Join(projectDir, "strategies", "momentum")
+		Expect(os.MkdirAll(strategyDir, 0755)).To(Succeed())
+		pluginFile = filepath.Join(strategyDir, "momentum.so")
+		Expect(os.WriteFile(pluginFile, []byte("old build"), 0755)).To(Succeed())
+
+		old = &live.Instance{ID: "old", StrategyName: "momentum", StrategyPath: strategyDir, FrameworkRoot: projectDir, PID: 100, Status: live.StatusRunning}
+		standby = &live.Instance{ID: "new", StrategyName: "momentum", StrategyPath: strategyDir, FrameworkRoot: projectDir, PID: 200, Status: live.StatusStandby}
+		opts = live.ReloadOptions{ReadyTimeout: time.Second, HealthTimeout: 10 * time.Millisecond}
+
+		manager.EXPECT().List(live.StatusRunning).Return([]*live.Instance{old}, nil).Maybe()
+		hasSocket = true
+		controller.EXPECT().Available("momentum").RunAndReturn(func(string) bool { return hasSocket }).Maybe()
+		compiler.EXPECT().NeedsRecompile(strategyDir).Return(true).Maybe()
+	})
+
+	compiles := func() {
+		compiler.EXPECT().CompileStrategy(strategyDir).RunAndReturn(func(string) error {
+			return os.WriteFile(pluginFile, []byte("new build"), 0755)
+		})
+	}
+
+	startsStandby := func() {
+		manager.EXPECT().StartStandby(mock.Anything, mock.Anything, projectDir).Return(standby, nil)
+		controller.EXPECT().StandbyAvailable("momentum").Return(true)
+	}
+
+	handsOver := func() {
+		manager.EXPECT().StopStrategy(mock.Anything, "momentum", mock.MatchedBy(func(opts live.StopOptions) bool {
+			return opts.Mode == live.StopModeLeave
+		})).Return(&live.StopResult{StrategyName: "momentum", OpenOrders: 2, Snapshot: true}, nil).Once()
+	}
+
+	promotes := func() {
+		controller.EXPECT().Promote("momentum").Return(&live.Exposure{OpenOrders: 2, Complete: true}, nil)
+		manager.EXPECT().Promote("new").RunAndReturn(func(string) error {
+			standby.Status = live.StatusRunning
+			return nil
+		})
+	}
+
+	It("should swap in the new build", func() {
+		compiles()
+		startsStandby()
+		handsOver()
+		promotes()
+		manager.EXPECT().Get("new").Return(standby, nil)
+		querier.EXPECT().HealthCheck("momentum").Return(nil)
+
+		result, err := reloader.Reload(context.Background(), "momentum", opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.OldPID).To(Equal(100))
+		Expect(result.NewPID).To(Equal(200))
+		Expect(result.Recompiled).To(BeTrue())
+		Expect(result.Adopted.OpenOrders).To(Equal(2))
+		Expect(result.Warnings).To(BeEmpty())
+		Expect(os.ReadFile(pluginFile)).To(Equal([]byte("new build")))
+	})
+
+	It("should only retire the old instance once the standby is ready", func() {
+		compiles()
+		manager.EXPECT().StartStandby(mock.Anything, mock.Anything, projectDir).Return(standby, nil)
+		ready := false
+		checks := 0
+		controller.EXPECT().StandbyAvailable("momentum").RunAndReturn(func(string) bool {
+			checks++
+			ready = checks > 2
+			return ready
+		})
+		manager.EXPECT().Get("new").Return(standby, nil)
+
+		var order []string
+		manager.EXPECT().StopStrategy(mock.Anything, "momentum", mock.Anything).RunAndReturn(func(context.Context, string, live.StopOptions) (*live.StopResult, error) {
+			Expect(ready).To(BeTrue(), "the old instance was stopped before the standby was ready")
+			order = append(order, "stop old")
+			return &live.StopResult{StrategyName: "momentum", OpenOrders: 2, Snapshot: true}, nil
+		}).Once()
+		controller.EXPECT().Promote("momentum").RunAndReturn(func(string) (*live.Exposure, error) {
+			order = append(order, "promote")
+			return &live.Exposure{OpenOrders: 2, Complete: true}, nil
+		})
+		manager.EXPECT().Promote("new").RunAndReturn(func(string) error {
+			standby.Status = live.StatusRunning
+			return nil
+		})
+		querier.EXPECT().HealthCheck("momentum").Return(nil)
+
+		_, err := reloader.Reload(context.Background(), "momentum", opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(checks).To(Equal(3))
+		Expect(order).To(Equal([]string{"stop old", "promote"}))
+	})
+
+	It("should refuse instances without a control socket", func() {
+		hasSocket = false
+
+		_, err := reloader.Reload(context.Background(), "momentum", opts)
+		Expect(err).To(MatchError(ContainSubstring("no control socket")))
+	})
+
+	It("should keep the running instance when the build fails", func() {
+		compiler.EXPECT().CompileStrategy(strategyDir).RunAndReturn(func(string) error {
+			_ = os.Remove(pluginFile)
+			return errors.New("compilation failed")
+		})
+
+		result, err := reloader.Reload(context.Background(), "momentum", opts)
+		Expect(err).To(HaveOccurred())
+		Expect(result.FailedPhase).To(Equal(live.ReloadPhaseCompile))
+		Expect(result.RolledBack).To(BeFalse())
+		Expect(os.ReadFile(pluginFile)).To(Equal([]byte("old build")))
+	})
+
+	It("should keep the running instance when the standby can't load the build", func() {
+		compiles()
+		manager.EXPECT().StartStandby(mock.Anything, mock.Anything, projectDir).Return(standby, nil)
+		controller.EXPECT().StandbyAvailable("momentum").Return(false)
+		manager.EXPECT().Get("new").Return(&live.Instance{ID: "new", Status: live.StatusCrashed, Error: "exit code 1"}, nil)
+		manager.EXPECT().Kill(mock.Anything, "new").Return(nil)
+
+		result, err := reloader.Reload(context.Background(), "momentum", opts)
+		Expect(err).To(MatchError(ContainSubstring("exit code 1")))
+		Expect(result.FailedPhase).To(Equal(live.ReloadPhaseStandby))
+		Expect(os.ReadFile(pluginFile)).To(Equal([]byte("old build")))
+	})
+
+	It("should roll back when the new build dies after taking over", func() {
+		compiles()
+		startsStandby()
+		handsOver()
+		promotes()
+		manager.EXPECT().Get("new").Return(&live.Instance{ID: "new", Status: live.StatusCrashed}, nil)
+		manager.EXPECT().Start(mock.Anything, mock.Anything, projectDir).Return(&live.Instance{ID: "restored", PID: 300}, nil)
+
+		result, err := reloader.Reload(context.Background(), "momentum", opts)
+		Expect(err).To(MatchError(ContainSubstring("rolled back")))
+		Expect(result.RolledBack).To(BeTrue())
+		Expect(result.FailedPhase).To(Equal(live.ReloadPhaseHealth))
+		Expect(result.NewPID).To(Equal(300))
+		Expect(os.ReadFile(pluginFile)).To(Equal([]byte("old build")))
+	})
+
+	It("should warn when the new instance doesn't see the handed over exposure", func() {
+		compiles()
+		startsStandby()
+		handsOver()
+		controller.EXPECT().Promote("momentum").Return(&live.Exposure{OpenOrders: 0, Complete: true}, nil)
+		manager.EXPECT().Promote("new").RunAndReturn(func(string) error {
+			standby.Status = live.StatusRunning
+			return nil
+		})
+		manager.EXPECT().Get("new").Return(standby, nil)
+		querier.EXPECT().HealthCheck("momentum").Return(nil)
+
+		result, err := reloader.Reload(context.Background(), "momentum", opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Warnings).To(ContainElement(ContainSubstring("handed over 2 open orders")))
+	})
+})
@@ -0,0 +1,195 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+)
+
+// errPaused is what the pause gate blocks signals with, whether the operator paused them or the instance is on standby
+var errPaused = errors.New("signal execution paused")
+
+type actionExecutor struct {
+	connectors registry.ConnectorRegistry
+	logger     logging.ApplicationLogger
+	paused     atomic.Bool
+}
+
+// NewActionExecutor creates an ActionExecutor that acts on the strategy's ready connectors.
+// It registers an execution hook that holds back every signal while paused.
+func NewActionExecutor(
+	connectors registry.ConnectorRegistry,
+	hooks registry.Hooks,
+	logger logging.ApplicationLogger,
+) live.ActionExecutor {
+	e := &actionExecutor{
+		connectors: connectors,
+		logger:     logger,
+	}
+	hooks.RegisterHook(&pauseGate{executor: e})
+	return e
+}
+
+func (e *actionExecutor) Paused() bool {
+	return e.paused.Load()
+}
+
+func (e *actionExecutor) Execute(ctx context.Context, action live.TradingAction) *live.ActionResult {
+	result := &live.ActionResult{Action: action}
+
+	switch action.Type {
+	case live.ActionPause:
+		e.paused.Store(true)
+	case live.ActionResume:
+		e.paused.Store(false)
+	case live.ActionCancelOrders, live.ActionClosePosition:
+		conn, ok := e.connector(action.Exchange)
+		if !ok {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s is not a ready trading connector", action.Exchange))
+			break
+		}
+		if action.Type == live.ActionCancelOrders {
+			e.cancelOrders(ctx, conn, action, result)
+		} else {
+			e.closePosition(conn, action, result)
+		}
+	default:
+		result.Errors = append(result.Errors, fmt.Sprintf("unknown action %q", action.Type))
+	}
+
+	result.Paused = e.paused.Load()
+	result.Time = time.Now()
+
+	e.logger.Info("Trading action done",
+		"action", action.Description(),
+		"cancelled_orders", result.CancelledOrders,
+		"placed_orders", len(result.OrderIDs),
+		"paused", result.Paused,
+		"errors", len(result.Errors),
+	)
+
+	return result
+}
+
+// connector finds the ready trading connector of an exchange
+func (e *actionExecutor) connector(exchange string) (tradingConnector, bool) {
+	for _, conn := range tradingConnectors(e.connectors) {
+		if string(conn.name) == exchange {
+			return conn, true
+		}
+	}
+	return tradingConnector{}, false
+}
+
+func (e *actionExecutor) cancelOrders(ctx context.Context, conn tradingConnector, action live.TradingAction, result *live.ActionResult) {
+	orders, err := conn.executor.GetOpenOrders()
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("%s: failed to list open orders: %v", conn.name, err))
+		return
+	}
+
+	wanted := make(map[string]bool, len(action.OrderIDs))
+	for _, id := range action.OrderIDs {
+		wanted[id] = true
+	}
+
+	for _, order := range orders {
+		if !conn.trades(order.Symbol, action.Asset) || (len(wanted) > 0 && !wanted[order.ID]) {
+			continue
+		}
+		if ctx.Err() != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: stopped cancelling: %v", conn.name, ctx.Err()))
+			return
+		}
+		if _, err := conn.executor.CancelOrder(order.Symbol, order.ID); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: failed to cancel order %s: %v", conn.name, order.ID, err))
+			continue
+		}
+		result.CancelledOrders++
+	}
+}
+
+func (e *actionExecutor) closePosition(conn tradingConnector, action live.TradingAction, result *live.ActionResult) {
+	if conn.perp == nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("%s holds balances rather than positions, sell them instead", conn.name))
+		return
+	}
+
+	positions, err := conn.perp.GetPositions()
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("%s: failed to list positions: %v", conn.name, err))
+		return
+	}
+
+	for _, position := range positions {
+		if position.Size.IsZero() || !conn.trades(position.Symbol.Symbol(), action.Asset) {
+			continue
+		}
+
+		quantity := position.Size.Abs()
+		if action.Fraction > 0 && action.Fraction < 1 {
+			quantity = quantity.Mul(numerical.NewFromFloat(action.Fraction))
+		}
+
+		side := closingSide(position)
+		symbol := conn.perp.GetPerpSymbol(position.Symbol)
+
+		var placed string
+		if action.Price > 0 {
+			resp, err := conn.executor.PlaceLimitOrder(symbol, side, quantity, numerical.NewFromFloat(action.Price))
+			if err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("%s: failed to place limit order on %s: %v", conn.name, symbol, err))
+				return
+			}
+			placed = resp.OrderID
+		} else {
+			resp, err := conn.executor.PlaceMarketOrder(symbol, side, quantity)
+			if err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("%s: failed to close %s: %v", conn.name, symbol, err))
+				return
+			}
+			placed = resp.OrderID
+		}
+		result.OrderIDs = append(result.OrderIDs, placed)
+		return
+	}
+
+	result.Errors = append(result.Errors, fmt.Sprintf("%s: no open %s position", conn.name, action.Asset))
+}
+
+// trades reports whether an exchange symbol is the asset, either as named or as the connector's perp symbol
+func (c tradingConnector) trades(symbol, asset string) bool {
+	if symbol == asset {
+		return true
+	}
+	return c.perp != nil && symbol == c.perp.GetPerpSymbol(portfolio.NewAsset(asset))
+}
+
+// pauseGate blocks signals before any of their orders is placed while the strategy is paused
+type pauseGate struct {
+	executor *actionExecutor
+}
+
+func (g *pauseGate) BeforeExecute(_ *execution.ExecutionContext) error {
+	if g.executor.Paused() {
+		return errPaused
+	}
+	return nil
+}
+
+func (g *pauseGate) AfterExecute(_ *execution.ExecutionContext, _ *execution.ExecutionResult) error {
+	return nil
+}
+
+func (g *pauseGate) OnError(_ *execution.ExecutionContext, _ error) error {
+	return nil
+}
@@ -0,0 +1,237 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector/perp"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+)
+
+// confirmInterval is how often the exchange is polled while waiting for a drain to settle
+const confirmInterval = 500 * time.Millisecond
+
+type drainer struct {
+	connectors registry.ConnectorRegistry
+	views      monitoring.ViewRegistry
+	logger     logging.ApplicationLogger
+}
+
+// NewDrainer creates a Drainer that acts on the strategy's ready connectors
+func NewDrainer(
+	connectors registry.ConnectorRegistry,
+	views monitoring.ViewRegistry,
+	logger logging.ApplicationLogger,
+) live.Drainer {
+	return &drainer{
+		connectors: connectors,
+		views:      views,
+		logger:     logger,
+	}
+}
+
+// tradingConnector is a ready connector that can place and cancel orders
+type tradingConnector struct {
+	name     connector.ExchangeName
+	executor connector.OrderExecutor
+	perp     perp.Connector // nil for spot-only connectors
+}
+
+// Drain cancels orders and/or closes positions according to mode, waits for the exchange to confirm
+// until ctx expires, and snapshots what is left.
+func (d *drainer) Drain(ctx context.Context, mode live.StopMode) *live.StopResult {
+	started := time.Now()
+	result := &live.StopResult{Mode: mode}
+	connectors := d.tradingConnectors()
+
+	if mode == live.StopModeCancelOrders || mode == live.StopModeFlatten {
+		for _, conn := range connectors {
+			d.cancelOrders(conn, result)
+		}
+	}
+
+	if mode == live.StopModeFlatten {
+		for _, conn := range connectors {
+			d.closePositions(conn, result)
+		}
+	}
+
+	// Wait for the exchange to reflect the drain, then take the final snapshot
+	for {
+		exposure := d.exposure(connectors)
+		result.OpenOrders = exposure.OpenOrders
+		result.Positions = exposure.Positions
+		result.Snapshot = exposure.Complete
+
+		if drained(mode, exposure) {
+			break
+		}
+		if !sleepCtx(ctx, confirmInterval) {
+			result.TimedOut = true
+			result.Errors = append(result.Errors, "drain timeout expired before the exchange confirmed")
+			break
+		}
+	}
+
+	result.PnL = d.views.GetPnLView()
+	result.StoppedAt = time.Now()
+	result.Duration = result.StoppedAt.Sub(started)
+
+	d.logger.Info("Drain complete",
+		"mode", mode,
+		"cancelled_orders", result.CancelledOrders,
+		"closed_positions", result.ClosedPositions,
+		"open_orders", result.OpenOrders,
+		"open_positions", len(result.Positions),
+		"timed_out", result.TimedOut,
+	)
+
+	return result
+}
+
+// Exposure reports what the strategy currently has open on its connectors
+func (d *drainer) Exposure() *live.Exposure {
+	return d.exposure(d.tradingConnectors())
+}
+
+func (d *drainer) tradingConnectors() []tradingConnector {
+	return tradingConnectors(d.connectors)
+}
+
+// tradingConnectors returns the ready connectors that support trading
+func tradingConnectors(connectors registry.ConnectorRegistry) []tradingConnector {
+	var out []tradingConnector
+	for _, conn := range connectors.GetAllReadyConnectors() {
+		if !conn.SupportsTradingOperations() {
+			continue
+		}
+		executor, ok := conn.(connector.OrderExecutor)
+		if !ok {
+			continue
+		}
+
+		tc := tradingConnector{executor: executor}
+		if info := conn.GetConnectorInfo(); info != nil {
+			tc.name = info.Name
+		}
+		if p, ok := conn.(perp.Connector); ok {
+			tc.perp = p
+		}
+		out = append(out, tc)
+	}
+	return out
+}
+
+func (d *drainer) cancelOrders(conn tradingConnector, result *live.StopResult) {
+	orders, err := conn.executor.GetOpenOrders()
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("%s: failed to list open orders: %v", conn.name, err))
+		return
+	}
+
+	for _, order := range orders {
+		if _, err := conn.executor.CancelOrder(order.Symbol, order.ID); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: failed to cancel order %s: %v", conn.name, order.ID, err))
+			continue
+		}
+		result.CancelledOrders++
+	}
+}
+
+func (d *drainer) closePositions(conn tradingConnector, result *live.StopResult) {
+	if conn.perp == nil {
+		return
+	}
+
+	positions, err := conn.perp.GetPositions()
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("%s: failed to list positions: %v", conn.name, err))
+		return
+	}
+
+	for _, position := range positions {
+		if position.Size.IsZero() {
+			continue
+		}
+
+		side := closingSide(position)
+		symbol := conn.perp.GetPerpSymbol(position.Symbol)
+		if _, err := conn.executor.PlaceMarketOrder(symbol, side, position.Size.Abs()); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: failed to close %s: %v", conn.name, symbol, err))
+			continue
+		}
+		result.ClosedPositions++
+	}
+}
+
+// exposure returns the open order count and non-zero positions across all connectors,
+// and whether every connector could be queried
+func (d *drainer) exposure(connectors []tradingConnector) *live.Exposure {
+	exposure := &live.Exposure{Complete: true}
+
+	for _, conn := range connectors {
+		if orders, err := conn.executor.GetOpenOrders(); err == nil {
+			exposure.OpenOrders += len(orders)
+		} else {
+			exposure.Complete = false
+		}
+
+		if conn.perp == nil {
+			continue
+		}
+		if current, err := conn.perp.GetPositions(); err == nil {
+			for _, position := range current {
+				if !position.Size.IsZero() {
+					exposure.Positions = append(exposure.Positions, position)
+				}
+			}
+		} else {
+			exposure.Complete = false
+		}
+	}
+
+	return exposure
+}
+
+func drained(mode live.StopMode, exposure *live.Exposure) bool {
+	switch mode {
+	case live.StopModeCancelOrders:
+		return exposure.OpenOrders == 0
+	case live.StopModeFlatten:
+		return exposure.OpenOrders == 0 && len(exposure.Positions) == 0
+	default:
+		return true
+	}
+}
+
+// closingSide returns the order side that reduces a position to zero
+func closingSide(position connector.Position) connector.OrderSide {
+	switch position.Side {
+	case connector.OrderSideBuy:
+		return connector.OrderSideSell
+	case connector.OrderSideSell:
+		return connector.OrderSideBuy
+	}
+	if position.Size.IsNegative() {
+		return connector.OrderSideBuy
+	}
+	return connector.OrderSideSell
+}
+
+// sleepCtx waits for d and reports false if ctx ended first
+func sleepCtx(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -8,5 +8,9 @@ import (
 var Module = fx.Module("startup",
 	fx.Provide(
 		NewRuntime,
+		NewDrainer,
+		NewActionExecutor,
+		NewOrderSource,
+		NewSignalJournal,
 	),
 )
@@ -0,0 +1,93 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+)
+
+type orderSource struct {
+	connectors registry.ConnectorRegistry
+	views      monitoring.ViewRegistry
+}
+
+// NewOrderSource creates an OrderSource that lists the open orders of the strategy's ready connectors
+func NewOrderSource(connectors registry.ConnectorRegistry, views monitoring.ViewRegistry) live.OrderSource {
+	return &orderSource{
+		connectors: connectors,
+		views:      views,
+	}
+}
+
+func (s *orderSource) OpenOrders(ctx context.Context) *live.OpenOrders {
+	result := &live.OpenOrders{Orders: []live.WorkingOrder{}}
+	assets := s.views.GetAvailableAssets()
+	mids := make(map[string]numerical.Decimal)
+
+	for _, conn := range tradingConnectors(s.connectors) {
+		if ctx.Err() != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: stopped listing open orders: %v", conn.name, ctx.Err()))
+			continue
+		}
+
+		orders, err := conn.executor.GetOpenOrders()
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: failed to list open orders: %v", conn.name, err))
+			continue
+		}
+
+		for _, order := range orders {
+			asset := conn.asset(order.Symbol, assets)
+			mid, ok := mids[asset]
+			if !ok {
+				mid = midPrice(s.views.GetOrderbookView(asset))
+				mids[asset] = mid
+			}
+			result.Orders = append(result.Orders, live.WorkingOrder{
+				Order:    order,
+				Exchange: string(conn.name),
+				Asset:    asset,
+				Mid:      mid,
+			})
+		}
+	}
+
+	sort.SliceStable(result.Orders, func(i, j int) bool {
+		a, b := result.Orders[i], result.Orders[j]
+		if a.Exchange != b.Exchange {
+			return a.Exchange < b.Exchange
+		}
+		if a.Asset != b.Asset {
+			return a.Asset < b.Asset
+		}
+		return a.CreatedAt.Before(b.CreatedAt)
+	})
+	result.Time = time.Now()
+
+	return result
+}
+
+// asset finds which of the strategy's assets on this connector an exchange symbol stands for; the symbol itself if none
+func (c tradingConnector) asset(symbol string, assets []monitoring.AssetExchange) string {
+	for _, a := range assets {
+		if a.Exchange == string(c.name) && c.trades(symbol, a.Asset) {
+			return a.Asset
+		}
+	}
+	return symbol
+}
+
+// midPrice is halfway between the best bid and ask; zero when either side is empty
+func midPrice(book *connector.OrderBook) numerical.Decimal {
+	if book == nil || len(book.Bids) == 0 || len(book.Asks) == 0 {
+		return numerical.Zero()
+	}
+	return book.Bids[0].Price.Add(book.Asks[0].Price).Div(numerical.NewFromInt(2))
+}
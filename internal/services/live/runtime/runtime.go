@@ -1,14 +1,23 @@
 package runtime
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
+	"time"
 
+	"github.com/backtesting-org/kronos-cli/internal/services/live/control"
+	monitoringService "github.com/backtesting-org/kronos-cli/internal/services/monitoring"
 	"github.com/backtesting-org/kronos-cli/pkg/live"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/config"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/plugin"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/runtime"
 )
 
@@ -16,48 +25,327 @@ type liveRuntime struct {
 	logger       logging.ApplicationLogger
 	runtime      runtime.Runtime
 	configLoader config.StartupConfigLoader
+	plugins      plugin.Manager
+	drainer      live.Drainer
+	actions      live.ActionExecutor
+	orders       live.OrderSource
+	signals      live.SignalJournal
+	events       live.EventBus
+	views        monitoring.ViewRegistry
+	recorder     live.SeriesRecorder
+	stopDefaults live.StopOptions
+}
+
+// stopRequest is a stop issued over the control socket; the result is sent back on reply
+type stopRequest struct {
+	opts  live.StopOptions
+	reply chan *live.StopResult
+}
+
+// promoteRequest asks a standby instance to start trading; the exposure it took over is sent back
+type promoteRequest struct {
+	reply chan *live.Exposure
 }
 
 func NewRuntime(
 	logger logging.ApplicationLogger,
 	runtime runtime.Runtime,
 	configLoader config.StartupConfigLoader,
+	plugins plugin.Manager,
+	drainer live.Drainer,
+	actions live.ActionExecutor,
+	orders live.OrderSource,
+	signals live.SignalJournal,
+	events live.EventBus,
+	views monitoring.ViewRegistry,
+	recorder live.SeriesRecorder,
+	cfg *live.SupervisorConfig,
 ) live.Runtime {
 	return &liveRuntime{
 		logger:       logger,
 		runtime:      runtime,
 		configLoader: configLoader,
+		plugins:      plugins,
+		drainer:      drainer,
+		actions:      actions,
+		orders:       orders,
+		signals:      signals,
+		events:       events,
+		views:        views,
+		recorder:     recorder,
+		stopDefaults: cfg.Stop,
 	}
 }
 
 func (r *liveRuntime) Run(strategyDir string) error {
-	kronosPath := "kronos.yml"
+	return r.run(strategyDir, false)
+}
+
+func (r *liveRuntime) RunStandby(strategyDir string) error {
+	return r.run(strategyDir, true)
+}
+
+func (r *liveRuntime) CheckPlugin(strategyDir string) error {
+	cfg, _, _, err := r.loadConfig(strategyDir)
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.plugins.LoadStrategyPlugin(cfg.PluginPath); err != nil {
+		return fmt.Errorf("failed to load strategy plugin: %w", err)
+	}
+	return nil
+}
+
+// loadConfig loads the strategy's startup config; kronos.yml lives in the project root, two levels above strategies/<name>
+func (r *liveRuntime) loadConfig(strategyDir string) (*config.StartupConfig, string, string, error) {
+	projectDir := filepath.Dir(filepath.Dir(strategyDir))
+	kronosPath := filepath.Join(projectDir, "kronos.yml")
 	cfg, err := r.configLoader.LoadForStrategy(strategyDir, kronosPath)
 	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+		return nil, "", "", fmt.Errorf("failed to load config: %w", err)
+	}
+	return cfg, projectDir, kronosPath, nil
+}
+
+func (r *liveRuntime) run(strategyDir string, standby bool) error {
+	cfg, projectDir, kronosPath, err := r.loadConfig(strategyDir)
+	if err != nil {
+		return err
 	}
 
 	r.logger.Info("Config loaded", "strategy", cfg.Strategy.Name)
 
+	// The control socket is named after the strategy directory, which is what the supervisor knows it by
+	strategyName := filepath.Base(strategyDir)
+
+	// A standby connects and warms up like any instance, but its signals are held back until it takes over,
+	// so the instance it replaces can be retired the moment the standby is ready
+	if standby {
+		r.actions.Execute(context.Background(), live.TradingAction{Type: live.ActionPause})
+	}
+
 	err = r.runtime.Start(strategyDir, kronosPath)
 	if err != nil {
 		return fmt.Errorf("failed to start: %w", err)
 	}
 
 	r.logger.Info("SDK startup complete")
+
+	var promote func(*live.Exposure)
+	if standby {
+		promote, err = r.awaitPromotion(strategyName)
+		if err != nil || promote == nil {
+			// Stopped before it was promoted - it never traded, so there is nothing to drain
+			if stopErr := r.runtime.Stop(); stopErr != nil {
+				r.logger.Error("Failed to stop strategy", "error", stopErr)
+			}
+			if err == nil {
+				r.logger.Info("Standby instance stopped")
+			}
+			return err
+		}
+		r.actions.Execute(context.Background(), live.TradingAction{Type: live.ActionResume})
+	}
+
+	stopRequests := make(chan stopRequest)
+
+	server := control.NewServer(control.DefaultSocketDir(), strategyName)
+	server.Handle("/stop", r.handleStop(stopRequests))
+	server.Handle(control.ActionsPath, control.NewActionsHandler(r.actions))
+	server.Handle(control.PausePath, control.NewPauseHandler(r.actions))
+	server.Handle(monitoringService.OrdersPath, monitoringService.NewOrdersHandler(r.orders))
+	server.Handle(monitoringService.SignalsPath, monitoringService.NewSignalsHandler(r.signals))
+	server.Handle(monitoringService.StreamPath, monitoringService.NewStreamHandler(r.views, monitoringService.DefaultStreamInterval))
+	if err := server.Start(); err != nil {
+		r.logger.Warn("Control socket unavailable, the strategy can only be stopped with signals and won't stream updates, list orders and signals or take trading actions", "error", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Stop(ctx)
+	}()
+
+	// Sample PnL, positions and latency for the monitor's charts and kronos analyze until the strategy stops
+	recordCtx, stopRecording := context.WithCancel(context.Background())
+	recorded := make(chan struct{})
+	go func() {
+		defer close(recorded)
+		r.recorder.Record(recordCtx, strategyName, os.Getenv(live.InstanceIDEnv), r.views)
+	}()
+
+	// Tell the supervisor what we took over from the instance we replace
+	if promote != nil {
+		exposure := r.drainer.Exposure()
+		r.logger.Info("Took over from previous instance",
+			"open_orders", exposure.OpenOrders,
+			"open_positions", len(exposure.Positions),
+		)
+		promote(exposure)
+	}
+
 	r.logger.Info("Strategy running, keeping process alive...")
 
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
 
-	sig := <-sigChan
-	r.logger.Info("Received shutdown signal", "signal", sig)
+	var req stopRequest
+	select {
+	case sig := <-sigChan:
+		r.logger.Info("Received shutdown signal", "signal", sig)
+		req = stopRequest{opts: r.stopDefaults}
+	case req = <-stopRequests:
+		r.logger.Info("Received stop request", "mode", req.opts.Mode, "drain_timeout", req.opts.DrainTimeout)
+	}
+
+	stopRecording()
+	<-recorded
 
-	r.logger.Info("Stopping strategy...")
-	if err := r.runtime.Stop(); err != nil {
-		r.logger.Error("Failed to stop strategy", "error", err)
+	result := r.stop(strategyName, req.opts)
+	if err := control.SaveResult(projectDir, result); err != nil {
+		r.logger.Warn("Failed to record stop result", "error", err)
+	}
+	r.events.Publish(live.Event{
+		Type:         live.EventDrained,
+		Actor:        live.ActorDaemon,
+		StrategyName: strategyName,
+		InstanceID:   os.Getenv(live.InstanceIDEnv),
+		Reason:       drainSummary(result),
+	})
+	if req.reply != nil {
+		req.reply <- result
 	}
 
 	r.logger.Info("Shutdown complete")
 	return nil
 }
+
+// awaitPromotion opens the standby control socket, which tells the supervisor the started strategy is ready to
+// take over, and waits until it is promoted. The returned func answers the promotion and closes the standby socket;
+// it is nil if the process is signalled first.
+func (r *liveRuntime) awaitPromotion(strategyName string) (func(*live.Exposure), error) {
+	promotions := make(chan promoteRequest)
+	server := control.NewServer(control.DefaultSocketDir(), control.StandbyName(strategyName))
+	server.Handle("/promote", handlePromote(promotions))
+	if err := server.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start standby control socket: %w", err)
+	}
+	closeServer := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Stop(ctx)
+	}
+
+	r.logger.Info("Standby ready, waiting to be promoted")
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigChan)
+
+	select {
+	case sig := <-sigChan:
+		r.logger.Info("Received shutdown signal while on standby", "signal", sig)
+		closeServer()
+		return nil, nil
+	case req := <-promotions:
+		r.logger.Info("Promoted, taking over")
+		return func(exposure *live.Exposure) {
+			req.reply <- exposure
+			closeServer()
+		}, nil
+	}
+}
+
+// handlePromote accepts POST /promote and replies with the exposure taken over once the strategy is trading
+func handlePromote(requests chan<- promoteRequest) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		reply := make(chan *live.Exposure, 1)
+		select {
+		case requests <- promoteRequest{reply: reply}:
+		default:
+			http.Error(w, "instance is already being promoted", http.StatusConflict)
+			return
+		}
+
+		select {
+		case exposure := <-reply:
+			control.WriteJSON(w, exposure)
+		case <-req.Context().Done():
+		}
+	}
+}
+
+// stop halts signal generation, then drains according to the stop mode and snapshots the final state
+func (r *liveRuntime) stop(strategyName string, opts live.StopOptions) *live.StopResult {
+	started := time.Now()
+
+	// Stop the strategy first so it can't place new orders while we drain
+	r.logger.Info("Stopping strategy...")
+	stopErr := r.runtime.Stop()
+	if stopErr != nil {
+		r.logger.Error("Failed to stop strategy", "error", stopErr)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), opts.DrainTimeout)
+	defer cancel()
+
+	result := r.drainer.Drain(ctx, opts.Mode)
+	result.StrategyName = strategyName
+	result.Duration = time.Since(started)
+	if stopErr != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("failed to stop strategy: %v", stopErr))
+	}
+
+	return result
+}
+
+// drainSummary describes what a stop did for the event log
+func drainSummary(result *live.StopResult) string {
+	summary := fmt.Sprintf("%s stop: cancelled %d orders, closed %d positions",
+		result.Mode, result.CancelledOrders, result.ClosedPositions)
+	if len(result.Errors) > 0 {
+		summary += fmt.Sprintf(", %d errors", len(result.Errors))
+	}
+	return summary
+}
+
+// handleStop accepts POST /stop with StopOptions and replies once the strategy has drained
+func (r *liveRuntime) handleStop(requests chan<- stopRequest) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		opts := r.stopDefaults
+		if err := json.NewDecoder(req.Body).Decode(&opts); err != nil {
+			http.Error(w, fmt.Sprintf("invalid stop request: %v", err), http.StatusBadRequest)
+			return
+		}
+		if opts.Mode == "" {
+			opts.Mode = r.stopDefaults.Mode
+		}
+		if opts.DrainTimeout <= 0 {
+			opts.DrainTimeout = r.stopDefaults.DrainTimeout
+		}
+
+		reply := make(chan *live.StopResult, 1)
+		select {
+		case requests <- stopRequest{opts: opts, reply: reply}:
+		default:
+			http.Error(w, "strategy is already stopping", http.StatusConflict)
+			return
+		}
+
+		select {
+		case result := <-reply:
+			control.WriteJSON(w, result)
+		case <-req.Context().Done():
+		}
+	}
+}
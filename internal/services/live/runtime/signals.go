@@ -0,0 +1,204 @@
+package runtime
+
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/data/stores/activity"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+)
+
+// signalJournalSize is how many signals the journal keeps; older ones are dropped
+const signalJournalSize = 1000
+
+type signalJournal struct {
+	mu      sync.Mutex
+	records []live.SignalRecord // ring buffer, next is where the next record goes
+	next    int
+	full    bool
+}
+
+// NewSignalJournal creates a SignalJournal. RecordSignals feeds it every signal the executor is given.
+func NewSignalJournal() live.SignalJournal {
+	return &signalJournal{records: make([]live.SignalRecord, signalJournalSize)}
+}
+
+// RecordSignals decorates the hook registry so the executor reports every signal to the journal, with the order IDs
+// placed for it and the error that stopped it. The recorder always runs after every other hook: once it sees a signal,
+// no hook can block it any more and the executor is about to act on it.
+func RecordSignals(hooks registry.Hooks, journal live.SignalJournal, positions activity.Positions) registry.Hooks {
+	j, ok := journal.(*signalJournal)
+	if !ok {
+		return hooks
+	}
+	return &recordingHooks{
+		Hooks: hooks,
+		recorder: &signalRecorder{
+			journal:   j,
+			positions: positions,
+			executing: make(map[*execution.ExecutionContext]int),
+		},
+	}
+}
+
+func (j *signalJournal) Recent(limit int) []live.SignalRecord {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	count := j.next
+	if j.full {
+		count = len(j.records)
+	}
+	if limit <= 0 || limit > count {
+		limit = count
+	}
+
+	out := make([]live.SignalRecord, 0, limit)
+	for i := count - limit; i < count; i++ {
+		index := i
+		if j.full {
+			index = (j.next + i) % len(j.records)
+		}
+		out = append(out, j.records[index])
+	}
+	return out
+}
+
+func (j *signalJournal) add(record live.SignalRecord) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.records[j.next] = record
+	j.next = (j.next + 1) % len(j.records)
+	if j.next == 0 {
+		j.full = true
+	}
+}
+
+// recordingHooks appends the signal recorder to the hooks the executor runs
+type recordingHooks struct {
+	registry.Hooks
+	recorder *signalRecorder
+}
+
+func (h *recordingHooks) GetHooks() []execution.ExecutionHook {
+	return append(h.Hooks.GetHooks(), h.recorder)
+}
+
+// signalRecorder journals signals as the executor reports on them. The executor calls every hook's OnError
+// when a hook blocks a signal or an action fails, and AfterExecute once all actions are placed,
+// so each signal is recorded exactly once.
+type signalRecorder struct {
+	journal   *signalJournal
+	positions activity.Positions
+
+	mu sync.Mutex
+	// executing holds the signals every hook let through, with how many orders the strategy had when their actions began
+	executing map[*execution.ExecutionContext]int
+}
+
+func (r *signalRecorder) BeforeExecute(ctx *execution.ExecutionContext) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.executing[ctx] = len(r.orders(ctx))
+	return nil
+}
+
+func (r *signalRecorder) AfterExecute(ctx *execution.ExecutionContext, result *execution.ExecutionResult) error {
+	r.finish(ctx)
+
+	record := newSignalRecord(ctx)
+	record.Outcome = live.SignalExecuted
+	if result != nil {
+		record.OrderIDs = result.OrderIDs
+	}
+	r.journal.add(record)
+	return nil
+}
+
+func (r *signalRecorder) OnError(ctx *execution.ExecutionContext, err error) error {
+	placed, executing := r.finish(ctx)
+
+	record := newSignalRecord(ctx)
+	record.Reason = err.Error()
+	switch {
+	case !executing && errors.Is(err, errPaused):
+		record.Outcome = live.SignalPaused
+	case !executing:
+		// The error arrived before the recorder, so a hook blocked the signal before any action ran
+		record.Outcome = live.SignalRejected
+	default:
+		record.Outcome = actionFailure(err)
+		// Actions before the failing one may already have placed orders, which are still working on the exchange
+		record.OrderIDs = placed
+	}
+	r.journal.add(record)
+	return nil
+}
+
+// finish stops tracking the signal, returning the orders its actions placed and whether they had begun
+func (r *signalRecorder) finish(ctx *execution.ExecutionContext) ([]string, bool) {
+	r.mu.Lock()
+	before, ok := r.executing[ctx]
+	delete(r.executing, ctx)
+	r.mu.Unlock()
+
+	if !ok {
+		return nil, false
+	}
+
+	var placed []string
+	orders := r.orders(ctx)
+	if before < len(orders) {
+		for _, order := range orders[before:] {
+			if order.ID != "" {
+				placed = append(placed, order.ID)
+			}
+		}
+	}
+	return placed, true
+}
+
+// orders lists the orders recorded for the signal's strategy; the executor adds each one as soon as it is placed
+func (r *signalRecorder) orders(ctx *execution.ExecutionContext) []connector.Order {
+	if r.positions == nil || ctx.Signal == nil {
+		return nil
+	}
+	exec := r.positions.GetStrategyExecution(ctx.Signal.Strategy)
+	if exec == nil {
+		return nil
+	}
+	return exec.Orders
+}
+
+func newSignalRecord(ctx *execution.ExecutionContext) live.SignalRecord {
+	record := live.SignalRecord{Time: ctx.Timestamp}
+	if ctx.Signal == nil {
+		return record
+	}
+
+	record.ID = ctx.Signal.ID.String()
+	for _, action := range ctx.Signal.Actions {
+		record.Actions = append(record.Actions, live.SignalAction{
+			Action:   action.Action,
+			Exchange: string(action.Exchange),
+			Asset:    action.Asset.Symbol(),
+			Quantity: action.Quantity,
+			Price:    action.Price,
+		})
+	}
+	return record
+}
+
+// actionFailure tells why one of a signal's actions failed. Exchanges only describe a lack of funds in their message,
+// so that is the one thing read from it.
+func actionFailure(err error) live.SignalOutcome {
+	if strings.Contains(strings.ToLower(err.Error()), "insufficient") {
+		return live.SignalInsufficientBalance
+	}
+	return live.SignalExchangeError
+}
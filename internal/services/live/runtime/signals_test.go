@@ -0,0 +1,157 @@
+package runtime_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/data/stores/activity/position"
+	"github.com/backtesting-org/kronos-sdk/pkg/executor"
+	"github.com/backtesting-org/kronos-sdk/pkg/registry"
+	timeprovider "github.com/backtesting-org/kronos-sdk/pkg/runtime/time"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	sdkregistry "github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	perpmocks "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/connector/perp"
+	registrymocks "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/runtime"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+// blockingHook stands in for a risk check loaded from a strategy plugin, registered after the journal
+type blockingHook struct {
+	err error
+}
+
+func (h *blockingHook) BeforeExecute(_ *execution.ExecutionContext) error {
+	return h.err
+}
+
+func (h *blockingHook) AfterExecute(_ *execution.ExecutionContext, _ *execution.ExecutionResult) error {
+	return nil
+}
+
+func (h *blockingHook) OnError(_ *execution.ExecutionContext, _ error) error {
+	return nil
+}
+
+var _ = Describe("SignalJournal", func() {
+	var (
+		journal    live.SignalJournal
+		hooks      sdkregistry.Hooks
+		connectors *registrymocks.ConnectorRegistry
+		paradex    *perpmocks.Connector
+		actions    live.ActionExecutor
+		exec       execution.Executor
+	)
+
+	signal := func(assets ...string) *strategy.Signal {
+		s := &strategy.Signal{ID: uuid.New(), Strategy: "momentum"}
+		for _, asset := range assets {
+			s.Actions = append(s.Actions, strategy.TradeAction{
+				Action:   strategy.ActionBuy,
+				Asset:    portfolio.NewAsset(asset),
+				Exchange: "paradex",
+				Quantity: numerical.NewFromInt(1),
+				Price:    numerical.NewFromInt(100),
+			})
+		}
+		return s
+	}
+
+	placeOrder := func(symbol string, response *connector.OrderResponse, err error) {
+		paradex.EXPECT().PlaceLimitOrder(symbol, connector.OrderSideBuy, mock.Anything, mock.Anything).Return(response, err).Once()
+	}
+
+	last := func() live.SignalRecord {
+		records := journal.Recent(0)
+		Expect(records).To(HaveLen(1))
+		return records[0]
+	}
+
+	BeforeEach(func() {
+		logger := &logging.NoOpLogger{}
+		timeProvider := timeprovider.NewTimeProvider()
+
+		connectors = registrymocks.NewConnectorRegistry(GinkgoT())
+		paradex = perpmocks.NewConnector(GinkgoT())
+		connectors.EXPECT().GetConnector(connector.ExchangeName("paradex")).Return(paradex, true).Maybe()
+
+		journal = runtime.NewSignalJournal()
+		positions := position.NewStore(timeProvider)
+		decorated := runtime.RecordSignals(registry.NewHookRegistry(), journal, positions)
+		hooks = decorated
+		actions = runtime.NewActionExecutor(connectors, decorated, logger)
+		exec = executor.NewExecutor(connectors, positions, logger, timeProvider, decorated)
+	})
+
+	It("should record an executed signal with its orders", func() {
+		placeOrder("BTC", &connector.OrderResponse{OrderID: "o-1"}, nil)
+		placeOrder("ETH", &connector.OrderResponse{OrderID: "o-2"}, nil)
+
+		Expect(exec.ExecuteSignal(signal("BTC", "ETH"))).To(Succeed())
+
+		record := last()
+		Expect(record.Outcome).To(Equal(live.SignalExecuted))
+		Expect(record.OrderIDs).To(Equal([]string{"o-1", "o-2"}))
+	})
+
+	It("should keep the orders placed before an action failed", func() {
+		placeOrder("BTC", &connector.OrderResponse{OrderID: "o-1"}, nil)
+		placeOrder("ETH", nil, errors.New("rate limited"))
+
+		Expect(exec.ExecuteSignal(signal("BTC", "ETH", "SOL"))).NotTo(Succeed())
+
+		record := last()
+		Expect(record.Outcome).To(Equal(live.SignalExchangeError))
+		Expect(record.OrderIDs).To(Equal([]string{"o-1"}))
+		Expect(record.Reason).To(ContainSubstring("rate limited"))
+	})
+
+	It("should report an exchange rejecting an order for lack of funds", func() {
+		placeOrder("BTC", nil, errors.New("Insufficient margin"))
+
+		Expect(exec.ExecuteSignal(signal("BTC"))).NotTo(Succeed())
+
+		record := last()
+		Expect(record.Outcome).To(Equal(live.SignalInsufficientBalance))
+		Expect(record.OrderIDs).To(BeEmpty())
+	})
+
+	It("should report an action on an unavailable exchange as an exchange error", func() {
+		s := signal("BTC")
+		s.Actions[0].Exchange = "hyperliquid"
+		connectors.EXPECT().GetConnector(connector.ExchangeName("hyperliquid")).Return(nil, false)
+
+		Expect(exec.ExecuteSignal(s)).NotTo(Succeed())
+		Expect(last().Outcome).To(Equal(live.SignalExchangeError))
+	})
+
+	It("should report a signal blocked by a hook registered after the journal as rejected", func() {
+		// Whatever the hook says, no action ran
+		hooks.RegisterHook(&blockingHook{err: errors.New("failed to place order: exposure limit reached")})
+
+		Expect(exec.ExecuteSignal(signal("BTC"))).NotTo(Succeed())
+
+		record := last()
+		Expect(record.Outcome).To(Equal(live.SignalRejected))
+		Expect(record.OrderIDs).To(BeEmpty())
+		Expect(record.Reason).To(ContainSubstring("exposure limit reached"))
+	})
+
+	It("should report signals held back while paused", func() {
+		actions.Execute(context.Background(), live.TradingAction{Type: live.ActionPause})
+
+		Expect(exec.ExecuteSignal(signal("BTC"))).NotTo(Succeed())
+		Expect(last().Outcome).To(Equal(live.SignalPaused))
+	})
+})
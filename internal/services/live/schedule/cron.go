@@ -0,0 +1,179 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cron is a parsed five-field cron expression: minute, hour, day of month, month and day of week.
+// Fields accept *, numbers, ranges (1-5), lists (1,3,5) and steps (*/15, 9-17/2); months and days of week
+// also accept names (jan, mon). Sunday is 0 or 7. @hourly, @daily, @weekly and @monthly are shorthands.
+type Cron struct {
+	minute, hour, dom, month, dow uint64
+
+	// Like cron(8), when both day fields are restricted a day matching either one fires.
+	// A field starting with * (*, */2) isn't a restriction, so it narrows the other one instead.
+	domAny, dowAny bool
+}
+
+var cronMacros = map[string]string{
+	"@hourly":   "0 * * * *",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@weekly":   "0 0 * * 0",
+	"@monthly":  "0 0 1 * *",
+}
+
+var monthNames = map[string]int{
+	"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+	"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+}
+
+var dayNames = map[string]int{
+	"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+}
+
+// cronSearchYears bounds how far ahead Next looks for a match, so impossible dates like Feb 30 end the search
+const cronSearchYears = 5
+
+// ParseCron parses a cron expression
+func ParseCron(expr string) (*Cron, error) {
+	spec := strings.TrimSpace(expr)
+	if macro, ok := cronMacros[strings.ToLower(spec)]; ok {
+		spec = macro
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron expression %q: expected 5 fields (minute hour day-of-month month day-of-week), got %d", expr, len(fields))
+	}
+
+	c := &Cron{}
+	var err error
+	if c.minute, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: minute: %w", expr, err)
+	}
+	if c.hour, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: hour: %w", expr, err)
+	}
+	if c.dom, err = parseCronField(fields[2], 1, 31, nil); err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: day of month: %w", expr, err)
+	}
+	if c.month, err = parseCronField(fields[3], 1, 12, monthNames); err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: month: %w", expr, err)
+	}
+	if c.dow, err = parseCronField(fields[4], 0, 7, dayNames); err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: day of week: %w", expr, err)
+	}
+
+	// 7 is another name for Sunday
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1
+	}
+	c.domAny = strings.HasPrefix(fields[2], "*")
+	c.dowAny = strings.HasPrefix(fields[4], "*")
+
+	return c, nil
+}
+
+// parseCronField turns one field into a bitset of the values it matches
+func parseCronField(field string, min, max int, names map[string]int) (uint64, error) {
+	var bits uint64
+
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepPart)
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step %q", stepPart)
+			}
+			step = n
+		}
+
+		lo, hi := min, max
+		if rangePart != "*" {
+			first, last, isRange := strings.Cut(rangePart, "-")
+
+			var err error
+			if lo, err = parseCronValue(first, min, max, names); err != nil {
+				return 0, err
+			}
+			hi = lo
+			if isRange {
+				if hi, err = parseCronValue(last, min, max, names); err != nil {
+					return 0, err
+				}
+			} else if hasStep {
+				// "5/15" means from 5 to the end in steps of 15
+				hi = max
+			}
+			if hi < lo {
+				return 0, fmt.Errorf("invalid range %q", rangePart)
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+
+	return bits, nil
+}
+
+func parseCronValue(s string, min, max int, names map[string]int) (int, error) {
+	if v, ok := names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	if v < min || v > max {
+		return 0, fmt.Errorf("value %d out of range %d-%d", v, min, max)
+	}
+	return v, nil
+}
+
+// Next returns the first time after t that matches, in t's location, or the zero time if there is none
+func (c *Cron) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(cronSearchYears, 0, 0)
+
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !c.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (c *Cron) dayMatches(t time.Time) bool {
+	domMatch := c.dom&(1<<uint(t.Day())) != 0
+	dowMatch := c.dow&(1<<uint(t.Weekday())) != 0
+
+	if c.domAny || c.dowAny {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
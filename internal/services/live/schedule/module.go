@@ -0,0 +1,10 @@
+package schedule
+
+import "go.uber.org/fx"
+
+// Module provides the strategy scheduler via Fx
+var Module = fx.Module("live/schedule",
+	fx.Provide(
+		NewScheduler,
+	),
+)
@@ -0,0 +1,161 @@
+package schedule
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+const (
+	// maxLookback bounds how far back Last searches for the transition that decides the current state
+	maxLookback = 400 * 24 * time.Hour
+
+	// maxNextSteps bounds how many firings Next skips that don't change the state
+	maxNextSteps = 1000
+)
+
+// Plan is a compiled Schedule
+type Plan struct {
+	loc   *time.Location
+	rules []planRule
+}
+
+type planRule struct {
+	start *Cron
+	stop  *Cron
+}
+
+// Compile parses a schedule's timezone and rules
+func Compile(schedule *live.Schedule) (*Plan, error) {
+	loc := time.UTC
+	if schedule.Timezone != "" {
+		var err error
+		if loc, err = time.LoadLocation(schedule.Timezone); err != nil {
+			return nil, fmt.Errorf("invalid timezone %q: %w", schedule.Timezone, err)
+		}
+	}
+
+	if len(schedule.Rules) == 0 {
+		return nil, fmt.Errorf("schedule has no rules")
+	}
+
+	plan := &Plan{loc: loc}
+	for i, rule := range schedule.Rules {
+		if rule.Start == "" || rule.Stop == "" {
+			return nil, fmt.Errorf("rule %d: both start and stop are required", i+1)
+		}
+
+		start, err := ParseCron(rule.Start)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: start: %w", i+1, err)
+		}
+		stop, err := ParseCron(rule.Stop)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: stop: %w", i+1, err)
+		}
+		plan.rules = append(plan.rules, planRule{start: start, stop: stop})
+	}
+
+	return plan, nil
+}
+
+// Location is the timezone the plan is evaluated in
+func (p *Plan) Location() *time.Location {
+	return p.loc
+}
+
+// Open reports whether t falls inside a trading window
+func (p *Plan) Open(t time.Time) bool {
+	action, _, ok := p.Last(t)
+	return ok && action == live.ScheduleStart
+}
+
+// Last returns the latest start or stop firing at or before t. A start and a stop firing together
+// count as a start, so back-to-back windows don't stop the strategy in between.
+func (p *Plan) Last(t time.Time) (live.ScheduleAction, time.Time, bool) {
+	t = t.In(p.loc)
+
+	var (
+		action live.ScheduleAction
+		at     time.Time
+	)
+	consider := func(a live.ScheduleAction, fired time.Time) {
+		if fired.IsZero() {
+			return
+		}
+		if fired.After(at) || (fired.Equal(at) && a == live.ScheduleStart) {
+			action, at = a, fired
+		}
+	}
+
+	for _, rule := range p.rules {
+		consider(live.ScheduleStart, lastFiring(rule.start, t))
+		consider(live.ScheduleStop, lastFiring(rule.stop, t))
+	}
+
+	return action, at, !at.IsZero()
+}
+
+// Next returns the first transition after t that changes the state, or a zero time if there is none
+func (p *Plan) Next(t time.Time) (live.ScheduleAction, time.Time) {
+	open := p.Open(t)
+	cur := t.In(p.loc)
+
+	for i := 0; i < maxNextSteps; i++ {
+		var (
+			at    time.Time
+			start bool
+		)
+		for _, rule := range p.rules {
+			for _, next := range []struct {
+				fired   time.Time
+				isStart bool
+			}{
+				{rule.start.Next(cur), true},
+				{rule.stop.Next(cur), false},
+			} {
+				if next.fired.IsZero() {
+					continue
+				}
+				switch {
+				case at.IsZero() || next.fired.Before(at):
+					at, start = next.fired, next.isStart
+				case next.fired.Equal(at) && next.isStart:
+					start = true
+				}
+			}
+		}
+
+		if at.IsZero() {
+			return "", time.Time{}
+		}
+		if start != open {
+			if start {
+				return live.ScheduleStart, at
+			}
+			return live.ScheduleStop, at
+		}
+		cur = at
+	}
+
+	return "", time.Time{}
+}
+
+// lastFiring returns the latest time at or before t that c matches, widening the search window until
+// one is found or maxLookback is reached
+func lastFiring(c *Cron, t time.Time) time.Time {
+	for window := time.Hour; ; window *= 2 {
+		if window > maxLookback {
+			window = maxLookback
+		}
+
+		var last time.Time
+		for fired := c.Next(t.Add(-window)); !fired.IsZero() && !fired.After(t); fired = c.Next(fired) {
+			last = fired
+		}
+		if !last.IsZero() || window == maxLookback {
+			return last
+		}
+	}
+}
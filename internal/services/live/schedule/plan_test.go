@@ -0,0 +1,155 @@
+package schedule_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/schedule"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+var _ = Describe("Cron", func() {
+	// Monday 2026-03-02 10:30 UTC
+	monday := time.Date(2026, 3, 2, 10, 30, 0, 0, time.UTC)
+
+	It("should reject malformed expressions", func() {
+		for _, expr := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "5-1 * * * *", "*/0 * * * *", "* * * foo *"} {
+			_, err := schedule.ParseCron(expr)
+			Expect(err).To(HaveOccurred(), expr)
+		}
+	})
+
+	It("should find the next matching minute", func() {
+		c, err := schedule.ParseCron("*/15 * * * *")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Next(monday)).To(Equal(time.Date(2026, 3, 2, 10, 45, 0, 0, time.UTC)))
+	})
+
+	It("should accept day and month names", func() {
+		c, err := schedule.ParseCron("0 9 * * sat,sun")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Next(monday)).To(Equal(time.Date(2026, 3, 7, 9, 0, 0, 0, time.UTC)))
+
+		c, err = schedule.ParseCron("0 0 1 jun *")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Next(monday)).To(Equal(time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)))
+	})
+
+	It("should treat 7 as Sunday", func() {
+		c, err := schedule.ParseCron("0 12 * * 7")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Next(monday).Weekday()).To(Equal(time.Sunday))
+	})
+
+	It("should match either day field when both are restricted", func() {
+		// The 15th, or any Friday
+		c, err := schedule.ParseCron("0 0 15 * fri")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Next(monday)).To(Equal(time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC)))
+	})
+
+	It("should only narrow the other day field when one starts with *", func() {
+		// Mondays that fall on an odd day of the month
+		c, err := schedule.ParseCron("0 9 */2 * 1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Next(monday)).To(Equal(time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC)))
+
+		// The 15th, whatever the weekday
+		c, err = schedule.ParseCron("0 0 15 * */1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Next(monday)).To(Equal(time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)))
+	})
+
+	It("should expand shorthands", func() {
+		c, err := schedule.ParseCron("@daily")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Next(monday)).To(Equal(time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)))
+	})
+
+	It("should give up on dates that never occur", func() {
+		c, err := schedule.ParseCron("0 0 30 feb *")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Next(monday).IsZero()).To(BeTrue())
+	})
+})
+
+var _ = Describe("Plan", func() {
+	weekdays := &live.Schedule{
+		Timezone: "America/New_York",
+		Rules:    []live.ScheduleRule{{Start: "0 9 * * mon-fri", Stop: "0 17 * * mon-fri"}},
+	}
+
+	newYork, err := time.LoadLocation("America/New_York")
+	Expect(err).NotTo(HaveOccurred())
+
+	at := func(year int, month time.Month, day, hour, minute int) time.Time {
+		return time.Date(year, month, day, hour, minute, 0, 0, newYork)
+	}
+
+	It("should reject invalid schedules", func() {
+		_, err := schedule.Compile(&live.Schedule{Timezone: "Mars/Olympus", Rules: weekdays.Rules})
+		Expect(err).To(MatchError(ContainSubstring("invalid timezone")))
+
+		_, err = schedule.Compile(&live.Schedule{})
+		Expect(err).To(MatchError(ContainSubstring("no rules")))
+
+		_, err = schedule.Compile(&live.Schedule{Rules: []live.ScheduleRule{{Start: "0 9 * * *"}}})
+		Expect(err).To(MatchError(ContainSubstring("both start and stop")))
+	})
+
+	It("should evaluate rules in the schedule's timezone", func() {
+		plan, err := schedule.Compile(weekdays)
+		Expect(err).NotTo(HaveOccurred())
+
+		// 10:00 in New York is 15:00 UTC
+		Expect(plan.Open(at(2026, 3, 2, 10, 0).UTC())).To(BeTrue())
+		Expect(plan.Open(at(2026, 3, 2, 8, 59))).To(BeFalse())
+		Expect(plan.Open(at(2026, 3, 2, 17, 0))).To(BeFalse())
+	})
+
+	It("should stay closed over the weekend", func() {
+		plan, err := schedule.Compile(weekdays)
+		Expect(err).NotTo(HaveOccurred())
+
+		saturday := at(2026, 3, 7, 12, 0)
+		Expect(plan.Open(saturday)).To(BeFalse())
+
+		action, lastAt, ok := plan.Last(saturday)
+		Expect(ok).To(BeTrue())
+		Expect(action).To(Equal(live.ScheduleStop))
+		Expect(lastAt).To(BeTemporally("==", at(2026, 3, 6, 17, 0)))
+
+		action, next := plan.Next(saturday)
+		Expect(action).To(Equal(live.ScheduleStart))
+		Expect(next).To(BeTemporally("==", at(2026, 3, 9, 9, 0)))
+	})
+
+	It("should skip firings that don't change the state", func() {
+		plan, err := schedule.Compile(&live.Schedule{Rules: []live.ScheduleRule{
+			{Start: "0 8 * * *", Stop: "0 20 * * *"},
+			{Start: "0 12 * * *", Stop: "0 22 * * *"},
+		}})
+		Expect(err).NotTo(HaveOccurred())
+
+		// Open since 08:00; the 12:00 start changes nothing, the 20:00 stop closes the window
+		action, next := plan.Next(time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC))
+		Expect(action).To(Equal(live.ScheduleStop))
+		Expect(next).To(Equal(time.Date(2026, 3, 2, 20, 0, 0, 0, time.UTC)))
+	})
+
+	It("should keep back-to-back windows open across the boundary", func() {
+		plan, err := schedule.Compile(&live.Schedule{Rules: []live.ScheduleRule{
+			{Start: "0 8 * * *", Stop: "0 12 * * *"},
+			{Start: "0 12 * * *", Stop: "0 16 * * *"},
+		}})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(plan.Open(time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC))).To(BeTrue())
+
+		action, next := plan.Next(time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC))
+		Expect(action).To(Equal(live.ScheduleStop))
+		Expect(next).To(Equal(time.Date(2026, 3, 2, 16, 0, 0, 0, time.UTC)))
+	})
+})
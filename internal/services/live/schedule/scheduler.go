@@ -0,0 +1,259 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/manager"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-cli/pkg/strategy"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/config"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+)
+
+// pollInterval is how often schedules are checked; strategies start and stop within this of their transition
+const pollInterval = 30 * time.Second
+
+type scheduler struct {
+	manager    live.InstanceManager
+	controller live.InstanceController
+	querier    monitoring.ViewQuerier
+	compiler   strategy.CompileService
+	strategies config.StrategyConfig
+	logger     logging.ApplicationLogger
+	lockPath   string
+
+	mu     sync.Mutex
+	warned map[string]string // the schedule error last logged per strategy
+}
+
+// scheduled is a strategy with a schedule block
+type scheduled struct {
+	strategy config.Strategy
+	timezone string
+	plan     *Plan
+	err      error
+}
+
+// NewScheduler creates a Scheduler. Processes coordinate through ~/.kronos/scheduler.lock.
+func NewScheduler(
+	manager live.InstanceManager,
+	controller live.InstanceController,
+	querier monitoring.ViewQuerier,
+	compiler strategy.CompileService,
+	strategies config.StrategyConfig,
+	logger logging.ApplicationLogger,
+) (live.Scheduler, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get home directory: %w", err)
+	}
+
+	return &scheduler{
+		manager:    manager,
+		controller: controller,
+		querier:    querier,
+		compiler:   compiler,
+		strategies: strategies,
+		logger:     logger,
+		lockPath:   filepath.Join(homeDir, ".kronos", "scheduler.lock"),
+		warned:     make(map[string]string),
+	}, nil
+}
+
+// Run drives schedules while this process holds the scheduler lock, checking again every pollInterval
+func (s *scheduler) Run(ctx context.Context) error {
+	if err := os.MkdirAll(filepath.Dir(s.lockPath), 0755); err != nil {
+		return fmt.Errorf("failed to create kronos directory: %w", err)
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	var release func()
+	defer func() {
+		if release != nil {
+			release()
+		}
+	}()
+
+	// since is the previous pass; zero until this process drives schedules
+	var since time.Time
+	for {
+		if release == nil {
+			unlock, ok, err := manager.TryLockFile(s.lockPath)
+			if err != nil {
+				return err
+			}
+			if ok {
+				release = unlock
+				s.logger.Info("Driving strategy schedules")
+			}
+		}
+
+		if release != nil {
+			now := time.Now()
+			s.apply(ctx, since, now)
+			since = now
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// apply brings scheduled strategies in line with their schedules. The first pass applies the current state,
+// catching up on transitions missed while no kronos process drove schedules. Later passes only act on
+// transitions since the previous one, so a manual start or stop holds until the next transition.
+func (s *scheduler) apply(ctx context.Context, since, now time.Time) {
+	all, err := s.scheduled()
+	if err != nil {
+		s.logger.Warn("Failed to load strategy schedules", "error", err)
+		return
+	}
+
+	for _, sched := range all {
+		if sched.err != nil {
+			s.warnOnce(sched.strategy.Name, sched.err)
+			continue
+		}
+
+		action, at, ok := sched.plan.Last(now)
+		if !ok || (!since.IsZero() && !at.After(since)) {
+			continue
+		}
+		s.enforce(ctx, sched.strategy, action)
+	}
+}
+
+// enforce starts or stops a strategy unless it is already in that state
+func (s *scheduler) enforce(ctx context.Context, strat config.Strategy, action live.ScheduleAction) {
+	running := s.running(strat.Name)
+
+	switch {
+	case action == live.ScheduleStart && !running:
+		s.logger.Info("Trading window opened, starting strategy", "strategy", strat.Name)
+		if err := s.start(ctx, &strat); err != nil {
+			s.logger.Error("Scheduled start failed", "strategy", strat.Name, "error", err)
+		}
+
+	case action == live.ScheduleStop && running:
+		s.logger.Info("Trading window closed, stopping strategy", "strategy", strat.Name)
+		// A stop under way finishes even if the scheduler itself is stopping
+		if _, err := s.manager.StopStrategy(context.WithoutCancel(ctx), strat.Name, live.StopOptions{Actor: live.ActorScheduler}); err != nil {
+			s.logger.Error("Scheduled stop failed", "strategy", strat.Name, "error", err)
+		}
+	}
+}
+
+func (s *scheduler) start(ctx context.Context, strat *config.Strategy) error {
+	if err := s.compiler.CompileStrategy(strat.Path); err != nil {
+		return fmt.Errorf("failed to compile strategy: %w", err)
+	}
+
+	frameworkRoot, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get current directory: %w", err)
+	}
+
+	// The strategy outlives this pass and the scheduler itself; only the actor is carried over
+	_, err = s.manager.Start(live.WithActor(context.WithoutCancel(ctx), live.ActorScheduler), strat, frameworkRoot)
+	return err
+}
+
+// running reports whether any kronos process runs the strategy; instances started elsewhere only show up on their sockets
+func (s *scheduler) running(strategyName string) bool {
+	return s.controller.Available(strategyName) || s.querier.HealthCheck(strategyName) == nil
+}
+
+func (s *scheduler) warnOnce(strategyName string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.warned[strategyName] == err.Error() {
+		return
+	}
+	s.warned[strategyName] = err.Error()
+	s.logger.Warn("Ignoring invalid schedule", "strategy", strategyName, "error", err)
+}
+
+// Status reports each scheduled strategy's window and next transition
+func (s *scheduler) Status() ([]*live.ScheduleStatus, error) {
+	all, err := s.scheduled()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	statuses := make([]*live.ScheduleStatus, 0, len(all))
+	for _, sched := range all {
+		status := &live.ScheduleStatus{
+			StrategyName: sched.strategy.Name,
+			Timezone:     sched.timezone,
+		}
+		if sched.err != nil {
+			status.Error = sched.err.Error()
+		} else {
+			status.Open = sched.plan.Open(now)
+			status.NextAction, status.NextAt = sched.plan.Next(now)
+		}
+		statuses = append(statuses, status)
+	}
+
+	// Soonest transition first; schedules that never fire again or don't parse go last
+	sort.Slice(statuses, func(i, j int) bool {
+		a, b := statuses[i], statuses[j]
+		if a.NextAt.IsZero() != b.NextAt.IsZero() {
+			return !a.NextAt.IsZero()
+		}
+		if !a.NextAt.Equal(b.NextAt) {
+			return a.NextAt.Before(b.NextAt)
+		}
+		return a.StrategyName < b.StrategyName
+	})
+
+	return statuses, nil
+}
+
+// scheduled finds the project's strategies that have a schedule block
+func (s *scheduler) scheduled() ([]*scheduled, error) {
+	// Outside a project nothing is scheduled
+	if _, err := os.Stat("strategies"); os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	strategies, err := s.strategies.FindStrategies()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find strategies: %w", err)
+	}
+
+	var all []*scheduled
+	for _, strat := range strategies {
+		opts, err := manager.LoadStrategyOptions(strat.Path)
+		if err != nil {
+			all = append(all, &scheduled{strategy: strat, err: err})
+			continue
+		}
+		if opts.Schedule == nil {
+			continue
+		}
+
+		sched := &scheduled{strategy: strat, timezone: opts.Schedule.Timezone}
+		if sched.timezone == "" {
+			sched.timezone = "UTC"
+		}
+		sched.plan, sched.err = Compile(opts.Schedule)
+		all = append(all, sched)
+	}
+
+	return all, nil
+}
@@ -0,0 +1,82 @@
+package schedule_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+
+	configmocks "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/config"
+	monitoringmocks "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/config"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/schedule"
+	livemocks "github.com/backtesting-org/kronos-cli/mocks/github.com/backtesting-org/kronos-cli/pkg/live"
+	strategymocks "github.com/backtesting-org/kronos-cli/mocks/github.com/backtesting-org/kronos-cli/pkg/strategy"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+var _ = Describe("Scheduler", func() {
+	var (
+		manager   *livemocks.InstanceManager
+		scheduler live.Scheduler
+	)
+
+	BeforeEach(func() {
+		GinkgoT().Setenv("HOME", GinkgoT().TempDir())
+
+		// A project with one strategy whose window opened every minute and never closes
+		project := GinkgoT().TempDir()
+		strategyPath := filepath.Join(project, "strategies", "momentum")
+		Expect(os.MkdirAll(strategyPath, 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(strategyPath, "config.yml"), []byte(
+			"name: momentum\nschedule:\n  rules:\n    - start: \"* * * * *\"\n      stop: \"0 0 30 feb *\"\n"), 0644)).To(Succeed())
+
+		wd, err := os.Getwd()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(os.Chdir(project)).To(Succeed())
+		DeferCleanup(os.Chdir, wd)
+
+		strategies := configmocks.NewStrategyConfig(GinkgoT())
+		strategies.EXPECT().FindStrategies().Return([]config.Strategy{{Name: "momentum", Path: strategyPath}}, nil)
+
+		controller := livemocks.NewInstanceController(GinkgoT())
+		controller.EXPECT().Available("momentum").Return(false)
+		querier := monitoringmocks.NewViewQuerier(GinkgoT())
+		querier.EXPECT().HealthCheck("momentum").Return(errors.New("no socket"))
+		compiler := strategymocks.NewCompileService(GinkgoT())
+		compiler.EXPECT().CompileStrategy(strategyPath).Return(nil)
+
+		manager = livemocks.NewInstanceManager(GinkgoT())
+		scheduler, err = schedule.NewScheduler(manager, controller, querier, compiler, strategies, &logging.NoOpLogger{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should leave the strategies it started running when it stops", func() {
+		started := make(chan context.Context, 1)
+		manager.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, _ *config.Strategy, _ string) (*live.Instance, error) {
+				started <- ctx
+				return &live.Instance{ID: "a", StrategyName: "momentum", Status: live.StatusRunning}, nil
+			})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+		go func() {
+			done <- scheduler.Run(ctx)
+		}()
+
+		var startCtx context.Context
+		Eventually(started).Should(Receive(&startCtx))
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+
+		// The spawner kills the strategy when its context ends
+		Expect(startCtx.Err()).NotTo(HaveOccurred())
+		Expect(live.ActorFromContext(startCtx)).To(Equal(live.ActorScheduler))
+	})
+})
@@ -0,0 +1,194 @@
+package series
+
+import (
+	"math"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+// Point is one step of a strategy's equity curve
+type Point struct {
+	Time     time.Time `json:"time"`
+	Equity   float64   `json:"equity"`
+	Fees     float64   `json:"fees"`
+	Drawdown float64   `json:"drawdown"` // equity below its running peak, zero or negative
+}
+
+// Equity stitches samples, oldest first, into one curve across instances. PnL restarts from zero with
+// every instance, so each one is carried on from where the previous instance's samples ended.
+func Equity(samples []*live.MetricSample) []Point {
+	points := make([]Point, 0, len(samples))
+
+	var (
+		equityOffset, feesOffset float64
+		last                     *live.MetricSample
+		peak                     = math.Inf(-1)
+	)
+	for _, sample := range samples {
+		if last != nil && sample.InstanceID != last.InstanceID {
+			equityOffset += last.TotalPnL
+			feesOffset += last.Fees
+		}
+		last = sample
+
+		equity := equityOffset + sample.TotalPnL
+		peak = math.Max(peak, equity)
+		points = append(points, Point{
+			Time:     sample.Time,
+			Equity:   equity,
+			Fees:     feesOffset + sample.Fees,
+			Drawdown: equity - peak,
+		})
+	}
+	return points
+}
+
+// Day is a strategy's equity curve summed up over one calendar day
+type Day struct {
+	Date     time.Time `json:"date"`
+	Close    float64   `json:"close"`    // equity at the day's last sample
+	Change   float64   `json:"change"`   // PnL made during the day
+	Drawdown float64   `json:"drawdown"` // deepest drawdown during the day
+}
+
+// Daily groups a curve into calendar days in loc, oldest first
+func Daily(points []Point, loc *time.Location) []Day {
+	var days []Day
+	for i, point := range points {
+		y, m, d := point.Time.In(loc).Date()
+		date := time.Date(y, m, d, 0, 0, 0, 0, loc)
+
+		if len(days) == 0 || !days[len(days)-1].Date.Equal(date) {
+			// A day's change counts from the previous day's close, or the first sample when there is none
+			open := point.Equity
+			if i > 0 {
+				open = points[i-1].Equity
+			}
+			days = append(days, Day{Date: date, Close: open})
+		}
+
+		day := &days[len(days)-1]
+		day.Change += point.Equity - day.Close
+		day.Close = point.Equity
+		day.Drawdown = math.Min(day.Drawdown, point.Drawdown)
+	}
+	return days
+}
+
+// Analysis sums up a strategy's recorded history over a window
+type Analysis struct {
+	StrategyName string    `json:"strategy_name"`
+	From         time.Time `json:"from"`
+	Until        time.Time `json:"until"`
+	Samples      int       `json:"samples"`
+	Instances    int       `json:"instances"`
+
+	PnL           float64   `json:"pnl"`
+	Fees          float64   `json:"fees"`
+	Peak          float64   `json:"peak"`
+	MaxDrawdown   float64   `json:"max_drawdown"` // zero or negative
+	MaxDrawdownAt time.Time `json:"max_drawdown_at,omitempty"`
+
+	Days        []Day   `json:"days"`
+	WinningDays int     `json:"winning_days"`
+	LosingDays  int     `json:"losing_days"`
+	BestDay     float64 `json:"best_day"`
+	WorstDay    float64 `json:"worst_day"`
+
+	// Sharpe is the annualised ratio of the mean daily PnL to its deviation; zero with fewer than two days
+	Sharpe float64 `json:"sharpe"`
+
+	AvgLatency time.Duration `json:"avg_latency"`
+	MaxP99     time.Duration `json:"max_p99"`
+}
+
+// Analyze sums up samples, oldest first, with days in loc. It returns nil without samples.
+func Analyze(strategyName string, samples []*live.MetricSample, loc *time.Location) *Analysis {
+	if len(samples) == 0 {
+		return nil
+	}
+
+	points := Equity(samples)
+	first, last := points[0], points[len(points)-1]
+
+	analysis := &Analysis{
+		StrategyName: strategyName,
+		From:         first.Time,
+		Until:        last.Time,
+		Samples:      len(samples),
+		PnL:          last.Equity - first.Equity,
+		Fees:         last.Fees - first.Fees,
+		Peak:         first.Equity,
+		Days:         Daily(points, loc),
+	}
+
+	for _, point := range points {
+		analysis.Peak = math.Max(analysis.Peak, point.Equity)
+		if point.Drawdown < analysis.MaxDrawdown {
+			analysis.MaxDrawdown = point.Drawdown
+			analysis.MaxDrawdownAt = point.Time
+		}
+	}
+
+	instances := make(map[string]bool)
+	var latency time.Duration
+	var timed int
+	for _, sample := range samples {
+		instances[sample.InstanceID] = true
+		if sample.AvgLatency > 0 {
+			latency += sample.AvgLatency
+			timed++
+		}
+		if sample.P99Latency > analysis.MaxP99 {
+			analysis.MaxP99 = sample.P99Latency
+		}
+	}
+	analysis.Instances = len(instances)
+	if timed > 0 {
+		analysis.AvgLatency = latency / time.Duration(timed)
+	}
+
+	changes := make([]float64, len(analysis.Days))
+	for i, day := range analysis.Days {
+		changes[i] = day.Change
+		switch {
+		case day.Change > 0:
+			analysis.WinningDays++
+		case day.Change < 0:
+			analysis.LosingDays++
+		}
+		if i == 0 || day.Change > analysis.BestDay {
+			analysis.BestDay = day.Change
+		}
+		if i == 0 || day.Change < analysis.WorstDay {
+			analysis.WorstDay = day.Change
+		}
+	}
+	analysis.Sharpe = sharpe(changes)
+
+	return analysis
+}
+
+// sharpe annualises daily PnL over every calendar day, as crypto markets never close
+func sharpe(changes []float64) float64 {
+	if len(changes) < 2 {
+		return 0
+	}
+
+	var mean float64
+	for _, change := range changes {
+		mean += change
+	}
+	mean /= float64(len(changes))
+
+	var variance float64
+	for _, change := range changes {
+		variance += (change - mean) * (change - mean)
+	}
+	deviation := math.Sqrt(variance / float64(len(changes)-1))
+	if deviation == 0 {
+		return 0
+	}
+	return mean / deviation * math.Sqrt(365)
+}
@@ -0,0 +1,117 @@
+package series_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/series"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+var _ = Describe("Analysis", func() {
+	var start time.Time
+
+	BeforeEach(func() {
+		start = time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	})
+
+	sample := func(hours int, instance string, total, fees float64) *live.MetricSample {
+		return &live.MetricSample{
+			Time:       start.Add(time.Duration(hours) * time.Hour),
+			InstanceID: instance,
+			TotalPnL:   total,
+			Fees:       fees,
+		}
+	}
+
+	Describe("Equity", func() {
+		It("should carry a restarted instance on from where the previous one ended", func() {
+			points := series.Equity([]*live.MetricSample{
+				sample(0, "a1", 0, 0),
+				sample(1, "a1", 10, 1),
+				sample(2, "a2", 0, 0),
+				sample(3, "a2", -4, 0.5),
+			})
+
+			Expect(points).To(HaveLen(4))
+			Expect(points[2].Equity).To(Equal(10.0))
+			Expect(points[3].Equity).To(Equal(6.0))
+			Expect(points[3].Fees).To(Equal(1.5))
+		})
+
+		It("should measure drawdown from the running peak", func() {
+			points := series.Equity([]*live.MetricSample{
+				sample(0, "a1", 5, 0),
+				sample(1, "a1", 12, 0),
+				sample(2, "a1", 7, 0),
+				sample(3, "a1", 15, 0),
+			})
+
+			Expect(points[1].Drawdown).To(BeZero())
+			Expect(points[2].Drawdown).To(Equal(-5.0))
+			Expect(points[3].Drawdown).To(BeZero())
+		})
+	})
+
+	Describe("Daily", func() {
+		It("should count each day's change from the previous day's close", func() {
+			days := series.Daily(series.Equity([]*live.MetricSample{
+				sample(0, "a1", 2, 0),
+				sample(2, "a1", 10, 0),
+				sample(24, "a1", 4, 0),
+				sample(26, "a1", 6, 0),
+			}), time.UTC)
+
+			Expect(days).To(HaveLen(2))
+			Expect(days[0].Date).To(Equal(time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)))
+			Expect(days[0].Close).To(Equal(10.0))
+			Expect(days[0].Change).To(Equal(8.0))
+			Expect(days[1].Close).To(Equal(6.0))
+			Expect(days[1].Change).To(Equal(-4.0))
+			Expect(days[1].Drawdown).To(Equal(-6.0))
+		})
+	})
+
+	Describe("Analyze", func() {
+		It("should return nil without samples", func() {
+			Expect(series.Analyze("alpha", nil, time.UTC)).To(BeNil())
+		})
+
+		It("should sum up the window across days and instances", func() {
+			samples := []*live.MetricSample{
+				sample(0, "a1", 0, 0),
+				sample(2, "a1", 10, 1),
+				sample(24, "a1", 4, 2),
+				sample(25, "a2", 0, 0),
+				sample(48, "a2", 8, 1),
+			}
+			samples[1].AvgLatency = 10 * time.Millisecond
+			samples[1].P99Latency = 30 * time.Millisecond
+			samples[4].AvgLatency = 20 * time.Millisecond
+			samples[4].P99Latency = 50 * time.Millisecond
+
+			analysis := series.Analyze("alpha", samples, time.UTC)
+
+			Expect(analysis.StrategyName).To(Equal("alpha"))
+			Expect(analysis.Samples).To(Equal(5))
+			Expect(analysis.Instances).To(Equal(2))
+			Expect(analysis.PnL).To(Equal(12.0))
+			Expect(analysis.Fees).To(Equal(3.0))
+			Expect(analysis.Peak).To(Equal(12.0))
+			Expect(analysis.MaxDrawdown).To(Equal(-6.0))
+			Expect(analysis.MaxDrawdownAt).To(Equal(start.Add(24 * time.Hour)))
+
+			Expect(analysis.Days).To(HaveLen(3))
+			Expect(analysis.WinningDays).To(Equal(2))
+			Expect(analysis.LosingDays).To(Equal(1))
+			Expect(analysis.BestDay).To(Equal(10.0))
+			Expect(analysis.WorstDay).To(Equal(-6.0))
+			Expect(analysis.Sharpe).NotTo(BeZero())
+
+			Expect(analysis.AvgLatency).To(Equal(15 * time.Millisecond))
+			Expect(analysis.MaxP99).To(Equal(50 * time.Millisecond))
+		})
+	})
+})
@@ -0,0 +1,11 @@
+package series
+
+import "go.uber.org/fx"
+
+// Module provides the metrics history store and the recorder running strategies sample into it
+var Module = fx.Module("live/series",
+	fx.Provide(
+		NewStore,
+		NewRecorder,
+	),
+)
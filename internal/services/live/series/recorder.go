@@ -0,0 +1,85 @@
+package series
+
+import (
+	"context"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+)
+
+// pruneEvery is how often a recording strategy removes samples beyond the retention
+const pruneEvery = 24 * time.Hour
+
+type recorder struct {
+	store  live.SeriesStore
+	cfg    live.SeriesConfig
+	logger logging.ApplicationLogger
+	now    func() time.Time
+}
+
+// NewRecorder creates a SeriesRecorder that samples at the configured interval
+func NewRecorder(store live.SeriesStore, cfg *live.SupervisorConfig, logger logging.ApplicationLogger) live.SeriesRecorder {
+	return &recorder{
+		store:  store,
+		cfg:    cfg.Series,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+func (r *recorder) Record(ctx context.Context, strategyName, instanceID string, views monitoring.ViewRegistry) {
+	if r.cfg.Interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(r.cfg.Interval)
+	defer ticker.Stop()
+
+	var pruned time.Time
+	for {
+		now := r.now()
+		if r.cfg.Retention > 0 && now.Sub(pruned) >= pruneEvery {
+			if err := r.store.Prune(now.Add(-r.cfg.Retention)); err != nil {
+				r.logger.Warn("Failed to prune metrics history", "error", err)
+			}
+			pruned = now
+		}
+
+		if sample := Sample(views, now); sample != nil {
+			sample.InstanceID = instanceID
+			if err := r.store.Append(strategyName, sample); err != nil {
+				r.logger.Warn("Failed to record metrics sample", "error", err)
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Sample reads a strategy's current figures from its views; nil until it has PnL to report
+func Sample(views monitoring.ViewRegistry, now time.Time) *live.MetricSample {
+	pnl := views.GetPnLView()
+	if pnl == nil {
+		return nil
+	}
+
+	sample := &live.MetricSample{
+		Time:          now,
+		RealizedPnL:   pnl.RealizedPnL.InexactFloat64(),
+		UnrealizedPnL: pnl.UnrealizedPnL.InexactFloat64(),
+		TotalPnL:      pnl.TotalPnL.InexactFloat64(),
+		Fees:          pnl.TotalFees.InexactFloat64(),
+		Positions:     live.NetPositions(views.GetPositionsView()),
+	}
+	if stats := views.GetProfilingStats(); stats != nil {
+		sample.AvgLatency = stats.AvgDuration
+		sample.P99Latency = stats.P99
+	}
+	return sample
+}
@@ -0,0 +1,122 @@
+package series_test
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/series"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	monitoringmocks "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+)
+
+var _ = Describe("Recorder", func() {
+	var (
+		views *monitoringmocks.ViewRegistry
+		pnl   *monitoring.PnLView
+	)
+
+	BeforeEach(func() {
+		views = monitoringmocks.NewViewRegistry(GinkgoT())
+		pnl = &monitoring.PnLView{
+			RealizedPnL:   numerical.NewFromFloat(7.5),
+			UnrealizedPnL: numerical.NewFromFloat(-2.5),
+			TotalPnL:      numerical.NewFromFloat(5),
+			TotalFees:     numerical.NewFromFloat(0.75),
+		}
+	})
+
+	Describe("Sample", func() {
+		It("should take PnL, net positions and latency from the views", func() {
+			views.EXPECT().GetPnLView().Return(pnl).Once()
+			views.EXPECT().GetPositionsView().Return(&strategy.StrategyExecution{
+				Trades: []connector.Trade{
+					{Exchange: "binance", Symbol: "BTC", Side: connector.OrderSideBuy, Quantity: numerical.NewFromFloat(2)},
+					{Exchange: "binance", Symbol: "BTC", Side: connector.OrderSideSell, Quantity: numerical.NewFromFloat(0.5)},
+				},
+			}).Once()
+			views.EXPECT().GetProfilingStats().Return(&monitoring.ProfilingStats{
+				AvgDuration: 5 * time.Millisecond,
+				P99:         20 * time.Millisecond,
+			}).Once()
+
+			now := time.Now()
+			sample := series.Sample(views, now)
+
+			Expect(sample.Time).To(Equal(now))
+			Expect(sample.RealizedPnL).To(Equal(7.5))
+			Expect(sample.UnrealizedPnL).To(Equal(-2.5))
+			Expect(sample.TotalPnL).To(Equal(5.0))
+			Expect(sample.Fees).To(Equal(0.75))
+			Expect(sample.Positions).To(HaveLen(1))
+			Expect(sample.Positions[0].Quantity.InexactFloat64()).To(Equal(1.5))
+			Expect(sample.AvgLatency).To(Equal(5 * time.Millisecond))
+			Expect(sample.P99Latency).To(Equal(20 * time.Millisecond))
+		})
+
+		It("should skip a strategy with no PnL yet", func() {
+			views.EXPECT().GetPnLView().Return(nil).Once()
+
+			Expect(series.Sample(views, time.Now())).To(BeNil())
+		})
+	})
+
+	Describe("Record", func() {
+		var store live.SeriesStore
+
+		BeforeEach(func() {
+			dir, err := os.MkdirTemp("", "recorder")
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(func() {
+				_ = os.RemoveAll(dir)
+			})
+			store = series.NewStoreAt(dir)
+		})
+
+		It("should record samples with the instance until cancelled", func() {
+			views.EXPECT().GetPnLView().Return(pnl)
+			views.EXPECT().GetPositionsView().Return(nil)
+			views.EXPECT().GetProfilingStats().Return(nil)
+
+			cfg := &live.SupervisorConfig{Series: live.SeriesConfig{Interval: 10 * time.Millisecond, Retention: time.Hour}}
+			recorder := series.NewRecorder(store, cfg, &logging.NoOpLogger{})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				recorder.Record(ctx, "alpha", "a1", views)
+			}()
+
+			Eventually(func() int {
+				samples, _ := store.Query("alpha", time.Time{}, time.Time{})
+				return len(samples)
+			}).Should(BeNumerically(">=", 2))
+
+			cancel()
+			Eventually(done).Should(BeClosed())
+
+			samples, err := store.Query("alpha", time.Time{}, time.Time{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(samples[0].InstanceID).To(Equal("a1"))
+			Expect(samples[0].TotalPnL).To(Equal(5.0))
+		})
+
+		It("should record nothing when recording is disabled", func() {
+			recorder := series.NewRecorder(store, &live.SupervisorConfig{}, &logging.NoOpLogger{})
+			recorder.Record(context.Background(), "alpha", "a1", views)
+
+			samples, err := store.Query("alpha", time.Time{}, time.Time{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(samples).To(BeEmpty())
+		})
+	})
+})
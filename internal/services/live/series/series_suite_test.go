@@ -0,0 +1,13 @@
+package series_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSeries(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Series Suite")
+}
@@ -0,0 +1,148 @@
+package series
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/history"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+// dayLayout names the file holding one UTC day of a strategy's samples
+const dayLayout = "2006-01-02"
+
+// fileStore keeps each strategy's samples in one JSONL file per UTC day, so a query
+// only reads the days it covers and retention removes whole files
+type fileStore struct {
+	dir string
+}
+
+// DefaultDir returns ~/.kronos/series
+func DefaultDir() string {
+	homeDir, _ := os.UserHomeDir()
+	return filepath.Join(homeDir, ".kronos", "series")
+}
+
+// NewStore creates the series store in the configured directory
+func NewStore(cfg *live.SupervisorConfig) live.SeriesStore {
+	dir := cfg.Series.Dir
+	if dir == "" {
+		dir = DefaultDir()
+	}
+	return NewStoreAt(dir)
+}
+
+// NewStoreAt creates a series store in dir
+func NewStoreAt(dir string) live.SeriesStore {
+	return &fileStore{dir: dir}
+}
+
+func (s *fileStore) log(strategyName string, day time.Time) *history.Log[live.MetricSample] {
+	path := filepath.Join(s.dir, strategyName, day.UTC().Format(dayLayout)+".jsonl")
+	return history.NewLog(path,
+		func(*live.MetricSample) string { return strategyName },
+		func(sample *live.MetricSample) time.Time { return sample.Time },
+	)
+}
+
+func (s *fileStore) Append(strategyName string, sample *live.MetricSample) error {
+	if strategyName == "" || strings.ContainsAny(strategyName, `/\`) {
+		return fmt.Errorf("invalid strategy name %q", strategyName)
+	}
+	if err := s.log(strategyName, sample.Time).Append(sample); err != nil {
+		return fmt.Errorf("failed to record sample: %w", err)
+	}
+	return nil
+}
+
+func (s *fileStore) Query(strategyName string, from, until time.Time) ([]*live.MetricSample, error) {
+	if until.IsZero() {
+		until = time.Now()
+	}
+
+	days, err := s.days(strategyName)
+	if err != nil {
+		return nil, err
+	}
+
+	samples := []*live.MetricSample{}
+	for _, day := range days {
+		// A file covers [day, day+24h)
+		if !day.Add(24*time.Hour).After(from) || !day.Before(until) {
+			continue
+		}
+
+		// Logs list newest first, so each day is reversed onto the result
+		records, err := s.log(strategyName, day).List(live.HistoryFilter{Since: from})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read samples: %w", err)
+		}
+		for i := len(records) - 1; i >= 0; i-- {
+			if records[i].Time.Before(until) {
+				samples = append(samples, records[i])
+			}
+		}
+	}
+	return samples, nil
+}
+
+func (s *fileStore) Prune(cutoff time.Time) error {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read %s: %w", s.dir, err)
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		days, err := s.days(entry.Name())
+		if err != nil {
+			return err
+		}
+		for _, day := range days {
+			// Only whole days before the cutoff go, the day it falls in is kept
+			if day.Add(24 * time.Hour).After(cutoff) {
+				break
+			}
+			path := filepath.Join(s.dir, entry.Name(), day.Format(dayLayout)+".jsonl")
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				return fmt.Errorf("failed to remove %s: %w", path, err)
+			}
+		}
+	}
+	return nil
+}
+
+// days lists the days the strategy has samples for, oldest first
+func (s *fileStore) days(strategyName string) ([]time.Time, error) {
+	entries, err := os.ReadDir(filepath.Join(s.dir, strategyName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read samples of %s: %w", strategyName, err)
+	}
+
+	var days []time.Time
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), ".jsonl")
+		if !ok || entry.IsDir() {
+			continue
+		}
+		day, err := time.Parse(dayLayout, name)
+		if err != nil {
+			continue
+		}
+		days = append(days, day)
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+	return days, nil
+}
@@ -0,0 +1,108 @@
+package series_test
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/series"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+var _ = Describe("Store", func() {
+	var (
+		dir   string
+		store live.SeriesStore
+		day   time.Time
+	)
+
+	sample := func(at time.Time, total float64) *live.MetricSample {
+		return &live.MetricSample{Time: at, InstanceID: "a1", TotalPnL: total}
+	}
+
+	totals := func(samples []*live.MetricSample) []float64 {
+		out := make([]float64, len(samples))
+		for i, s := range samples {
+			out[i] = s.TotalPnL
+		}
+		return out
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "series")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(func() {
+			_ = os.RemoveAll(dir)
+		})
+
+		store = series.NewStoreAt(dir)
+		day = time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	})
+
+	It("should return no samples for a strategy that recorded none", func() {
+		samples, err := store.Query("alpha", time.Time{}, time.Time{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(samples).To(BeEmpty())
+	})
+
+	It("should keep one file per strategy and day", func() {
+		Expect(store.Append("alpha", sample(day.Add(23*time.Hour), 1))).To(Succeed())
+		Expect(store.Append("alpha", sample(day.Add(25*time.Hour), 2))).To(Succeed())
+		Expect(store.Append("beta", sample(day.Add(time.Hour), 3))).To(Succeed())
+
+		Expect(filepath.Join(dir, "alpha", "2026-03-10.jsonl")).To(BeAnExistingFile())
+		Expect(filepath.Join(dir, "alpha", "2026-03-11.jsonl")).To(BeAnExistingFile())
+		Expect(filepath.Join(dir, "beta", "2026-03-10.jsonl")).To(BeAnExistingFile())
+	})
+
+	It("should return the samples of a window across days, oldest first", func() {
+		for i := 0; i < 6; i++ {
+			Expect(store.Append("alpha", sample(day.Add(time.Duration(i)*12*time.Hour), float64(i)))).To(Succeed())
+		}
+		Expect(store.Append("beta", sample(day.Add(time.Hour), 99))).To(Succeed())
+
+		samples, err := store.Query("alpha", day.Add(12*time.Hour), day.Add(48*time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(totals(samples)).To(Equal([]float64{1, 2, 3}))
+
+		samples, err = store.Query("alpha", time.Time{}, day.Add(100*time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(totals(samples)).To(Equal([]float64{0, 1, 2, 3, 4, 5}))
+	})
+
+	It("should round trip positions and latency", func() {
+		recorded := sample(day, 5)
+		recorded.Fees = 0.25
+		recorded.P99Latency = 40 * time.Millisecond
+		recorded.Positions = []live.NetPosition{{Exchange: "binance", Symbol: "BTC"}}
+		Expect(store.Append("alpha", recorded)).To(Succeed())
+
+		samples, err := store.Query("alpha", time.Time{}, day.Add(time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(samples).To(HaveLen(1))
+		Expect(samples[0].Fees).To(Equal(0.25))
+		Expect(samples[0].P99Latency).To(Equal(40 * time.Millisecond))
+		Expect(samples[0].Positions).To(HaveLen(1))
+		Expect(samples[0].Positions[0].Symbol).To(Equal("BTC"))
+	})
+
+	It("should refuse a strategy name that would escape the directory", func() {
+		Expect(store.Append("../alpha", sample(day, 1))).NotTo(Succeed())
+	})
+
+	It("should prune whole days before the cutoff", func() {
+		for i := 0; i < 3; i++ {
+			Expect(store.Append("alpha", sample(day.AddDate(0, 0, i), float64(i)))).To(Succeed())
+		}
+
+		Expect(store.Prune(day.AddDate(0, 0, 1).Add(time.Hour))).To(Succeed())
+
+		samples, err := store.Query("alpha", time.Time{}, day.AddDate(0, 0, 3))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(totals(samples)).To(Equal([]float64{1, 2}))
+	})
+})
@@ -0,0 +1,248 @@
+package exporter
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+)
+
+// collectConcurrency caps how many instances a scrape queries at once
+const collectConcurrency = 16
+
+// metric describes a family before any samples are added to it
+type metric struct {
+	name string
+	help string
+	typ  MetricType
+}
+
+var (
+	instanceUp       = metric{"kronos_instance_up", "Whether the instance answered its health check.", Gauge}
+	instanceHealthy  = metric{"kronos_instance_healthy", "Whether the instance reports no connector or data flow errors.", Gauge}
+	instanceState    = metric{"kronos_instance_connection_state", "Overall connection state of the instance, 1 for the current state.", Gauge}
+	instanceStarted  = metric{"kronos_instance_start_time_seconds", "When the instance started, in seconds since the epoch.", Gauge}
+	connectorState   = metric{"kronos_connector_state", "State of a connector reporting errors, 1 for the current state.", Gauge}
+	dataFlowErrors   = metric{"kronos_data_flow_errors", "Errors receiving a type of market data from an exchange.", Gauge}
+	realizedPnL      = metric{"kronos_realized_pnl", "Realized profit and loss.", Gauge}
+	unrealizedPnL    = metric{"kronos_unrealized_pnl", "Unrealized profit and loss of open positions.", Gauge}
+	totalPnL         = metric{"kronos_total_pnl", "Realized plus unrealized profit and loss.", Gauge}
+	feesPaid         = metric{"kronos_fees_paid", "Trading fees paid.", Gauge}
+	signalsGenerated = metric{"kronos_signals_generated", "Signals generated by the strategy.", Counter}
+	signalsExecuted  = metric{"kronos_signals_executed", "Signals executed on an exchange.", Counter}
+	signalsFailed    = metric{"kronos_signals_failed", "Signals that failed to execute.", Counter}
+	signalLatency    = metric{"kronos_signal_latency_seconds", "Average latency from signal to execution.", Gauge}
+	lastSignal       = metric{"kronos_last_signal_time_seconds", "When the strategy last generated a signal, in seconds since the epoch.", Gauge}
+	activePositions  = metric{"kronos_active_positions", "Open positions.", Gauge}
+	dailyPnL         = metric{"kronos_daily_pnl", "Profit and loss over the last day.", Gauge}
+	weeklyPnL        = metric{"kronos_weekly_pnl", "Profit and loss over the last week.", Gauge}
+	monthlyPnL       = metric{"kronos_monthly_pnl", "Profit and loss over the last month.", Gauge}
+	executionTime    = metric{"kronos_execution_duration_seconds", "Strategy execution time over recent executions, by quantile.", Gauge}
+	executionAvg     = metric{"kronos_execution_duration_avg_seconds", "Average strategy execution time over recent executions.", Gauge}
+	executionSuccess = metric{"kronos_execution_success_ratio", "Share of recent executions that succeeded.", Gauge}
+	executionSamples = metric{"kronos_execution_samples", "Recent executions the profiling statistics are computed over.", Gauge}
+	assetInfo        = metric{"kronos_asset_info", "Assets the instance trades, always 1.", Gauge}
+	bestBid          = metric{"kronos_orderbook_best_bid", "Best bid price of an asset.", Gauge}
+	bestAsk          = metric{"kronos_orderbook_best_ask", "Best ask price of an asset.", Gauge}
+	exporterScraped  = metric{"kronos_exporter_instances", "Instances found by this scrape.", Gauge}
+	exporterDuration = metric{"kronos_exporter_scrape_duration_seconds", "How long this scrape took.", Gauge}
+)
+
+// familySet gathers samples into families, in the order the families were first added to
+type familySet struct {
+	families []*Family
+	byName   map[string]*Family
+}
+
+func newFamilySet() *familySet {
+	return &familySet{byName: make(map[string]*Family)}
+}
+
+func (s *familySet) add(m metric, value float64, labels ...Label) {
+	family, ok := s.byName[m.name]
+	if !ok {
+		family = &Family{Name: m.name, Help: m.help, Type: m.typ}
+		s.byName[m.name] = family
+		s.families = append(s.families, family)
+	}
+	family.Samples = append(family.Samples, Sample{Labels: labels, Value: value})
+}
+
+func (s *familySet) merge(other *familySet) {
+	for _, family := range other.families {
+		for _, sample := range family.Samples {
+			s.add(metric{family.Name, family.Help, family.Type}, sample.Value, sample.Labels...)
+		}
+	}
+}
+
+// Collector translates the views of every running instance into metric families on each scrape
+type Collector struct {
+	querier monitoring.ViewQuerier
+	health  live.HealthQuerier
+}
+
+func NewCollector(querier monitoring.ViewQuerier, health live.HealthQuerier) *Collector {
+	return &Collector{
+		querier: querier,
+		health:  health,
+	}
+}
+
+// Collect queries every instance with a monitoring socket concurrently. An instance that doesn't
+// answer its health check only reports kronos_instance_up 0.
+func (c *Collector) Collect() []*Family {
+	started := time.Now()
+
+	instanceIDs, _ := c.querier.ListInstances()
+	sort.Strings(instanceIDs)
+
+	sets := make([]*familySet, len(instanceIDs))
+	slots := make(chan struct{}, collectConcurrency)
+
+	var wg sync.WaitGroup
+	for i, instanceID := range instanceIDs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+			sets[i] = c.collectInstance(instanceID)
+		}()
+	}
+	wg.Wait()
+
+	// Merged in instance order so every scrape lists samples the same way
+	all := newFamilySet()
+	for _, set := range sets {
+		all.merge(set)
+	}
+	all.add(exporterScraped, float64(len(instanceIDs)))
+	all.add(exporterDuration, time.Since(started).Seconds())
+
+	return all.families
+}
+
+// collectInstance queries the health, PnL, metrics, profiling and orderbooks of one instance
+func (c *Collector) collectInstance(instanceID string) *familySet {
+	set := newFamilySet()
+
+	report, err := c.health.QueryHealth(instanceID)
+	if err != nil {
+		set.add(instanceUp, 0, Label{"instance", instanceID})
+		return set
+	}
+
+	metrics, _ := c.querier.QueryMetrics(instanceID)
+	pnl, _ := c.querier.QueryPnL(instanceID)
+	stats, _ := c.querier.QueryProfilingStats(instanceID)
+	assets, _ := c.querier.QueryAvailableAssets(instanceID)
+
+	strategyName := instanceID
+	switch {
+	case metrics != nil && metrics.StrategyName != "":
+		strategyName = metrics.StrategyName
+	case pnl != nil && pnl.StrategyName != "":
+		strategyName = pnl.StrategyName
+	}
+
+	labels := func(extra ...Label) []Label {
+		return append([]Label{{"instance", instanceID}, {"strategy", strategyName}}, extra...)
+	}
+
+	set.add(instanceUp, 1, labels()...)
+	set.add(instanceHealthy, boolValue(!report.HasErrors), labels()...)
+	if report.OverallState != "" {
+		set.add(instanceState, 1, labels(Label{"state", string(report.OverallState)})...)
+	}
+	if !report.StartedAt.IsZero() {
+		set.add(instanceStarted, unixSeconds(report.StartedAt), labels()...)
+	}
+	if report.ConnectorErrors != nil {
+		for _, exchange := range sortedKeys(report.ConnectorErrors.Errors) {
+			state := report.ConnectorErrors.Errors[exchange].State
+			set.add(connectorState, 1, labels(Label{"exchange", exchange}, Label{"state", string(state)})...)
+		}
+	}
+	if report.DataFlowErrors != nil {
+		for _, exchange := range sortedKeys(report.DataFlowErrors.Errors) {
+			byType := report.DataFlowErrors.Errors[exchange]
+			for _, dataType := range sortedKeys(byType) {
+				set.add(dataFlowErrors, float64(byType[dataType].ErrorCount),
+					labels(Label{"exchange", exchange}, Label{"data_type", dataType})...)
+			}
+		}
+	}
+
+	if pnl != nil {
+		set.add(realizedPnL, pnl.RealizedPnL.InexactFloat64(), labels()...)
+		set.add(unrealizedPnL, pnl.UnrealizedPnL.InexactFloat64(), labels()...)
+		set.add(totalPnL, pnl.TotalPnL.InexactFloat64(), labels()...)
+		set.add(feesPaid, pnl.TotalFees.InexactFloat64(), labels()...)
+	}
+
+	if metrics != nil {
+		set.add(signalsGenerated, float64(metrics.SignalsGenerated), labels()...)
+		set.add(signalsExecuted, float64(metrics.SignalsExecuted), labels()...)
+		set.add(signalsFailed, float64(metrics.SignalsFailed), labels()...)
+		set.add(signalLatency, metrics.AverageLatency.Seconds(), labels()...)
+		if !metrics.LastSignalTime.IsZero() {
+			set.add(lastSignal, unixSeconds(metrics.LastSignalTime), labels()...)
+		}
+		set.add(activePositions, float64(metrics.ActivePositions), labels()...)
+		set.add(dailyPnL, metrics.DailyPnL, labels()...)
+		set.add(weeklyPnL, metrics.WeeklyPnL, labels()...)
+		set.add(monthlyPnL, metrics.MonthlyPnL, labels()...)
+	}
+
+	// The statistics cover the executions the strategy still holds, not its whole run,
+	// so they are gauges rather than a summary whose count would have to keep growing
+	if stats != nil && stats.TotalRuns > 0 {
+		set.add(executionTime, stats.P50.Seconds(), labels(Label{"quantile", "0.5"})...)
+		set.add(executionTime, stats.P95.Seconds(), labels(Label{"quantile", "0.95"})...)
+		set.add(executionTime, stats.P99.Seconds(), labels(Label{"quantile", "0.99"})...)
+		set.add(executionAvg, stats.AvgDuration.Seconds(), labels()...)
+		set.add(executionSuccess, stats.SuccessRate/100, labels()...)
+		set.add(executionSamples, float64(stats.TotalRuns), labels()...)
+	}
+
+	for _, asset := range assets {
+		assetLabels := labels(Label{"exchange", asset.Exchange}, Label{"asset", asset.Asset})
+		set.add(assetInfo, 1, assetLabels...)
+
+		book, err := c.querier.QueryOrderbook(instanceID, asset.Asset, asset.Exchange)
+		if err != nil || book == nil {
+			continue
+		}
+		if len(book.Bids) > 0 {
+			set.add(bestBid, book.Bids[0].Price.InexactFloat64(), assetLabels...)
+		}
+		if len(book.Asks) > 0 {
+			set.add(bestAsk, book.Asks[0].Price.InexactFloat64(), assetLabels...)
+		}
+	}
+
+	return set
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func unixSeconds(t time.Time) float64 {
+	return float64(t.UnixNano()) / float64(time.Second)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,116 @@
+package exporter
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	// ContentTypeText is the Prometheus text exposition format
+	ContentTypeText = "text/plain; version=0.0.4; charset=utf-8"
+
+	// ContentTypeOpenMetrics is the OpenMetrics text format, sent to scrapers that ask for it
+	ContentTypeOpenMetrics = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+)
+
+// MetricType is the type of a metric family
+type MetricType string
+
+const (
+	Gauge   MetricType = "gauge"
+	Counter MetricType = "counter"
+)
+
+// Label is a label name and value of a sample
+type Label struct {
+	Name  string
+	Value string
+}
+
+// Sample is one value of a metric family
+type Sample struct {
+	Labels []Label
+	Value  float64
+}
+
+// Family is a metric with every sample exported for it. Counter names leave out the _total suffix,
+// which is added where each format expects it.
+type Family struct {
+	Name    string
+	Help    string
+	Type    MetricType
+	Samples []Sample
+}
+
+// Encode writes families in the Prometheus text format, or in OpenMetrics if openMetrics is set
+func Encode(w io.Writer, families []*Family, openMetrics bool) error {
+	b := bufio.NewWriter(w)
+
+	for _, family := range families {
+		typeName, sampleName := family.Name, family.Name
+		if family.Type == Counter {
+			sampleName += "_total"
+			if !openMetrics {
+				typeName = sampleName
+			}
+		}
+
+		fmt.Fprintf(b, "# HELP %s %s\n", typeName, escapeHelp(family.Help))
+		fmt.Fprintf(b, "# TYPE %s %s\n", typeName, family.Type)
+		for _, sample := range family.Samples {
+			b.WriteString(sampleName)
+			writeLabels(b, sample.Labels)
+			b.WriteByte(' ')
+			b.WriteString(formatValue(sample.Value))
+			b.WriteByte('\n')
+		}
+	}
+
+	if openMetrics {
+		b.WriteString("# EOF\n")
+	}
+	return b.Flush()
+}
+
+func writeLabels(b *bufio.Writer, labels []Label) {
+	if len(labels) == 0 {
+		return
+	}
+	b.WriteByte('{')
+	for i, label := range labels {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		fmt.Fprintf(b, `%s="%s"`, label.Name, escapeLabel(label.Value))
+	}
+	b.WriteByte('}')
+}
+
+var (
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(help string) string {
+	return helpEscaper.Replace(help)
+}
+
+func escapeLabel(value string) string {
+	return labelEscaper.Replace(value)
+}
+
+func formatValue(value float64) string {
+	switch {
+	case math.IsNaN(value):
+		return "NaN"
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
@@ -0,0 +1,122 @@
+package exporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+)
+
+const (
+	// DefaultListen is where the exporter listens unless told otherwise, the port reserved for it among Prometheus exporters
+	DefaultListen = ":9464"
+
+	// MetricsPath is where the exporter serves its metrics
+	MetricsPath = "/metrics"
+)
+
+// Exporter serves the metrics of every running instance over HTTP for Prometheus to scrape
+type Exporter struct {
+	collector *Collector
+	logger    logging.ApplicationLogger
+
+	mu         sync.Mutex
+	listener   net.Listener
+	httpServer *http.Server
+}
+
+func NewExporter(querier monitoring.ViewQuerier, health live.HealthQuerier, logger logging.ApplicationLogger) *Exporter {
+	return &Exporter{
+		collector: NewCollector(querier, health),
+		logger:    logger,
+	}
+}
+
+// Start listens on addr and serves in the background
+func (e *Exporter) Start(addr string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.listener != nil {
+		return fmt.Errorf("exporter already started")
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+
+	httpServer := &http.Server{
+		Handler:           e.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	e.listener = listener
+	e.httpServer = httpServer
+
+	go func() {
+		if err := httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			e.logger.Error("Exporter stopped", "error", err)
+		}
+	}()
+
+	return nil
+}
+
+// Addr returns the address the exporter listens on, with the port resolved
+func (e *Exporter) Addr() string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.listener == nil {
+		return ""
+	}
+	return e.listener.Addr().String()
+}
+
+// Stop shuts the exporter down, letting scrapes in flight finish until ctx expires
+func (e *Exporter) Stop(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.httpServer == nil {
+		return nil
+	}
+	err := e.httpServer.Shutdown(ctx)
+	e.httpServer = nil
+	e.listener = nil
+	return err
+}
+
+// Handler serves the metrics on MetricsPath, in OpenMetrics to scrapers that accept it
+func (e *Exporter) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET "+MetricsPath, func(w http.ResponseWriter, r *http.Request) {
+		families := e.collector.Collect()
+
+		openMetrics := strings.Contains(r.Header.Get("Accept"), "application/openmetrics-text")
+		if openMetrics {
+			w.Header().Set("Content-Type", ContentTypeOpenMetrics)
+		} else {
+			w.Header().Set("Content-Type", ContentTypeText)
+		}
+
+		if err := Encode(w, families, openMetrics); err != nil {
+			e.logger.Warn("Failed to write metrics", "remote", r.RemoteAddr, "error", err)
+		}
+	})
+
+	mux.HandleFunc("GET /{$}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		fmt.Fprintf(w, `<html><head><title>Kronos Exporter</title></head><body><h1>Kronos Exporter</h1><p><a href="%s">Metrics</a></p></body></html>`, MetricsPath)
+	})
+
+	return mux
+}
@@ -0,0 +1,143 @@
+package exporter_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/monitoring/exporter"
+	livemocks "github.com/backtesting-org/kronos-cli/mocks/github.com/backtesting-org/kronos-cli/pkg/live"
+	monitoringmocks "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring/health"
+)
+
+var _ = Describe("Exporter", func() {
+	var (
+		querier  *monitoringmocks.ViewQuerier
+		healthQ  *livemocks.HealthQuerier
+		exp      *exporter.Exporter
+		metrics  string
+		scrapeAs string
+	)
+
+	BeforeEach(func() {
+		querier = monitoringmocks.NewViewQuerier(GinkgoT())
+		healthQ = livemocks.NewHealthQuerier(GinkgoT())
+		exp = exporter.NewExporter(querier, healthQ, &logging.NoOpLogger{})
+		scrapeAs = ""
+
+		Expect(exp.Start("127.0.0.1:0")).To(Succeed())
+		DeferCleanup(func() { _ = exp.Stop(context.Background()) })
+	})
+
+	scrape := func() {
+		req, err := http.NewRequest(http.MethodGet, "http://"+exp.Addr()+exporter.MetricsPath, nil)
+		Expect(err).NotTo(HaveOccurred())
+		if scrapeAs != "" {
+			req.Header.Set("Accept", scrapeAs)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		metrics = string(body)
+	}
+
+	Context("with a running and a hung instance", func() {
+		BeforeEach(func() {
+			querier.EXPECT().ListInstances().Return([]string{"momentum", "hung"}, nil)
+
+			healthQ.EXPECT().QueryHealth("hung").Return(nil, errors.New("timeout"))
+			healthQ.EXPECT().QueryHealth("momentum").Return(&health.SystemHealthReport{
+				OverallState: health.StateDegraded,
+				HasErrors:    true,
+				StartedAt:    time.Unix(1700000000, 0),
+				ConnectorErrors: &health.ConnectorErrorReport{Errors: map[string]health.ConnectorError{
+					"binance": {State: health.StateDisconnected},
+				}},
+			}, nil)
+
+			querier.EXPECT().QueryMetrics("momentum").Return(&monitoring.StrategyMetrics{
+				StrategyName:     "momentum-v2",
+				SignalsGenerated: 12,
+				AverageLatency:   250 * time.Millisecond,
+			}, nil)
+			querier.EXPECT().QueryPnL("momentum").Return(&monitoring.PnLView{
+				TotalPnL: numerical.NewFromInt(42),
+			}, nil)
+			querier.EXPECT().QueryProfilingStats("momentum").Return(nil, errors.New("not profiled"))
+			querier.EXPECT().QueryAvailableAssets("momentum").Return([]monitoring.AssetExchange{
+				{Asset: "BTC", Exchange: "binance"},
+			}, nil)
+			querier.EXPECT().QueryOrderbook("momentum", "BTC", "binance").Return(&connector.OrderBook{
+				Bids: []connector.PriceLevel{{Price: numerical.NewFromInt(99), Quantity: numerical.NewFromInt(1)}},
+				Asks: []connector.PriceLevel{{Price: numerical.NewFromInt(101), Quantity: numerical.NewFromInt(1)}},
+			}, nil)
+		})
+
+		It("labels each instance's metrics by instance and strategy", func() {
+			scrape()
+
+			Expect(metrics).To(ContainSubstring(`kronos_instance_up{instance="momentum",strategy="momentum-v2"} 1`))
+			Expect(metrics).To(ContainSubstring(`kronos_instance_healthy{instance="momentum",strategy="momentum-v2"} 0`))
+			Expect(metrics).To(ContainSubstring(`kronos_total_pnl{instance="momentum",strategy="momentum-v2"} 42`))
+			Expect(metrics).To(ContainSubstring(`kronos_signal_latency_seconds{instance="momentum",strategy="momentum-v2"} 0.25`))
+			Expect(metrics).To(ContainSubstring(`kronos_instance_start_time_seconds{instance="momentum",strategy="momentum-v2"} 1.7e+09`))
+		})
+
+		It("labels connector and orderbook metrics by exchange and asset", func() {
+			scrape()
+
+			Expect(metrics).To(ContainSubstring(`kronos_connector_state{instance="momentum",strategy="momentum-v2",exchange="binance",state="disconnected"} 1`))
+			Expect(metrics).To(ContainSubstring(`kronos_orderbook_best_bid{instance="momentum",strategy="momentum-v2",exchange="binance",asset="BTC"} 99`))
+			Expect(metrics).To(ContainSubstring(`kronos_orderbook_best_ask{instance="momentum",strategy="momentum-v2",exchange="binance",asset="BTC"} 101`))
+		})
+
+		It("reports an instance that doesn't answer as down without querying it further", func() {
+			scrape()
+
+			Expect(metrics).To(ContainSubstring(`kronos_instance_up{instance="hung"} 0`))
+			Expect(metrics).NotTo(ContainSubstring(`kronos_execution_duration_seconds`))
+			Expect(metrics).To(ContainSubstring("kronos_exporter_instances 2\n"))
+		})
+
+		It("writes counters with the _total suffix in the Prometheus text format", func() {
+			scrape()
+
+			Expect(metrics).To(ContainSubstring("# TYPE kronos_signals_generated_total counter\n"))
+			Expect(metrics).To(ContainSubstring(`kronos_signals_generated_total{instance="momentum",strategy="momentum-v2"} 12`))
+			Expect(metrics).NotTo(ContainSubstring("# EOF"))
+		})
+
+		It("writes OpenMetrics to scrapers that ask for it", func() {
+			scrapeAs = "application/openmetrics-text; version=1.0.0"
+			scrape()
+
+			Expect(metrics).To(ContainSubstring("# TYPE kronos_signals_generated counter\n"))
+			Expect(metrics).To(ContainSubstring(`kronos_signals_generated_total{instance="momentum",strategy="momentum-v2"} 12`))
+			Expect(metrics).To(HaveSuffix("# EOF\n"))
+		})
+	})
+
+	It("escapes label values", func() {
+		querier.EXPECT().ListInstances().Return([]string{`odd"name`}, nil)
+		healthQ.EXPECT().QueryHealth(mock.Anything).Return(nil, errors.New("gone"))
+
+		scrape()
+		Expect(metrics).To(ContainSubstring(`kronos_instance_up{instance="odd\"name"} 0`))
+	})
+})
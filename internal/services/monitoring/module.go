@@ -1,6 +1,9 @@
 package monitoring
 
 import (
+	"github.com/backtesting-org/kronos-cli/internal/services/monitoring/exporter"
+	"github.com/backtesting-org/kronos-cli/internal/services/monitoring/portfolio"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
 	"go.uber.org/fx"
 )
@@ -8,10 +11,17 @@ import (
 // Module provides monitoring dependencies via FX
 var Module = fx.Module("monitoring",
 	fx.Provide(
-		// ViewQuerier implementation - queries running instances via socket
+		// One querier serves queries, streams, summaries, health reports, open orders and signals, so they all share its connections to the instances
 		fx.Annotate(
-			NewQuerier,
+			newQuerier,
 			fx.As(new(monitoring.ViewQuerier)),
+			fx.As(new(live.ViewStreamer)),
+			fx.As(new(live.SummaryQuerier)),
+			fx.As(new(live.HealthQuerier)),
+			fx.As(new(live.OrderQuerier)),
+			fx.As(new(live.SignalQuerier)),
 		),
+		exporter.NewExporter,
+		portfolio.NewPortfolioQuerier,
 	),
 )
@@ -0,0 +1,32 @@
+package monitoring
+
+import (
+	"net/http"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/control"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+// OrdersPath is where a strategy's control socket lists its working orders
+const OrdersPath = "/orders"
+
+// NewOrdersHandler serves the working orders of this strategy process.
+// The SDK's monitoring server has no view of orders resting on the exchange, so they are listed from the connectors here.
+func NewOrdersHandler(source live.OrderSource) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		control.WriteJSON(w, source.OpenOrders(r.Context()))
+	}
+}
+
+// QueryOpenOrders lists the working orders of an instance through its control socket
+func (q *querier) QueryOpenOrders(instanceID string) (*live.OpenOrders, error) {
+	var result live.OpenOrders
+	if err := q.doControlRequest(instanceID, OrdersPath, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,109 @@
+package monitoring_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/control"
+	"github.com/backtesting-org/kronos-cli/internal/services/monitoring"
+	livemocks "github.com/backtesting-org/kronos-cli/mocks/github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+var _ = Describe("Open orders", func() {
+	var (
+		tmpDir  string
+		source  *livemocks.OrderSource
+		querier live.OrderQuerier
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = os.MkdirTemp("", "orders-test-*")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(func() { _ = os.RemoveAll(tmpDir) })
+
+		source = livemocks.NewOrderSource(GinkgoT())
+		querier = monitoring.NewQuerierWithConfig(filepath.Join(tmpDir, "sockets"), 5*time.Second).(live.OrderQuerier)
+	})
+
+	It("lists the instance's working orders through its control socket", func() {
+		source.EXPECT().OpenOrders(mock.Anything).Return(&live.OpenOrders{
+			Orders: []live.WorkingOrder{{
+				Order: connector.Order{
+					ID:       "o-1",
+					Symbol:   "BTC-USD-PERP",
+					Side:     connector.OrderSideBuy,
+					Quantity: numerical.NewFromFloat(0.5),
+					Price:    numerical.NewFromInt(60000),
+				},
+				Exchange: "paradex",
+				Asset:    "BTC",
+				Mid:      numerical.NewFromInt(61000),
+			}},
+			Errors: []string{"bybit: failed to list open orders: timeout"},
+		}).Once()
+
+		server := control.NewServer(filepath.Join(tmpDir, "control"), "momentum")
+		server.Handle(monitoring.OrdersPath, monitoring.NewOrdersHandler(source))
+		Expect(server.Start()).To(Succeed())
+		DeferCleanup(func() { _ = server.Stop(context.Background()) })
+
+		result, err := querier.QueryOpenOrders("momentum")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Orders).To(HaveLen(1))
+		Expect(result.Orders[0].ID).To(Equal("o-1"))
+		Expect(result.Orders[0].Asset).To(Equal("BTC"))
+		Expect(result.Orders[0].Price.IntPart()).To(Equal(int64(60000)))
+		Expect(result.Errors).To(ConsistOf(ContainSubstring("bybit")))
+
+		distance, ok := result.Orders[0].DistanceFromMid()
+		Expect(ok).To(BeTrue())
+		Expect(distance).To(BeNumerically("~", 1.64, 0.01))
+	})
+
+	It("reports instances without a control socket", func() {
+		_, err := querier.QueryOpenOrders("momentum")
+		Expect(err).To(MatchError(ContainSubstring("has no control socket")))
+	})
+})
+
+var _ = Describe("WorkingOrder", func() {
+	now := time.Now()
+
+	order := func(age time.Duration, price, mid float64) live.WorkingOrder {
+		return live.WorkingOrder{
+			Order: connector.Order{
+				Quantity:  numerical.NewFromInt(4),
+				FilledQty: numerical.NewFromInt(1),
+				Price:     numerical.NewFromFloat(price),
+				CreatedAt: now.Add(-age),
+			},
+			Mid: numerical.NewFromFloat(mid),
+		}
+	}
+
+	It("works out how much has filled", func() {
+		Expect(order(time.Minute, 100, 100).FilledPercent()).To(BeNumerically("~", 25, 0.001))
+	})
+
+	It("flags orders that rested too long or drifted from mid", func() {
+		Expect(order(time.Minute, 100, 100.5).Stale(now)).To(BeFalse())
+		Expect(order(live.StaleOrderAge+time.Second, 100, 100).Stale(now)).To(BeTrue())
+		Expect(order(time.Minute, 90, 100).Stale(now)).To(BeTrue())
+	})
+
+	It("doesn't judge distance without a mid", func() {
+		_, ok := order(time.Minute, 90, 0).DistanceFromMid()
+		Expect(ok).To(BeFalse())
+		Expect(order(time.Minute, 90, 0).Stale(now)).To(BeFalse())
+	})
+})
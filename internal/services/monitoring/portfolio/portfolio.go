@@ -0,0 +1,214 @@
+package portfolio
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+)
+
+const (
+	// queryConcurrency caps how many instances are queried at once
+	queryConcurrency = 16
+
+	// largestHoldings is how many positions the portfolio lists as its largest
+	largestHoldings = 10
+)
+
+type portfolioQuerier struct {
+	views monitoring.ViewQuerier
+	now   func() time.Time
+}
+
+// NewPortfolioQuerier creates a PortfolioQuerier that fans QueryPnL and QueryPositions out over every instance
+func NewPortfolioQuerier(views monitoring.ViewQuerier) live.PortfolioQuerier {
+	return &portfolioQuerier{
+		views: views,
+		now:   time.Now,
+	}
+}
+
+// instanceViews is what one instance answered
+type instanceViews struct {
+	instanceID string
+	pnl        *monitoring.PnLView
+	positions  *strategy.StrategyExecution
+	err        error
+}
+
+func (q *portfolioQuerier) QueryPortfolio(ctx context.Context) (*live.Portfolio, error) {
+	instanceIDs, err := q.views.ListInstances()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list instances: %w", err)
+	}
+	sort.Strings(instanceIDs)
+
+	instances := make([]instanceViews, len(instanceIDs))
+	slots := make(chan struct{}, queryConcurrency)
+
+	var wg sync.WaitGroup
+	for i, instanceID := range instanceIDs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			instances[i].instanceID = instanceID
+
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+			case <-ctx.Done():
+				instances[i].err = ctx.Err()
+				return
+			}
+
+			instances[i].pnl, instances[i].err = q.views.QueryPnL(instanceID)
+			if instances[i].err != nil {
+				return
+			}
+			instances[i].positions, instances[i].err = q.views.QueryPositions(instanceID)
+		}()
+	}
+	wg.Wait()
+
+	return combine(q.now(), instances), nil
+}
+
+// combine adds up the instances into a portfolio
+func combine(now time.Time, instances []instanceViews) *live.Portfolio {
+	portfolio := &live.Portfolio{
+		Time:       now,
+		Strategies: []live.StrategyContribution{},
+		Assets:     []live.AssetExposure{},
+		Exchanges:  []live.ExchangeExposure{},
+		Largest:    []live.Holding{},
+	}
+
+	assets := make(map[string]*live.AssetExposure)
+	exchanges := make(map[string]*live.ExchangeExposure)
+	var holdings []live.Holding
+	var gross float64
+
+	for _, instance := range instances {
+		contribution := live.StrategyContribution{InstanceID: instance.instanceID}
+		if instance.err != nil {
+			contribution.Error = instance.err.Error()
+			portfolio.Strategies = append(portfolio.Strategies, contribution)
+			continue
+		}
+
+		if pnl := instance.pnl; pnl != nil {
+			contribution.RealizedPnL = pnl.RealizedPnL.InexactFloat64()
+			contribution.UnrealizedPnL = pnl.UnrealizedPnL.InexactFloat64()
+			contribution.TotalPnL = pnl.TotalPnL.InexactFloat64()
+			contribution.Fees = pnl.TotalFees.InexactFloat64()
+		}
+		portfolio.RealizedPnL += contribution.RealizedPnL
+		portfolio.UnrealizedPnL += contribution.UnrealizedPnL
+		portfolio.TotalPnL += contribution.TotalPnL
+		portfolio.Fees += contribution.Fees
+		gross += math.Abs(contribution.TotalPnL)
+
+		prices := lastPrices(instance.positions)
+		for _, position := range live.NetPositions(instance.positions) {
+			quantity := position.Quantity.InexactFloat64()
+			price := prices[priceKey{position.Exchange, position.Symbol}]
+			holding := live.Holding{
+				InstanceID: instance.instanceID,
+				Exchange:   position.Exchange,
+				Asset:      position.Symbol,
+				Quantity:   quantity,
+				Price:      price,
+				Notional:   quantity * price,
+			}
+			holdings = append(holdings, holding)
+			contribution.Positions++
+
+			asset, ok := assets[holding.Asset]
+			if !ok {
+				asset = &live.AssetExposure{Asset: holding.Asset}
+				assets[holding.Asset] = asset
+			}
+			asset.Quantity += holding.Quantity
+			asset.Notional += holding.Notional
+			asset.Gross += math.Abs(holding.Notional)
+
+			exchange, ok := exchanges[holding.Exchange]
+			if !ok {
+				exchange = &live.ExchangeExposure{Exchange: holding.Exchange}
+				exchanges[holding.Exchange] = exchange
+			}
+			exchange.Net += holding.Notional
+			exchange.Gross += math.Abs(holding.Notional)
+			exchange.Positions++
+		}
+
+		portfolio.Strategies = append(portfolio.Strategies, contribution)
+	}
+
+	if gross > 0 {
+		for i := range portfolio.Strategies {
+			portfolio.Strategies[i].Share = portfolio.Strategies[i].TotalPnL / gross
+		}
+	}
+	sort.SliceStable(portfolio.Strategies, func(i, j int) bool {
+		return portfolio.Strategies[i].TotalPnL > portfolio.Strategies[j].TotalPnL
+	})
+
+	for _, asset := range assets {
+		portfolio.Assets = append(portfolio.Assets, *asset)
+	}
+	sort.Slice(portfolio.Assets, func(i, j int) bool {
+		if portfolio.Assets[i].Gross != portfolio.Assets[j].Gross {
+			return portfolio.Assets[i].Gross > portfolio.Assets[j].Gross
+		}
+		return portfolio.Assets[i].Asset < portfolio.Assets[j].Asset
+	})
+
+	for _, exchange := range exchanges {
+		portfolio.Exchanges = append(portfolio.Exchanges, *exchange)
+	}
+	sort.Slice(portfolio.Exchanges, func(i, j int) bool {
+		if portfolio.Exchanges[i].Gross != portfolio.Exchanges[j].Gross {
+			return portfolio.Exchanges[i].Gross > portfolio.Exchanges[j].Gross
+		}
+		return portfolio.Exchanges[i].Exchange < portfolio.Exchanges[j].Exchange
+	})
+
+	// Holdings arrive in instance order, so equal positions keep a stable order
+	sort.SliceStable(holdings, func(i, j int) bool {
+		return math.Abs(holdings[i].Notional) > math.Abs(holdings[j].Notional)
+	})
+	if len(holdings) > largestHoldings {
+		holdings = holdings[:largestHoldings]
+	}
+	portfolio.Largest = append(portfolio.Largest, holdings...)
+
+	return portfolio
+}
+
+type priceKey struct{ exchange, symbol string }
+
+// lastPrices is the price of the latest fill of each asset an instance traded, which its positions are valued at
+func lastPrices(execution *strategy.StrategyExecution) map[priceKey]float64 {
+	prices := make(map[priceKey]float64)
+	if execution == nil {
+		return prices
+	}
+
+	latest := make(map[priceKey]time.Time)
+	for _, trade := range execution.Trades {
+		key := priceKey{string(trade.Exchange), trade.Symbol}
+		if seen, ok := latest[key]; ok && trade.Timestamp.Before(seen) {
+			continue
+		}
+		latest[key] = trade.Timestamp
+		prices[key] = trade.Price.InexactFloat64()
+	}
+	return prices
+}
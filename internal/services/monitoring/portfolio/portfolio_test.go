@@ -0,0 +1,149 @@
+package portfolio_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/monitoring/portfolio"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	monitoringmocks "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+)
+
+var _ = Describe("PortfolioQuerier", func() {
+	var (
+		views      *monitoringmocks.ViewQuerier
+		portfolios live.PortfolioQuerier
+		now        time.Time
+	)
+
+	pnl := func(realized, unrealized, fees float64) *monitoring.PnLView {
+		return &monitoring.PnLView{
+			RealizedPnL:   numerical.NewFromFloat(realized),
+			UnrealizedPnL: numerical.NewFromFloat(unrealized),
+			TotalPnL:      numerical.NewFromFloat(realized + unrealized),
+			TotalFees:     numerical.NewFromFloat(fees),
+		}
+	}
+
+	trade := func(exchange, symbol string, side connector.OrderSide, quantity, price float64, ago time.Duration) connector.Trade {
+		return connector.Trade{
+			Exchange:  connector.ExchangeName(exchange),
+			Symbol:    symbol,
+			Side:      side,
+			Quantity:  numerical.NewFromFloat(quantity),
+			Price:     numerical.NewFromFloat(price),
+			Timestamp: now.Add(-ago),
+		}
+	}
+
+	BeforeEach(func() {
+		views = monitoringmocks.NewViewQuerier(GinkgoT())
+		portfolios = portfolio.NewPortfolioQuerier(views)
+		now = time.Now()
+	})
+
+	It("should fail when the instances can't be listed", func() {
+		views.EXPECT().ListInstances().Return(nil, errors.New("no socket dir")).Once()
+
+		_, err := portfolios.QueryPortfolio(context.Background())
+		Expect(err).To(MatchError(ContainSubstring("no socket dir")))
+	})
+
+	It("should return an empty portfolio without instances", func() {
+		views.EXPECT().ListInstances().Return([]string{}, nil).Once()
+
+		p, err := portfolios.QueryPortfolio(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(p.Strategies).To(BeEmpty())
+		Expect(p.Assets).To(BeEmpty())
+		Expect(p.Largest).To(BeEmpty())
+	})
+
+	It("should add up PnL and exposure across instances", func() {
+		views.EXPECT().ListInstances().Return([]string{"momentum", "arb", "down"}, nil).Once()
+
+		views.EXPECT().QueryPnL("momentum").Return(pnl(30, 10, 2), nil).Once()
+		views.EXPECT().QueryPositions("momentum").Return(&strategy.StrategyExecution{
+			Trades: []connector.Trade{
+				trade("binance", "BTC", connector.OrderSideBuy, 2, 100, 2*time.Minute),
+				trade("binance", "BTC", connector.OrderSideSell, 1, 110, time.Minute),
+				trade("binance", "ETH", connector.OrderSideSell, 3, 10, time.Minute),
+			},
+		}, nil).Once()
+
+		views.EXPECT().QueryPnL("arb").Return(pnl(-10, 0, 1), nil).Once()
+		views.EXPECT().QueryPositions("arb").Return(&strategy.StrategyExecution{
+			Trades: []connector.Trade{
+				trade("bybit", "BTC", connector.OrderSideSell, 0.5, 108, time.Minute),
+			},
+		}, nil).Once()
+
+		views.EXPECT().QueryPnL("down").Return(nil, errors.New("connection refused")).Once()
+
+		p, err := portfolios.QueryPortfolio(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+
+		By("totalling the PnL of the instances that answered")
+		Expect(p.RealizedPnL).To(BeNumerically("~", 20, 1e-9))
+		Expect(p.UnrealizedPnL).To(BeNumerically("~", 10, 1e-9))
+		Expect(p.TotalPnL).To(BeNumerically("~", 30, 1e-9))
+		Expect(p.Fees).To(BeNumerically("~", 3, 1e-9))
+
+		By("listing each instance's contribution, best first")
+		Expect(p.Strategies).To(HaveLen(3))
+		Expect(p.Strategies[0].InstanceID).To(Equal("momentum"))
+		Expect(p.Strategies[0].Positions).To(Equal(2))
+		Expect(p.Strategies[0].Share).To(BeNumerically("~", 0.8, 1e-9))
+		Expect(p.Strategies[1].InstanceID).To(Equal("down"))
+		Expect(p.Strategies[1].Error).To(ContainSubstring("connection refused"))
+		Expect(p.Strategies[2].InstanceID).To(Equal("arb"))
+		Expect(p.Strategies[2].Share).To(BeNumerically("~", -0.2, 1e-9))
+
+		By("netting each asset across strategies and exchanges at the latest fill")
+		Expect(p.Assets).To(HaveLen(2))
+		Expect(p.Assets[0].Asset).To(Equal("BTC"))
+		Expect(p.Assets[0].Quantity).To(BeNumerically("~", 0.5, 1e-9))
+		Expect(p.Assets[0].Notional).To(BeNumerically("~", 110-54, 1e-9))
+		Expect(p.Assets[0].Gross).To(BeNumerically("~", 110+54, 1e-9))
+		Expect(p.Assets[1].Asset).To(Equal("ETH"))
+		Expect(p.Assets[1].Notional).To(BeNumerically("~", -30, 1e-9))
+
+		By("netting each exchange across strategies and assets")
+		Expect(p.Exchanges).To(HaveLen(2))
+		Expect(p.Exchanges[0].Exchange).To(Equal("binance"))
+		Expect(p.Exchanges[0].Net).To(BeNumerically("~", 80, 1e-9))
+		Expect(p.Exchanges[0].Gross).To(BeNumerically("~", 140, 1e-9))
+		Expect(p.Exchanges[0].Positions).To(Equal(2))
+		Expect(p.Exchanges[1].Exchange).To(Equal("bybit"))
+		Expect(p.Exchanges[1].Net).To(BeNumerically("~", -54, 1e-9))
+
+		By("ranking holdings by notional")
+		Expect(p.Largest).To(HaveLen(3))
+		Expect(p.Largest[0].InstanceID).To(Equal("momentum"))
+		Expect(p.Largest[0].Asset).To(Equal("BTC"))
+		Expect(p.Largest[0].Price).To(BeNumerically("~", 110, 1e-9))
+		Expect(p.Largest[1].InstanceID).To(Equal("arb"))
+		Expect(p.Largest[2].Asset).To(Equal("ETH"))
+	})
+
+	It("should list an instance without positions with its PnL only", func() {
+		views.EXPECT().ListInstances().Return([]string{"idle"}, nil).Once()
+		views.EXPECT().QueryPnL("idle").Return(pnl(5, 0, 0), nil).Once()
+		views.EXPECT().QueryPositions("idle").Return(nil, nil).Once()
+
+		p, err := portfolios.QueryPortfolio(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(p.Strategies).To(HaveLen(1))
+		Expect(p.Strategies[0].TotalPnL).To(BeNumerically("~", 5, 1e-9))
+		Expect(p.Strategies[0].Share).To(BeNumerically("~", 1, 1e-9))
+		Expect(p.Assets).To(BeEmpty())
+	})
+})
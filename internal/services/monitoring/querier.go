@@ -1,60 +1,131 @@
 package monitoring
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"os"
 	"path/filepath"
+	"sync"
+	"syscall"
 	"time"
 
+	"github.com/backtesting-org/kronos-cli/internal/services/live/control"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
 	monitoring2 "github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring/health"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
 )
 
-// querier implements ViewQuerier - queries running strategy instances via Unix socket
+// idleConnTimeout is how long a connection to an instance is kept open between queries
+const idleConnTimeout = 90 * time.Second
+
+// querier implements ViewQuerier - queries running strategy instances via Unix socket.
+// It also implements live.ViewStreamer, subscribing through the instances' control sockets,
+// live.SummaryQuerier, live.OrderQuerier and live.SignalQuerier.
 type querier struct {
-	socketDir string
-	timeout   time.Duration
+	socketDir  string
+	controlDir string
+	timeout    time.Duration
+
+	mu         sync.Mutex
+	transports map[string]*http.Transport // by socket path, so connections are kept alive between polls
 }
 
 // NewQuerier creates a new ViewQuerier client
 func NewQuerier() monitoring2.ViewQuerier {
+	return newQuerier()
+}
+
+// NewQuerierWithConfig creates a ViewQuerier with custom socket directory
+func NewQuerierWithConfig(socketDir string, timeout time.Duration) monitoring2.ViewQuerier {
+	return newQuerierWithConfig(socketDir, timeout)
+}
+
+// NewStreamer creates a ViewStreamer for instances on this machine
+func NewStreamer() live.ViewStreamer {
+	return newQuerier()
+}
+
+// NewStreamerWithConfig creates a ViewStreamer with custom socket directory;
+// control sockets are expected in a "control" directory next to it, as they are under ~/.kronos
+func NewStreamerWithConfig(socketDir string, timeout time.Duration) live.ViewStreamer {
+	return newQuerierWithConfig(socketDir, timeout)
+}
+
+func newQuerier() *querier {
 	homeDir, _ := os.UserHomeDir()
 	return &querier{
-		socketDir: filepath.Join(homeDir, ".kronos", "sockets"),
-		timeout:   5 * time.Second,
+		socketDir:  filepath.Join(homeDir, ".kronos", "sockets"),
+		controlDir: control.DefaultSocketDir(),
+		timeout:    5 * time.Second,
+		transports: make(map[string]*http.Transport),
 	}
 }
 
-// NewQuerierWithConfig creates a ViewQuerier with custom socket directory
-func NewQuerierWithConfig(socketDir string, timeout time.Duration) monitoring2.ViewQuerier {
+func newQuerierWithConfig(socketDir string, timeout time.Duration) *querier {
 	return &querier{
-		socketDir: socketDir,
-		timeout:   timeout,
+		socketDir:  socketDir,
+		controlDir: filepath.Join(filepath.Dir(socketDir), "control"),
+		timeout:    timeout,
+		transports: make(map[string]*http.Transport),
+	}
+}
+
+// transport returns the transport for a socket, creating it on first use. Transports are kept
+// so that polling an instance reuses its connection instead of dialing for every query.
+func (q *querier) transport(socketPath string) *http.Transport {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if transport, ok := q.transports[socketPath]; ok {
+		return transport
+	}
+
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", socketPath)
+		},
+		MaxIdleConnsPerHost: 4,
+		IdleConnTimeout:     idleConnTimeout,
+	}
+	q.transports[socketPath] = transport
+	return transport
+}
+
+// dropTransports forgets the transports of sockets that keep(path) rejects, closing their idle connections
+func (q *querier) dropTransports(keep func(socketPath string) bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for socketPath, transport := range q.transports {
+		if !keep(socketPath) {
+			transport.CloseIdleConnections()
+			delete(q.transports, socketPath)
+		}
 	}
 }
 
-// getClient creates an HTTP client that connects via Unix socket
+// getClient returns an HTTP client that connects via Unix socket
 func (q *querier) getClient(instanceID string) (*http.Client, string, error) {
 	socketPath := filepath.Join(q.socketDir, fmt.Sprintf("%s.sock", instanceID))
 
 	// Check socket exists
 	if _, err := os.Stat(socketPath); os.IsNotExist(err) {
+		q.dropTransports(func(path string) bool { return path != socketPath })
 		return nil, "", fmt.Errorf("instance %s not found (socket does not exist)", instanceID)
 	}
 
 	client := &http.Client{
-		Timeout: q.timeout,
-		Transport: &http.Transport{
-			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
-				return net.Dial("unix", socketPath)
-			},
-		},
+		Timeout:   q.timeout,
+		Transport: q.transport(socketPath),
 	}
 
 	return client, socketPath, nil
@@ -62,13 +133,23 @@ func (q *querier) getClient(instanceID string) (*http.Client, string, error) {
 
 // doRequest performs an HTTP request to the instance
 func (q *querier) doRequest(instanceID, path string, result interface{}) error {
+	return q.doRequestContext(context.Background(), instanceID, path, result)
+}
+
+// doRequestContext performs an HTTP request to the instance that ctx can cut short
+func (q *querier) doRequestContext(ctx context.Context, instanceID, path string, result interface{}) error {
 	client, _, err := q.getClient(instanceID)
 	if err != nil {
 		return err
 	}
 
 	// Use "http://unix" as dummy host - actual connection is via socket
-	resp, err := client.Get(fmt.Sprintf("http://unix%s", path))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://unix%s", path), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := client.Do(req)
 	if err != nil {
 		return fmt.Errorf("failed to connect to instance %s: %w", instanceID, err)
 	}
@@ -87,6 +168,37 @@ func (q *querier) doRequest(instanceID, path string, result interface{}) error {
 	return nil
 }
 
+// doControlRequest performs an HTTP request to the instance's control socket,
+// which serves what the SDK's monitoring server doesn't
+func (q *querier) doControlRequest(instanceID, path string, result interface{}) error {
+	socketPath := control.SocketPath(q.controlDir, instanceID)
+	if _, err := os.Stat(socketPath); os.IsNotExist(err) {
+		q.dropTransports(func(path string) bool { return path != socketPath })
+		return fmt.Errorf("instance %s has no control socket", instanceID)
+	}
+
+	client := &http.Client{Timeout: q.timeout, Transport: q.transport(socketPath)}
+	resp, err := client.Get("http://unix" + path)
+	if err != nil {
+		return fmt.Errorf("failed to connect to instance %s: %w", instanceID, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return fmt.Errorf("instance %s doesn't serve %s, it was started by an older kronos", instanceID, path)
+	default:
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("instance %s returned status %d: %s", instanceID, resp.StatusCode, bytes.TrimSpace(msg))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
 // QueryPnL retrieves PnL snapshot from a running instance
 func (q *querier) QueryPnL(instanceID string) (*monitoring2.PnLView, error) {
 	var result monitoring2.PnLView
@@ -158,29 +270,38 @@ func (q *querier) HealthCheck(instanceID string) error {
 	return err
 }
 
-// Shutdown sends graceful shutdown command to instance via HTTP
-// Note: This sends the command to the strategy process's monitoring server
+// Shutdown asks the instance's monitoring server to shut the strategy down. A socket that is gone or refuses
+// connections means the instance already stopped; any other failure to get the request accepted is an error,
+// as the strategy may still be trading.
 func (q *querier) Shutdown(instanceID string) error {
-	client, _, err := q.getClient(instanceID)
-	if err != nil {
-		return err
-	}
+	socketPath := filepath.Join(q.socketDir, fmt.Sprintf("%s.sock", instanceID))
 
-	req, err := http.NewRequest(http.MethodPost, "http://unix/shutdown", nil)
-	if err != nil {
-		return fmt.Errorf("failed to create shutdown request: %w", err)
+	// A connection of its own, so a kept-alive one to an instance that is going away can't fail the request
+	client := &http.Client{
+		Timeout: q.timeout,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", socketPath)
+			},
+			DisableKeepAlives: true,
+		},
 	}
 
-	client.Timeout = 2 * time.Second
-
-	resp, err := client.Do(req)
+	resp, err := client.Post("http://unix/shutdown", "application/json", nil)
 	if err != nil {
-		// If connection fails, the process might have already shut down
-		// This is actually success for our purposes
-		return nil
+		if errors.Is(err, syscall.ENOENT) || errors.Is(err, syscall.ECONNREFUSED) {
+			q.dropTransports(func(path string) bool { return path != socketPath })
+			return nil
+		}
+		return fmt.Errorf("failed to send shutdown to instance %s: %w", instanceID, err)
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("instance %s refused shutdown with status %d: %s", instanceID, resp.StatusCode, bytes.TrimSpace(msg))
+	}
 	return nil
 }
 
@@ -195,13 +316,20 @@ func (q *querier) ListInstances() ([]string, error) {
 	}
 
 	var instances []string
+	listed := make(map[string]bool)
 	for _, entry := range entries {
 		if !entry.IsDir() && filepath.Ext(entry.Name()) == ".sock" {
 			instanceID := entry.Name()[:len(entry.Name())-5] // Remove .sock extension
 			instances = append(instances, instanceID)
+			listed[filepath.Join(q.socketDir, entry.Name())] = true
 		}
 	}
 
+	// Connections to instances that are gone are of no more use
+	q.dropTransports(func(socketPath string) bool {
+		return listed[socketPath] || filepath.Dir(socketPath) != q.socketDir
+	})
+
 	return instances, nil
 }
 
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	monitoring2 "github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
@@ -206,6 +207,61 @@ var _ = Describe("Querier", func() {
 		})
 	})
 
+	Describe("Shutdown", func() {
+		It("should ask the instance to shut down", func() {
+			received := make(chan string, 1)
+			mux := http.NewServeMux()
+			mux.HandleFunc("/shutdown", func(w http.ResponseWriter, r *http.Request) {
+				received <- r.Method
+			})
+			startMockServer(mux)
+
+			Expect(querier.Shutdown(instanceID)).To(Succeed())
+			Expect(received).To(Receive(Equal(http.MethodPost)))
+		})
+
+		It("should treat a missing socket as already stopped", func() {
+			Expect(querier.Shutdown("nonexistent")).To(Succeed())
+		})
+
+		It("should treat a socket nothing listens on as already stopped", func() {
+			listener, err := net.ListenUnix("unix", &net.UnixAddr{Name: filepath.Join(tmpDir, instanceID+".sock"), Net: "unix"})
+			Expect(err).NotTo(HaveOccurred())
+			listener.SetUnlinkOnClose(false)
+			Expect(listener.Close()).To(Succeed())
+
+			Expect(querier.Shutdown(instanceID)).To(Succeed())
+		})
+
+		It("should fail when the socket can't be dialed", func() {
+			// Longer than a Unix socket path may be
+			Expect(querier.Shutdown(strings.Repeat("a", 120))).To(MatchError(ContainSubstring("failed to send shutdown")))
+		})
+
+		It("should fail when the instance doesn't answer in time", func() {
+			querier = monitoring.NewQuerierWithConfig(tmpDir, 50*time.Millisecond)
+			release := make(chan struct{})
+			mux := http.NewServeMux()
+			mux.HandleFunc("/shutdown", func(w http.ResponseWriter, r *http.Request) {
+				<-release
+			})
+			startMockServer(mux)
+
+			Expect(querier.Shutdown(instanceID)).To(MatchError(ContainSubstring("failed to send shutdown")))
+			close(release)
+		})
+
+		It("should fail when the instance refuses", func() {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/shutdown", func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "shutdown already in progress", http.StatusServiceUnavailable)
+			})
+			startMockServer(mux)
+
+			Expect(querier.Shutdown(instanceID)).To(MatchError(ContainSubstring("status 503: shutdown already in progress")))
+		})
+	})
+
 	Describe("ListInstances", func() {
 		It("should return empty list when no instances", func() {
 			instances, err := querier.ListInstances()
@@ -0,0 +1,245 @@
+package report
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/series"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+)
+
+// Table is the tabular form of a view, shared by table and CSV output
+type Table struct {
+	Headers []string
+	Rows    [][]string
+}
+
+// Report is one snapshot of a view: the value itself for JSON, and its table for table and CSV output
+type Report struct {
+	Data  any
+	Table Table
+}
+
+// HealthReport is what the health view reports for an instance
+type HealthReport struct {
+	InstanceID string `json:"instance_id"`
+	Healthy    bool   `json:"healthy"`
+	Error      string `json:"error,omitempty"`
+}
+
+// PnL reports realized, unrealized and total PnL with fees, in a single row
+func PnL(view *monitoring.PnLView) Report {
+	return Report{
+		Data: view,
+		Table: Table{
+			Headers: []string{"STRATEGY", "REALIZED", "UNREALIZED", "TOTAL", "FEES"},
+			Rows: [][]string{{
+				view.StrategyName,
+				decimal(view.RealizedPnL),
+				decimal(view.UnrealizedPnL),
+				decimal(view.TotalPnL),
+				decimal(view.TotalFees),
+			}},
+		},
+	}
+}
+
+// Positions reports the open orders of an instance, one per row
+func Positions(execution *strategy.StrategyExecution) Report {
+	table := Table{
+		Headers: []string{"ID", "SYMBOL", "SIDE", "TYPE", "STATUS", "QTY", "PRICE", "FILLED", "CREATED"},
+	}
+	for _, order := range execution.Orders {
+		table.Rows = append(table.Rows, []string{
+			order.ID,
+			order.Symbol,
+			string(order.Side),
+			string(order.Type),
+			string(order.Status),
+			decimal(order.Quantity),
+			decimal(order.Price),
+			decimal(order.FilledQty),
+			timestamp(order.CreatedAt),
+		})
+	}
+	return Report{Data: execution, Table: table}
+}
+
+// Trades reports recent trades, one per row
+func Trades(trades []connector.Trade) Report {
+	table := Table{
+		Headers: []string{"TIME", "ID", "SYMBOL", "EXCHANGE", "SIDE", "PRICE", "QTY", "FEE", "MAKER"},
+	}
+	for _, trade := range trades {
+		table.Rows = append(table.Rows, []string{
+			timestamp(trade.Timestamp),
+			trade.ID,
+			trade.Symbol,
+			string(trade.Exchange),
+			string(trade.Side),
+			decimal(trade.Price),
+			decimal(trade.Quantity),
+			decimal(trade.Fee),
+			strconv.FormatBool(trade.IsMaker),
+		})
+	}
+	if trades == nil {
+		// Encode as an empty list rather than null
+		trades = []connector.Trade{}
+	}
+	return Report{Data: trades, Table: table}
+}
+
+// Orderbook reports the best depth levels of each side, asks from the highest down to bids from the highest down.
+// A depth of zero reports every level.
+func Orderbook(book *connector.OrderBook, depth int) Report {
+	asks, bids := book.Asks, book.Bids
+	if depth > 0 {
+		asks, bids = asks[:min(depth, len(asks))], bids[:min(depth, len(bids))]
+	}
+
+	table := Table{Headers: []string{"SIDE", "PRICE", "QTY"}}
+	for i := len(asks) - 1; i >= 0; i-- {
+		table.Rows = append(table.Rows, []string{"ask", decimal(asks[i].Price), decimal(asks[i].Quantity)})
+	}
+	for _, level := range bids {
+		table.Rows = append(table.Rows, []string{"bid", decimal(level.Price), decimal(level.Quantity)})
+	}
+
+	trimmed := *book
+	trimmed.Asks, trimmed.Bids = asks, bids
+	return Report{Data: &trimmed, Table: table}
+}
+
+// Metrics reports the signal counters, latency and PnL of an instance, in a single row
+func Metrics(metrics *monitoring.StrategyMetrics) Report {
+	return Report{
+		Data: metrics,
+		Table: Table{
+			Headers: []string{"STRATEGY", "STATUS", "LAST SIGNAL", "GENERATED", "EXECUTED", "FAILED", "LATENCY", "POSITIONS", "DAILY PNL", "WEEKLY PNL", "MONTHLY PNL"},
+			Rows: [][]string{{
+				metrics.StrategyName,
+				metrics.Status,
+				timestamp(metrics.LastSignalTime),
+				strconv.Itoa(metrics.SignalsGenerated),
+				strconv.Itoa(metrics.SignalsExecuted),
+				strconv.Itoa(metrics.SignalsFailed),
+				metrics.AverageLatency.String(),
+				strconv.Itoa(metrics.ActivePositions),
+				float(metrics.DailyPnL),
+				float(metrics.WeeklyPnL),
+				float(metrics.MonthlyPnL),
+			}},
+		},
+	}
+}
+
+// Health reports whether an instance answered its health check
+func Health(instanceID string, err error) Report {
+	health := &HealthReport{InstanceID: instanceID, Healthy: err == nil}
+	if err != nil {
+		health.Error = err.Error()
+	}
+	return Report{
+		Data: health,
+		Table: Table{
+			Headers: []string{"INSTANCE", "HEALTHY", "ERROR"},
+			Rows:    [][]string{{instanceID, strconv.FormatBool(health.Healthy), health.Error}},
+		},
+	}
+}
+
+// Profiling reports the execution timing statistics of an instance, in a single row
+func Profiling(stats *monitoring.ProfilingStats) Report {
+	return Report{
+		Data: stats,
+		Table: Table{
+			Headers: []string{"STRATEGY", "RUNS", "SUCCEEDED", "FAILED", "SUCCESS RATE", "AVG", "MIN", "MAX", "P50", "P95", "P99", "LAST RUN"},
+			Rows: [][]string{{
+				stats.StrategyName,
+				strconv.Itoa(stats.TotalRuns),
+				strconv.Itoa(stats.SuccessCount),
+				strconv.Itoa(stats.FailureCount),
+				fmt.Sprintf("%.1f%%", stats.SuccessRate),
+				stats.AvgDuration.String(),
+				stats.MinDuration.String(),
+				stats.MaxDuration.String(),
+				stats.P50.String(),
+				stats.P95.String(),
+				stats.P99.String(),
+				timestamp(stats.LastExecution),
+			}},
+		},
+	}
+}
+
+// Analysis reports a strategy's recorded history over a window, in a single row
+func Analysis(analysis *series.Analysis) Report {
+	return Report{
+		Data: analysis,
+		Table: Table{
+			Headers: []string{"STRATEGY", "FROM", "UNTIL", "INSTANCES", "PNL", "FEES", "PEAK", "MAX DRAWDOWN", "WIN DAYS", "LOSS DAYS", "BEST DAY", "WORST DAY", "SHARPE", "AVG LATENCY", "MAX P99"},
+			Rows: [][]string{{
+				analysis.StrategyName,
+				timestamp(analysis.From),
+				timestamp(analysis.Until),
+				strconv.Itoa(analysis.Instances),
+				money(analysis.PnL),
+				money(analysis.Fees),
+				money(analysis.Peak),
+				money(analysis.MaxDrawdown),
+				strconv.Itoa(analysis.WinningDays),
+				strconv.Itoa(analysis.LosingDays),
+				money(analysis.BestDay),
+				money(analysis.WorstDay),
+				strconv.FormatFloat(analysis.Sharpe, 'f', 2, 64),
+				analysis.AvgLatency.String(),
+				analysis.MaxP99.String(),
+			}},
+		},
+	}
+}
+
+// Days reports a strategy's equity at the close of each day, one per row
+func Days(days []series.Day) Report {
+	table := Table{
+		Headers: []string{"DATE", "CLOSE", "CHANGE", "DRAWDOWN"},
+	}
+	for _, day := range days {
+		table.Rows = append(table.Rows, []string{
+			day.Date.Format("2006-01-02"),
+			money(day.Close),
+			money(day.Change),
+			money(day.Drawdown),
+		})
+	}
+	if days == nil {
+		days = []series.Day{}
+	}
+	return Report{Data: days, Table: table}
+}
+
+func decimal(d numerical.Decimal) string {
+	return d.String()
+}
+
+func float(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// money rounds a float PnL figure to cents
+func money(f float64) string {
+	return strconv.FormatFloat(f, 'f', 2, 64)
+}
+
+// timestamp formats t as RFC 3339 in UTC so scripts can parse it, or empty if unset
+func timestamp(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,123 @@
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Format is how reports are written
+type Format string
+
+const (
+	FormatTable Format = "table"
+	FormatJSON  Format = "json"
+	FormatCSV   Format = "csv"
+)
+
+// ParseFormat validates an output format given on the command line
+func ParseFormat(name string) (Format, error) {
+	switch format := Format(strings.ToLower(name)); format {
+	case FormatTable, FormatJSON, FormatCSV:
+		return format, nil
+	}
+	return "", fmt.Errorf("unknown output format %q, expected table, json or csv", name)
+}
+
+// Writer writes reports in one format. When watching, every report is one of a series:
+// JSON is written one compact object per line, CSV writes its header once and adds a time column,
+// and tables are each preceded by the time they were taken.
+type Writer struct {
+	out         io.Writer
+	format      Format
+	watch       bool
+	wroteHeader bool
+}
+
+func NewWriter(out io.Writer, format Format, watch bool) *Writer {
+	return &Writer{
+		out:    out,
+		format: format,
+		watch:  watch,
+	}
+}
+
+// Write writes a report taken at the given time
+func (w *Writer) Write(report Report, at time.Time) error {
+	switch w.format {
+	case FormatJSON:
+		return w.writeJSON(report, at)
+	case FormatCSV:
+		return w.writeCSV(report, at)
+	default:
+		return w.writeTable(report, at)
+	}
+}
+
+func (w *Writer) writeJSON(report Report, at time.Time) error {
+	encoder := json.NewEncoder(w.out)
+	if !w.watch {
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report.Data)
+	}
+
+	// Each line carries its time so a stream piped to jq or a file stays self-describing
+	return encoder.Encode(struct {
+		Time time.Time `json:"time"`
+		Data any       `json:"data"`
+	}{at.UTC(), report.Data})
+}
+
+func (w *Writer) writeCSV(report Report, at time.Time) error {
+	writer := csv.NewWriter(w.out)
+
+	if !w.wroteHeader {
+		headers := report.Table.Headers
+		if w.watch {
+			headers = append([]string{"TIME"}, headers...)
+		}
+		if err := writer.Write(headers); err != nil {
+			return err
+		}
+		w.wroteHeader = true
+	}
+
+	for _, row := range report.Table.Rows {
+		if w.watch {
+			row = append([]string{timestamp(at)}, row...)
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func (w *Writer) writeTable(report Report, at time.Time) error {
+	if w.watch {
+		if _, err := fmt.Fprintf(w.out, "%s\n", at.Local().Format("2006-01-02 15:04:05")); err != nil {
+			return err
+		}
+	}
+
+	tw := tabwriter.NewWriter(w.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(report.Table.Headers, "\t"))
+	for _, row := range report.Table.Rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if w.watch {
+		_, err := fmt.Fprintln(w.out)
+		return err
+	}
+	return nil
+}
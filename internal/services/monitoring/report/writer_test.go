@@ -0,0 +1,123 @@
+package report_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/monitoring/report"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+)
+
+var _ = Describe("Writer", func() {
+	var (
+		out bytes.Buffer
+		at  = time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+		pnl = report.PnL(&monitoring.PnLView{
+			StrategyName: "momentum",
+			RealizedPnL:  numerical.NewFromInt(40),
+			TotalPnL:     numerical.NewFromInt(42),
+		})
+	)
+
+	BeforeEach(func() {
+		out.Reset()
+	})
+
+	It("rejects unknown formats", func() {
+		_, err := report.ParseFormat("yaml")
+		Expect(err).To(HaveOccurred())
+
+		format, err := report.ParseFormat("JSON")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(format).To(Equal(report.FormatJSON))
+	})
+
+	It("writes an aligned table", func() {
+		writer := report.NewWriter(&out, report.FormatTable, false)
+		Expect(writer.Write(pnl, at)).To(Succeed())
+
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		Expect(lines).To(HaveLen(2))
+		Expect(strings.Fields(lines[0])).To(Equal([]string{"STRATEGY", "REALIZED", "UNREALIZED", "TOTAL", "FEES"}))
+		Expect(strings.Fields(lines[1])).To(Equal([]string{"momentum", "40", "0", "42", "0"}))
+		Expect(strings.Index(lines[1], "40")).To(Equal(strings.Index(lines[0], "REALIZED")))
+	})
+
+	It("writes the view itself as JSON", func() {
+		writer := report.NewWriter(&out, report.FormatJSON, false)
+		Expect(writer.Write(pnl, at)).To(Succeed())
+
+		var view monitoring.PnLView
+		Expect(json.Unmarshal(out.Bytes(), &view)).To(Succeed())
+		Expect(view.StrategyName).To(Equal("momentum"))
+		Expect(view.TotalPnL.IntPart()).To(Equal(int64(42)))
+	})
+
+	It("writes one timed JSON object per line when watching", func() {
+		writer := report.NewWriter(&out, report.FormatJSON, true)
+		Expect(writer.Write(pnl, at)).To(Succeed())
+		Expect(writer.Write(pnl, at.Add(time.Second))).To(Succeed())
+
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		Expect(lines).To(HaveLen(2))
+
+		var line struct {
+			Time time.Time          `json:"time"`
+			Data monitoring.PnLView `json:"data"`
+		}
+		Expect(json.Unmarshal([]byte(lines[1]), &line)).To(Succeed())
+		Expect(line.Time).To(BeTemporally("==", at.Add(time.Second)))
+		Expect(line.Data.StrategyName).To(Equal("momentum"))
+	})
+
+	It("writes the CSV header once and adds the time when watching", func() {
+		writer := report.NewWriter(&out, report.FormatCSV, true)
+		Expect(writer.Write(pnl, at)).To(Succeed())
+		Expect(writer.Write(pnl, at.Add(time.Minute))).To(Succeed())
+
+		Expect(out.String()).To(Equal(
+			"TIME,STRATEGY,REALIZED,UNREALIZED,TOTAL,FEES\n" +
+				"2025-03-01T12:00:00Z,momentum,40,0,42,0\n" +
+				"2025-03-01T12:01:00Z,momentum,40,0,42,0\n"))
+	})
+
+	It("writes an empty list of trades as [] rather than null", func() {
+		writer := report.NewWriter(&out, report.FormatJSON, false)
+		Expect(writer.Write(report.Trades(nil), at)).To(Succeed())
+		Expect(strings.TrimSpace(out.String())).To(Equal("[]"))
+	})
+
+	It("reports the best levels of an orderbook, asks above bids", func() {
+		level := func(price, qty int64) connector.PriceLevel {
+			return connector.PriceLevel{Price: numerical.NewFromInt(price), Quantity: numerical.NewFromInt(qty)}
+		}
+		book := &connector.OrderBook{
+			Asks: []connector.PriceLevel{level(101, 1), level(102, 2), level(103, 3)},
+			Bids: []connector.PriceLevel{level(100, 4), level(99, 5), level(98, 6)},
+		}
+
+		orderbook := report.Orderbook(book, 2)
+		Expect(orderbook.Table.Rows).To(Equal([][]string{
+			{"ask", "102", "2"},
+			{"ask", "101", "1"},
+			{"bid", "100", "4"},
+			{"bid", "99", "5"},
+		}))
+		Expect(orderbook.Data.(*connector.OrderBook).Asks).To(HaveLen(2))
+		Expect(book.Asks).To(HaveLen(3))
+	})
+
+	It("reports an unhealthy instance with its error", func() {
+		health := report.Health("momentum", errors.New("socket does not exist"))
+		Expect(health.Table.Rows).To(Equal([][]string{{"momentum", "false", "socket does not exist"}}))
+		Expect(health.Data.(*report.HealthReport).Healthy).To(BeFalse())
+	})
+})
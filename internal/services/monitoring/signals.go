@@ -0,0 +1,45 @@
+package monitoring
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/control"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+// SignalsPath is where a strategy's control socket serves the signals it generated
+const SignalsPath = "/signals"
+
+// NewSignalsHandler serves the latest signals of this strategy process and what became of them.
+// The SDK's metrics only count signals, so they are journaled in-process by an execution hook.
+func NewSignalsHandler(journal live.SignalJournal) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		limit := live.DefaultSignalLimit
+		if raw := r.URL.Query().Get("limit"); raw != "" {
+			parsed, err := strconv.Atoi(raw)
+			if err != nil || parsed < 0 {
+				http.Error(w, fmt.Sprintf("invalid limit %q", raw), http.StatusBadRequest)
+				return
+			}
+			limit = parsed
+		}
+
+		control.WriteJSON(w, journal.Recent(limit))
+	}
+}
+
+// QuerySignals fetches the latest signals of an instance through its control socket
+func (q *querier) QuerySignals(instanceID string, limit int) ([]live.SignalRecord, error) {
+	var result []live.SignalRecord
+	if err := q.doControlRequest(instanceID, fmt.Sprintf("%s?limit=%d", SignalsPath, limit), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
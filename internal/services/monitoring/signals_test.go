@@ -0,0 +1,84 @@
+package monitoring_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/control"
+	"github.com/backtesting-org/kronos-cli/internal/services/monitoring"
+	livemocks "github.com/backtesting-org/kronos-cli/mocks/github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+)
+
+var _ = Describe("Signals", func() {
+	var (
+		tmpDir  string
+		journal *livemocks.SignalJournal
+		querier live.SignalQuerier
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = os.MkdirTemp("", "signals-test-*")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(func() { _ = os.RemoveAll(tmpDir) })
+
+		journal = livemocks.NewSignalJournal(GinkgoT())
+		querier = monitoring.NewQuerierWithConfig(filepath.Join(tmpDir, "sockets"), 5*time.Second).(live.SignalQuerier)
+
+		server := control.NewServer(filepath.Join(tmpDir, "control"), "momentum")
+		server.Handle(monitoring.SignalsPath, monitoring.NewSignalsHandler(journal))
+		Expect(server.Start()).To(Succeed())
+		DeferCleanup(func() { _ = server.Stop(context.Background()) })
+	})
+
+	It("fetches the instance's latest signals through its control socket", func() {
+		journal.EXPECT().Recent(50).Return([]live.SignalRecord{
+			{ID: "s-1", Outcome: live.SignalExecuted, OrderIDs: []string{"o-1"}},
+			{ID: "s-2", Outcome: live.SignalInsufficientBalance, Reason: "failed to place order: insufficient margin"},
+		}).Once()
+
+		records, err := querier.QuerySignals("momentum", 50)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(records).To(HaveLen(2))
+		Expect(records[0].OrderIDs).To(Equal([]string{"o-1"}))
+		Expect(records[1].Outcome).To(Equal(live.SignalInsufficientBalance))
+		Expect(records[1].Executed()).To(BeFalse())
+	})
+
+	It("reports instances without a control socket", func() {
+		_, err := querier.QuerySignals("other", 50)
+		Expect(err).To(MatchError(ContainSubstring("has no control socket")))
+	})
+})
+
+var _ = Describe("SignalRecord", func() {
+	record := live.SignalRecord{
+		ID: "s-1",
+		Actions: []live.SignalAction{
+			{Action: strategy.ActionBuy, Exchange: "paradex", Asset: "BTC"},
+			{Action: strategy.ActionSell, Exchange: "bybit", Asset: "BTC"},
+			{Action: strategy.ActionSell, Exchange: "bybit", Asset: "ETH"},
+		},
+		Outcome: live.SignalRejected,
+		Reason:  "Max position exceeded",
+	}
+
+	It("lists each asset once", func() {
+		Expect(record.Assets()).To(Equal([]string{"BTC", "ETH"}))
+	})
+
+	It("matches by outcome and by search in its actions and reason", func() {
+		Expect(record.Matches("", "")).To(BeTrue())
+		Expect(record.Matches(live.SignalRejected, "eth")).To(BeTrue())
+		Expect(record.Matches("", "max position")).To(BeTrue())
+		Expect(record.Matches(live.SignalExecuted, "")).To(BeFalse())
+		Expect(record.Matches("", "sol")).To(BeFalse())
+	})
+})
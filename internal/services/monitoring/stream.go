@@ -0,0 +1,242 @@
+package monitoring
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/control"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	monitoring2 "github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+)
+
+const (
+	// StreamPath is where a strategy's control socket serves its view stream
+	StreamPath = "/stream"
+
+	// DefaultStreamInterval is how often a strategy samples its views for changes to push
+	DefaultStreamInterval = 250 * time.Millisecond
+
+	// streamKeepAlive is how often an idle stream sends a comment, so dead subscribers are noticed
+	streamKeepAlive = 15 * time.Second
+
+	// maxStreamEvent bounds a single event a subscriber accepts; deep orderbooks are the largest
+	maxStreamEvent = 4 << 20
+)
+
+// NewStreamHandler serves the views of this strategy process as server-sent events.
+// The views are sampled in-process every interval and only the ones that changed are sent,
+// so subscribers get updates as they happen without a request per view.
+func NewStreamHandler(views monitoring2.ViewRegistry, interval time.Duration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		req, err := live.ParseStreamRequest(r.URL.Query())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		WriteStream(w, r, WatchViews(r.Context(), views, req, interval))
+	}
+}
+
+// WatchViews samples the requested views every interval and sends those that changed since they were last sent.
+// The channel is closed once ctx is done.
+func WatchViews(ctx context.Context, views monitoring2.ViewRegistry, req live.StreamRequest, interval time.Duration) <-chan live.StreamUpdate {
+	updates := make(chan live.StreamUpdate)
+
+	go func() {
+		defer close(updates)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		last := make(map[live.StreamTopic][]byte, len(req.Topics))
+		for {
+			for _, topic := range req.Topics {
+				update, ok := sampleView(views, req, topic)
+				if !ok {
+					continue
+				}
+
+				// Compare the encoded view, the update's own time always differs
+				encoded, err := json.Marshal(update)
+				if err != nil || bytes.Equal(encoded, last[topic]) {
+					continue
+				}
+				last[topic] = encoded
+
+				update.Time = time.Now()
+				select {
+				case updates <- update:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return updates
+}
+
+// sampleView reads the current value of one view; false if the strategy has nothing for it yet
+func sampleView(views monitoring2.ViewRegistry, req live.StreamRequest, topic live.StreamTopic) (live.StreamUpdate, bool) {
+	update := live.StreamUpdate{Topic: topic}
+
+	switch topic {
+	case live.StreamPnL:
+		update.PnL = views.GetPnLView()
+		return update, update.PnL != nil
+	case live.StreamPositions:
+		update.Positions = views.GetPositionsView()
+		return update, update.Positions != nil
+	case live.StreamOrderbook:
+		update.Orderbook = views.GetOrderbookView(req.Asset)
+		return update, update.Orderbook != nil
+	case live.StreamTrades:
+		update.Trades = views.GetRecentTrades(req.TradeLimit)
+		return update, true
+	}
+
+	return update, false
+}
+
+// WriteStream sends updates to the client as server-sent events until the channel closes or the client goes away
+func WriteStream(w http.ResponseWriter, r *http.Request, updates <-chan live.StreamUpdate) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(streamKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case update, ok := <-updates:
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(update)
+			if err != nil {
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", update.Topic, data); err != nil {
+				return
+			}
+
+		case <-keepAlive.C:
+			if _, err := io.WriteString(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+
+		case <-r.Context().Done():
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+// ReadStream decodes server-sent events written by WriteStream from body.
+// The channel is closed, and body with it, when the stream ends or ctx is done.
+func ReadStream(ctx context.Context, body io.ReadCloser) <-chan live.StreamUpdate {
+	updates := make(chan live.StreamUpdate)
+
+	go func() {
+		defer close(updates)
+		defer body.Close()
+
+		// Closing the body unblocks the scanner when ctx ends mid-read
+		stop := context.AfterFunc(ctx, func() { _ = body.Close() })
+		defer stop()
+
+		scanner := bufio.NewScanner(body)
+		scanner.Buffer(make([]byte, 64*1024), maxStreamEvent)
+
+		var data []byte
+		for scanner.Scan() {
+			line := scanner.Bytes()
+			switch {
+			case len(line) == 0:
+				// A blank line ends the event
+				if len(data) == 0 {
+					continue
+				}
+				var update live.StreamUpdate
+				err := json.Unmarshal(data, &update)
+				data = data[:0]
+				if err != nil {
+					continue
+				}
+				select {
+				case updates <- update:
+				case <-ctx.Done():
+					return
+				}
+
+			case bytes.HasPrefix(line, []byte("data:")):
+				data = append(data, bytes.TrimPrefix(bytes.TrimPrefix(line, []byte("data:")), []byte(" "))...)
+			}
+			// Comments and event names need no handling; the topic is part of the data
+		}
+	}()
+
+	return updates
+}
+
+// Subscribe streams views of an instance through its control socket.
+// Instances without one, or started by a kronos that predates streaming, return ErrStreamUnsupported.
+func (q *querier) Subscribe(ctx context.Context, instanceID string, req live.StreamRequest) (<-chan live.StreamUpdate, error) {
+	socketPath := control.SocketPath(q.controlDir, instanceID)
+	if _, err := os.Stat(socketPath); os.IsNotExist(err) {
+		q.dropTransports(func(path string) bool { return path != socketPath })
+		return nil, fmt.Errorf("instance %s has no control socket: %w", instanceID, live.ErrStreamUnsupported)
+	}
+
+	// No client timeout: the response lasts as long as the subscription, ctx ends it
+	client := &http.Client{Transport: q.transport(socketPath)}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://unix"+StreamPath+"?"+req.Values().Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stream request: %w", err)
+	}
+
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to instance %s: %w", instanceID, err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return ReadStream(ctx, resp.Body), nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, fmt.Errorf("instance %s: %w", instanceID, live.ErrStreamUnsupported)
+	default:
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		resp.Body.Close()
+		return nil, fmt.Errorf("instance %s returned status %d: %s", instanceID, resp.StatusCode, bytes.TrimSpace(msg))
+	}
+}
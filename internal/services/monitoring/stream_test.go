@@ -0,0 +1,106 @@
+package monitoring_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/live/control"
+	"github.com/backtesting-org/kronos-cli/internal/services/monitoring"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	monitoringmocks "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	monitoring2 "github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+)
+
+var _ = Describe("Streamer", func() {
+	var (
+		tmpDir   string
+		views    *monitoringmocks.ViewRegistry
+		streamer live.ViewStreamer
+		total    atomic.Int64
+	)
+
+	pnlRequest := live.StreamRequest{Topics: []live.StreamTopic{live.StreamPnL}}
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = os.MkdirTemp("", "stream-test-*")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(func() { _ = os.RemoveAll(tmpDir) })
+
+		total.Store(100)
+		views = monitoringmocks.NewViewRegistry(GinkgoT())
+		views.EXPECT().GetPnLView().RunAndReturn(func() *monitoring2.PnLView {
+			return &monitoring2.PnLView{StrategyName: "momentum", TotalPnL: numerical.NewFromInt(total.Load())}
+		}).Maybe()
+
+		streamer = monitoring.NewStreamerWithConfig(filepath.Join(tmpDir, "sockets"), 5*time.Second)
+	})
+
+	startStrategy := func() {
+		server := control.NewServer(filepath.Join(tmpDir, "control"), "momentum")
+		server.Handle(monitoring.StreamPath, monitoring.NewStreamHandler(views, 10*time.Millisecond))
+		Expect(server.Start()).To(Succeed())
+		DeferCleanup(func() { _ = server.Stop(context.Background()) })
+	}
+
+	It("pushes a view when it changes and not otherwise", func() {
+		startStrategy()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		updates, err := streamer.Subscribe(ctx, "momentum", pnlRequest)
+		Expect(err).NotTo(HaveOccurred())
+
+		var update live.StreamUpdate
+		Eventually(updates).Should(Receive(&update))
+		Expect(update.Topic).To(Equal(live.StreamPnL))
+		Expect(update.PnL.TotalPnL.IntPart()).To(Equal(int64(100)))
+		Consistently(updates, 100*time.Millisecond).ShouldNot(Receive())
+
+		total.Store(250)
+		Eventually(updates).Should(Receive(&update))
+		Expect(update.PnL.TotalPnL.IntPart()).To(Equal(int64(250)))
+	})
+
+	It("closes the channel when the subscriber is done", func() {
+		startStrategy()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		updates, err := streamer.Subscribe(ctx, "momentum", pnlRequest)
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(updates).Should(Receive())
+
+		cancel()
+		Eventually(updates).Should(BeClosed())
+	})
+
+	It("reports instances without a control socket as unable to stream", func() {
+		_, err := streamer.Subscribe(context.Background(), "momentum", pnlRequest)
+		Expect(errors.Is(err, live.ErrStreamUnsupported)).To(BeTrue())
+	})
+
+	It("reports instances whose control socket predates streaming as unable to stream", func() {
+		server := control.NewServer(filepath.Join(tmpDir, "control"), "momentum")
+		Expect(server.Start()).To(Succeed())
+		DeferCleanup(func() { _ = server.Stop(context.Background()) })
+
+		_, err := streamer.Subscribe(context.Background(), "momentum", pnlRequest)
+		Expect(errors.Is(err, live.ErrStreamUnsupported)).To(BeTrue())
+	})
+
+	It("rejects requests for an orderbook without an asset", func() {
+		startStrategy()
+
+		_, err := streamer.Subscribe(context.Background(), "momentum", live.StreamRequest{Topics: []live.StreamTopic{live.StreamOrderbook}})
+		Expect(err).To(MatchError(ContainSubstring("status 400")))
+	})
+})
@@ -0,0 +1,86 @@
+package monitoring
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	monitoring2 "github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring/health"
+)
+
+const (
+	// summaryTimeout bounds each instance's part of QueryInstanceSummary, unless the querier's timeout is shorter
+	summaryTimeout = 2 * time.Second
+
+	// summaryConcurrency caps how many instances QueryInstanceSummary queries at once
+	summaryConcurrency = 16
+)
+
+// QueryInstanceSummary queries the metrics, PnL and health of every instance concurrently
+func (q *querier) QueryInstanceSummary(ctx context.Context, instanceIDs []string) []*live.InstanceSummary {
+	timeout := min(q.timeout, summaryTimeout)
+	summaries := make([]*live.InstanceSummary, len(instanceIDs))
+	slots := make(chan struct{}, summaryConcurrency)
+
+	var wg sync.WaitGroup
+	for i, instanceID := range instanceIDs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+			case <-ctx.Done():
+				summaries[i] = &live.InstanceSummary{InstanceID: instanceID, Error: ctx.Err().Error()}
+				return
+			}
+
+			// The timeout starts once the instance's turn comes, so waiting for a slot doesn't eat into it
+			instanceCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			summaries[i] = q.summarize(instanceCtx, instanceID)
+		}()
+	}
+	wg.Wait()
+
+	return summaries
+}
+
+// summarize queries one instance's metrics, PnL and health at the same time
+func (q *querier) summarize(ctx context.Context, instanceID string) *live.InstanceSummary {
+	summary := &live.InstanceSummary{InstanceID: instanceID}
+
+	var (
+		wg        sync.WaitGroup
+		metrics   monitoring2.StrategyMetrics
+		pnl       monitoring2.PnLView
+		healthErr error
+	)
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		if err := q.doRequestContext(ctx, instanceID, "/api/metrics", &metrics); err == nil {
+			summary.Metrics = &metrics
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		if err := q.doRequestContext(ctx, instanceID, "/api/pnl", &pnl); err == nil {
+			summary.PnL = &pnl
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		healthErr = q.doRequestContext(ctx, instanceID, "/health", &health.SystemHealthReport{})
+	}()
+	wg.Wait()
+
+	summary.Healthy = healthErr == nil
+	if healthErr != nil {
+		summary.Error = healthErr.Error()
+	}
+	return summary
+}
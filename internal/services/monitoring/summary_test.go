@@ -0,0 +1,109 @@
+package monitoring_test
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/monitoring"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	monitoring2 "github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+)
+
+var _ = Describe("Instance summaries", func() {
+	const timeout = 300 * time.Millisecond
+
+	var (
+		tmpDir  string
+		querier monitoring2.ViewQuerier
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = os.MkdirTemp("", "summary-test-*")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(func() { _ = os.RemoveAll(tmpDir) })
+
+		querier = monitoring.NewQuerierWithConfig(tmpDir, timeout)
+	})
+
+	// serve starts a fake instance and returns a counter of the connections made to it
+	serve := func(instanceID string, handler http.Handler) *atomic.Int64 {
+		listener, err := net.Listen("unix", filepath.Join(tmpDir, instanceID+".sock"))
+		Expect(err).NotTo(HaveOccurred())
+
+		connections := &atomic.Int64{}
+		server := &http.Server{
+			Handler: handler,
+			ConnState: func(_ net.Conn, state http.ConnState) {
+				if state == http.StateNew {
+					connections.Add(1)
+				}
+			},
+		}
+		go func() { _ = server.Serve(listener) }()
+		DeferCleanup(func() { _ = server.Close() })
+
+		return connections
+	}
+
+	healthy := func() http.Handler {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/api/metrics", func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode(monitoring2.StrategyMetrics{StrategyName: "momentum", Status: "running"})
+		})
+		mux.HandleFunc("/api/pnl", func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode(monitoring2.PnLView{TotalPnL: numerical.NewFromInt(42)})
+		})
+		mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("{}"))
+		})
+		return mux
+	}
+
+	It("doesn't let a hung instance hold up the others", func() {
+		serve("momentum", healthy())
+		serve("hung", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Answers nothing until the querier gives up
+			<-r.Context().Done()
+		}))
+
+		started := time.Now()
+		summaries := querier.(live.SummaryQuerier).QueryInstanceSummary(context.Background(), []string{"momentum", "hung", "gone"})
+		Expect(time.Since(started)).To(BeNumerically("<", 2*timeout))
+
+		Expect(summaries).To(HaveLen(3))
+
+		Expect(summaries[0].InstanceID).To(Equal("momentum"))
+		Expect(summaries[0].Healthy).To(BeTrue())
+		Expect(summaries[0].Metrics.Status).To(Equal("running"))
+		Expect(summaries[0].PnL.TotalPnL.IntPart()).To(Equal(int64(42)))
+
+		Expect(summaries[1].InstanceID).To(Equal("hung"))
+		Expect(summaries[1].Healthy).To(BeFalse())
+		Expect(summaries[1].Metrics).To(BeNil())
+		Expect(summaries[1].Error).NotTo(BeEmpty())
+
+		Expect(summaries[2].Healthy).To(BeFalse())
+		Expect(summaries[2].Error).To(ContainSubstring("socket does not exist"))
+	})
+
+	It("reuses connections to an instance between queries", func() {
+		connections := serve("momentum", healthy())
+
+		for i := 0; i < 5; i++ {
+			_, err := querier.QueryMetrics("momentum")
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(connections.Load()).To(Equal(int64(1)))
+	})
+})
@@ -0,0 +1,192 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+const (
+	// requestTimeout bounds queries to a node
+	requestTimeout = 10 * time.Second
+
+	// stopGrace is added to the drain timeout when waiting for a node to stop a strategy
+	stopGrace = 30 * time.Second
+)
+
+// ErrUnsupported is returned for operations that only run on the machine the strategies live on
+var ErrUnsupported = errors.New("not supported on a remote node")
+
+// Node is a client for a remote kronos node's API
+type Node struct {
+	name   string
+	host   string
+	token  string
+	client *http.Client
+}
+
+// Dial prepares a client for the node described by hc. It doesn't connect; Info does.
+func Dial(hc *live.HostContext) (*Node, error) {
+	if hc.Host == "" {
+		return nil, fmt.Errorf("context %s has no host", hc.Name)
+	}
+
+	token := strings.TrimSpace(hc.Token)
+	if token == "" && hc.TokenFile != "" {
+		data, err := os.ReadFile(hc.TokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read token: %w", err)
+		}
+		token = strings.TrimSpace(string(data))
+	}
+	if token == "" {
+		return nil, fmt.Errorf("no token for %s", hc.Host)
+	}
+
+	tlsConfig, err := clientTLS(hc)
+	if err != nil {
+		return nil, err
+	}
+
+	name := hc.Name
+	if name == "" {
+		name = hc.Host
+	}
+
+	return &Node{
+		name:  name,
+		host:  hc.Host,
+		token: token,
+		client: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig:     tlsConfig,
+				TLSHandshakeTimeout: 10 * time.Second,
+			},
+		},
+	}, nil
+}
+
+// clientTLS verifies the node by pinned fingerprint, by CA or by the system roots, in that order of preference
+func clientTLS(hc *live.HostContext) (*tls.Config, error) {
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	switch {
+	case hc.Fingerprint != "":
+		// A pinned self-signed certificate has no chain to verify; matching the fingerprint is the verification
+		expected := []byte(normalizeFingerprint(hc.Fingerprint))
+		config.InsecureSkipVerify = true
+		config.VerifyConnection = func(state tls.ConnectionState) error {
+			if len(state.PeerCertificates) == 0 {
+				return fmt.Errorf("node presented no certificate")
+			}
+			actual := Fingerprint(state.PeerCertificates[0].Raw)
+			if subtle.ConstantTimeCompare([]byte(actual), expected) != 1 {
+				return fmt.Errorf("certificate fingerprint %s doesn't match the pinned %s", actual, expected)
+			}
+			return nil
+		}
+
+	case hc.CAFile != "":
+		pem, err := os.ReadFile(hc.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates in %s", hc.CAFile)
+		}
+		config.RootCAs = pool
+	}
+
+	return config, nil
+}
+
+// Name returns the context name of the node, or its address if it has none
+func (n *Node) Name() string {
+	return n.name
+}
+
+// Host returns the node's address
+func (n *Node) Host() string {
+	return n.host
+}
+
+// Info fetches the node's identity, checking that it is reachable and accepts the token
+func (n *Node) Info(ctx context.Context) (*NodeInfo, error) {
+	var info NodeInfo
+	if err := n.do(ctx, http.MethodGet, "/v1/node", nil, &info, requestTimeout); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// get fetches path into result
+func (n *Node) get(path string, result interface{}) error {
+	return n.do(context.Background(), http.MethodGet, path, nil, result, requestTimeout)
+}
+
+// post sends body as JSON to path and decodes the reply into result
+func (n *Node) post(path string, body, result interface{}, timeout time.Duration) error {
+	return n.do(context.Background(), http.MethodPost, path, body, result, timeout)
+}
+
+func (n *Node) do(ctx context.Context, method, path string, body, result interface{}, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var payload io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		payload = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, "https://"+n.host+path, payload)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+n.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach %s: %w", n.name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return n.responseError(resp)
+	}
+
+	if result != nil {
+		if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+	return nil
+}
+
+// responseError turns a non-200 response into an error, using the node's message when it sent one
+func (n *Node) responseError(resp *http.Response) error {
+	var reply errorReply
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 4096)).Decode(&reply); err != nil || reply.Error == "" {
+		return fmt.Errorf("%s returned status %d", n.name, resp.StatusCode)
+	}
+	return fmt.Errorf("%s: %s", n.name, reply.Error)
+}
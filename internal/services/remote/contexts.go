@@ -0,0 +1,172 @@
+package remote
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// TokenEnv supplies the token when --host names an address rather than a context
+	TokenEnv = "KRONOS_TOKEN"
+
+	// FingerprintEnv pins the certificate when --host names an address rather than a context
+	FingerprintEnv = "KRONOS_FINGERPRINT"
+)
+
+type contextsFile struct {
+	Current  string                       `yaml:"current,omitempty"`
+	Contexts map[string]*live.HostContext `yaml:"contexts"`
+}
+
+// Contexts are the named kronos nodes this user can point commands at, kept in ~/.kronos/contexts.yml
+type Contexts struct {
+	path string
+	file contextsFile
+}
+
+// DefaultContextsPath returns ~/.kronos/contexts.yml
+func DefaultContextsPath() string {
+	homeDir, _ := os.UserHomeDir()
+	return filepath.Join(homeDir, ".kronos", "contexts.yml")
+}
+
+// LoadContexts reads the contexts file at path; a missing file has no contexts
+func LoadContexts(path string) (*Contexts, error) {
+	contexts := &Contexts{path: path}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			contexts.file.Contexts = map[string]*live.HostContext{}
+			return contexts, nil
+		}
+		return nil, fmt.Errorf("failed to read contexts: %w", err)
+	}
+
+	if err := yaml.Unmarshal(data, &contexts.file); err != nil {
+		return nil, fmt.Errorf("failed to parse contexts %s: %w", path, err)
+	}
+	if contexts.file.Contexts == nil {
+		contexts.file.Contexts = map[string]*live.HostContext{}
+	}
+	for name, hc := range contexts.file.Contexts {
+		if hc == nil {
+			return nil, fmt.Errorf("context %s has no host", name)
+		}
+		hc.Name = name
+	}
+	return contexts, nil
+}
+
+// Current returns the name of the context commands act on by default, "local" for this machine
+func (c *Contexts) Current() string {
+	if c.file.Current == "" {
+		return live.LocalContext
+	}
+	return c.file.Current
+}
+
+// Get returns a context by name
+func (c *Contexts) Get(name string) (*live.HostContext, error) {
+	hc, ok := c.file.Contexts[name]
+	if !ok {
+		return nil, fmt.Errorf("no context named %s", name)
+	}
+	return hc, nil
+}
+
+// List returns every context, sorted by name
+func (c *Contexts) List() []*live.HostContext {
+	list := make([]*live.HostContext, 0, len(c.file.Contexts))
+	for _, hc := range c.file.Contexts {
+		list = append(list, hc)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+// Add adds or replaces a context
+func (c *Contexts) Add(hc *live.HostContext) error {
+	if hc.Name == "" || hc.Name == live.LocalContext {
+		return fmt.Errorf("context name %q is reserved", hc.Name)
+	}
+	c.file.Contexts[hc.Name] = hc
+	return nil
+}
+
+// Remove deletes a context, switching back to this machine if it was the current one
+func (c *Contexts) Remove(name string) error {
+	if _, ok := c.file.Contexts[name]; !ok {
+		return fmt.Errorf("no context named %s", name)
+	}
+	delete(c.file.Contexts, name)
+	if c.file.Current == name {
+		c.file.Current = ""
+	}
+	return nil
+}
+
+// Use makes a context the default for commands; "local" goes back to this machine
+func (c *Contexts) Use(name string) error {
+	if name == live.LocalContext {
+		c.file.Current = ""
+		return nil
+	}
+	if _, ok := c.file.Contexts[name]; !ok {
+		return fmt.Errorf("no context named %s", name)
+	}
+	c.file.Current = name
+	return nil
+}
+
+// Save writes the contexts back to their file, readable only by the user since it may hold tokens
+func (c *Contexts) Save() error {
+	data, err := yaml.Marshal(&c.file)
+	if err != nil {
+		return fmt.Errorf("failed to encode contexts: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0700); err != nil {
+		return fmt.Errorf("failed to create contexts directory: %w", err)
+	}
+	if err := os.WriteFile(c.path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write contexts: %w", err)
+	}
+	return nil
+}
+
+// Resolve picks the node commands act on from --context, --host or the current context, in that order.
+// --host takes a context name or a host:port, authenticated with $KRONOS_TOKEN and pinned with
+// $KRONOS_FINGERPRINT. nil means this machine.
+func (c *Contexts) Resolve(contextName, host string) (*live.HostContext, error) {
+	if contextName != "" && host != "" {
+		return nil, fmt.Errorf("--context and --host are mutually exclusive")
+	}
+
+	switch {
+	case contextName == live.LocalContext || host == live.LocalContext:
+		return nil, nil
+	case contextName != "":
+		return c.Get(contextName)
+	case host != "":
+		if hc, ok := c.file.Contexts[host]; ok {
+			return hc, nil
+		}
+		return &live.HostContext{
+			Host:        host,
+			Token:       os.Getenv(TokenEnv),
+			Fingerprint: os.Getenv(FingerprintEnv),
+		}, nil
+	case c.file.Current != "":
+		hc, err := c.Get(c.file.Current)
+		if err != nil {
+			return nil, fmt.Errorf("current context: %w", err)
+		}
+		return hc, nil
+	}
+	return nil, nil
+}
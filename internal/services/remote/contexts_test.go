@@ -0,0 +1,89 @@
+package remote_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/remote"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+var _ = Describe("Contexts", func() {
+	var (
+		path     string
+		contexts *remote.Contexts
+	)
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "contexts.yml")
+
+		var err error
+		contexts, err = remote.LoadContexts(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(contexts.Add(&live.HostContext{Name: "prod", Host: "10.0.0.5:7443", Token: "secret", Fingerprint: "sha256:ab"})).To(Succeed())
+	})
+
+	It("acts on this machine without a current context", func() {
+		hc, err := contexts.Resolve("", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hc).To(BeNil())
+		Expect(contexts.Current()).To(Equal(live.LocalContext))
+	})
+
+	It("persists contexts and the current one, readable only by the user", func() {
+		Expect(contexts.Use("prod")).To(Succeed())
+		Expect(contexts.Save()).To(Succeed())
+
+		info, err := os.Stat(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+		reloaded, err := remote.LoadContexts(path)
+		Expect(err).NotTo(HaveOccurred())
+		hc, err := reloaded.Resolve("", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hc.Name).To(Equal("prod"))
+		Expect(hc.Token).To(Equal("secret"))
+	})
+
+	It("lets --context override the current context, local included", func() {
+		Expect(contexts.Use("prod")).To(Succeed())
+
+		hc, err := contexts.Resolve(live.LocalContext, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hc).To(BeNil())
+
+		_, err = contexts.Resolve("staging", "")
+		Expect(err).To(MatchError("no context named staging"))
+	})
+
+	It("takes a context name or an address for --host", func() {
+		hc, err := contexts.Resolve("", "prod")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hc.Host).To(Equal("10.0.0.5:7443"))
+
+		GinkgoT().Setenv(remote.TokenEnv, "from-env")
+		hc, err = contexts.Resolve("", "127.0.0.1:7443")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hc.Host).To(Equal("127.0.0.1:7443"))
+		Expect(hc.Token).To(Equal("from-env"))
+	})
+
+	It("rejects --context together with --host", func() {
+		_, err := contexts.Resolve("prod", "127.0.0.1:7443")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("goes back to this machine when the current context is removed", func() {
+		Expect(contexts.Use("prod")).To(Succeed())
+		Expect(contexts.Remove("prod")).To(Succeed())
+		Expect(contexts.Current()).To(Equal(live.LocalContext))
+	})
+
+	It("reserves the local name", func() {
+		Expect(contexts.Add(&live.HostContext{Name: live.LocalContext, Host: "x:1"})).NotTo(Succeed())
+	})
+})
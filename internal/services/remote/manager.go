@@ -0,0 +1,93 @@
+package remote
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/config"
+)
+
+// manager implements InstanceManager against a remote node's supervisor.
+// Starting strategies needs their code and credentials on the node, so it only inspects and stops them.
+type manager struct {
+	node *Node
+}
+
+// Manager returns an InstanceManager for the instances the node supervises
+func (n *Node) Manager() live.InstanceManager {
+	return &manager{node: n}
+}
+
+func (m *manager) unsupported(operation string) error {
+	return fmt.Errorf("%s on %s: %w", operation, m.node.name, ErrUnsupported)
+}
+
+func (m *manager) Start(ctx context.Context, strategy *config.Strategy, frameworkRoot string) (*live.Instance, error) {
+	return nil, m.unsupported("starting strategies")
+}
+
+func (m *manager) StartStandby(ctx context.Context, strategy *config.Strategy, frameworkRoot string) (*live.Instance, error) {
+	return nil, m.unsupported("starting standbys")
+}
+
+func (m *manager) Promote(instanceID string) error {
+	return m.unsupported("promoting standbys")
+}
+
+func (m *manager) Stop(ctx context.Context, instanceID string) error {
+	return m.node.post(fmt.Sprintf("/v1/managed/%s/stop", url.PathEscape(instanceID)), nil, nil, stopGrace)
+}
+
+func (m *manager) StopByStrategyName(ctx context.Context, strategyName string) error {
+	return m.node.post(fmt.Sprintf("/v1/strategies/%s/terminate", url.PathEscape(strategyName)), nil, nil, stopGrace)
+}
+
+func (m *manager) StopStrategy(ctx context.Context, strategyName string, opts live.StopOptions) (*live.StopResult, error) {
+	var result live.StopResult
+	path := fmt.Sprintf("/v1/strategies/%s/stop", url.PathEscape(strategyName))
+	if err := m.node.do(ctx, http.MethodPost, path, opts, &result, opts.DrainTimeout+stopGrace); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (m *manager) Kill(ctx context.Context, instanceID string) error {
+	return m.node.post(fmt.Sprintf("/v1/managed/%s/kill", url.PathEscape(instanceID)), nil, nil, requestTimeout)
+}
+
+func (m *manager) Get(instanceID string) (*live.Instance, error) {
+	var instance live.Instance
+	if err := m.node.get(fmt.Sprintf("/v1/managed/%s", url.PathEscape(instanceID)), &instance); err != nil {
+		return nil, err
+	}
+	return &instance, nil
+}
+
+func (m *manager) List(status live.InstanceStatus) ([]*live.Instance, error) {
+	var instances []*live.Instance
+	path := "/v1/managed"
+	if status != "" {
+		path += "?status=" + url.QueryEscape(string(status))
+	}
+	if err := m.node.get(path, &instances); err != nil {
+		return nil, err
+	}
+	return instances, nil
+}
+
+// LoadRunning and SaveState concern the node's own state, which it keeps itself
+func (m *manager) LoadRunning(ctx context.Context) error {
+	return nil
+}
+
+func (m *manager) SaveState() error {
+	return nil
+}
+
+func (m *manager) Shutdown(ctx context.Context, timeout time.Duration) error {
+	return m.unsupported("shutting down every instance")
+}
@@ -0,0 +1,22 @@
+package remote
+
+import "go.uber.org/fx"
+
+// Module provides the node API server and points the ViewQuerier, ViewStreamer, SummaryQuerier, OrderQuerier,
+// SignalQuerier, InstanceManager and Trader at the selected node.
+// It is not an fx.Module: decorations only reach the scope they are declared in, and these must reach every consumer.
+var Module = fx.Options(
+	fx.Provide(
+		NewTarget,
+		NewServer,
+	),
+	fx.Decorate(
+		RouteQuerier,
+		RouteManager,
+		RouteStreamer,
+		RouteSummaries,
+		RouteOrders,
+		RouteSignals,
+		RouteTrader,
+	),
+)
@@ -0,0 +1,41 @@
+package remote
+
+import (
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+// orders implements OrderQuerier against the instances of a remote node
+type orders struct {
+	node *Node
+}
+
+// Orders returns an OrderQuerier for the instances running on the node
+func (n *Node) Orders() live.OrderQuerier {
+	return &orders{node: n}
+}
+
+func (o *orders) QueryOpenOrders(instanceID string) (*live.OpenOrders, error) {
+	var result live.OpenOrders
+	if err := o.node.get(instancePath(instanceID, "orders"), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// routingOrders lists the orders of the selected node's instances, or this machine's
+type routingOrders struct {
+	local  live.OrderQuerier
+	target *Target
+}
+
+// RouteOrders decorates the local OrderQuerier so it follows the Target
+func RouteOrders(local live.OrderQuerier, target *Target) live.OrderQuerier {
+	return &routingOrders{local: local, target: target}
+}
+
+func (o *routingOrders) QueryOpenOrders(instanceID string) (*live.OpenOrders, error) {
+	if node := o.target.Node(); node != nil {
+		return node.Orders().QueryOpenOrders(instanceID)
+	}
+	return o.local.QueryOpenOrders(instanceID)
+}
@@ -0,0 +1,109 @@
+package remote
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+)
+
+// shutdownTimeout matches the local querier's patience with a shutting down instance
+const shutdownTimeout = 5 * time.Second
+
+// querier implements ViewQuerier against the monitoring sockets of a remote node
+type querier struct {
+	node *Node
+}
+
+// Querier returns a ViewQuerier for the instances running on the node
+func (n *Node) Querier() monitoring.ViewQuerier {
+	return &querier{node: n}
+}
+
+func instancePath(instanceID, view string) string {
+	return fmt.Sprintf("/v1/instances/%s/%s", url.PathEscape(instanceID), view)
+}
+
+func (q *querier) QueryPnL(instanceID string) (*monitoring.PnLView, error) {
+	var result monitoring.PnLView
+	if err := q.node.get(instancePath(instanceID, "pnl"), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (q *querier) QueryPositions(instanceID string) (*strategy.StrategyExecution, error) {
+	var result strategy.StrategyExecution
+	if err := q.node.get(instancePath(instanceID, "positions"), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (q *querier) QueryOrderbook(instanceID, asset, exchange string) (*connector.OrderBook, error) {
+	var result connector.OrderBook
+	query := url.Values{"asset": {asset}, "exchange": {exchange}}
+	if err := q.node.get(instancePath(instanceID, "orderbook")+"?"+query.Encode(), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (q *querier) QueryRecentTrades(instanceID string, limit int) ([]connector.Trade, error) {
+	var result []connector.Trade
+	if err := q.node.get(fmt.Sprintf("%s?limit=%d", instancePath(instanceID, "trades"), limit), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (q *querier) QueryMetrics(instanceID string) (*monitoring.StrategyMetrics, error) {
+	var result monitoring.StrategyMetrics
+	if err := q.node.get(instancePath(instanceID, "metrics"), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (q *querier) QueryAvailableAssets(instanceID string) ([]monitoring.AssetExchange, error) {
+	var result []monitoring.AssetExchange
+	if err := q.node.get(instancePath(instanceID, "assets"), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (q *querier) HealthCheck(instanceID string) error {
+	return q.node.get(instancePath(instanceID, "health"), nil)
+}
+
+func (q *querier) Shutdown(instanceID string) error {
+	return q.node.post(instancePath(instanceID, "shutdown"), nil, nil, shutdownTimeout)
+}
+
+func (q *querier) ListInstances() ([]string, error) {
+	var result []string
+	if err := q.node.get("/v1/instances", &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (q *querier) QueryProfilingStats(instanceID string) (*monitoring.ProfilingStats, error) {
+	var result monitoring.ProfilingStats
+	if err := q.node.get(instancePath(instanceID, "profiling/stats"), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (q *querier) QueryRecentExecutions(instanceID string, limit int) ([]monitoring.ProfilingMetrics, error) {
+	var result []monitoring.ProfilingMetrics
+	if err := q.node.get(fmt.Sprintf("%s?limit=%d", instancePath(instanceID, "profiling/executions"), limit), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,392 @@
+package remote
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	monitoringService "github.com/backtesting-org/kronos-cli/internal/services/monitoring"
+	"github.com/backtesting-org/kronos-cli/internal/version"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+)
+
+// NodeInfo identifies a kronos node; clients fetch it to check they reached and authenticated to it
+type NodeInfo struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// errorReply is the body of every non-200 response
+type errorReply struct {
+	Error string `json:"error"`
+}
+
+// Server exposes this node's instance manager and monitoring sockets over TCP with TLS and a bearer token.
+// It serves the same operations the CLI performs locally, so a client on another machine can stand in for them.
+type Server struct {
+	manager   live.InstanceManager
+	querier   monitoring.ViewQuerier
+	streamer  live.ViewStreamer
+	summaries live.SummaryQuerier
+	orders    live.OrderQuerier
+	signals   live.SignalQuerier
+	trader    live.Trader
+	logger    logging.ApplicationLogger
+
+	mu          sync.Mutex
+	httpServer  *http.Server
+	listener    net.Listener
+	fingerprint string
+	tokenFile   string
+}
+
+// NewServer creates the node API server; call Start to listen
+func NewServer(
+	manager live.InstanceManager,
+	querier monitoring.ViewQuerier,
+	streamer live.ViewStreamer,
+	summaries live.SummaryQuerier,
+	orders live.OrderQuerier,
+	signals live.SignalQuerier,
+	trader live.Trader,
+	logger logging.ApplicationLogger,
+) *Server {
+	return &Server{
+		manager:   manager,
+		querier:   querier,
+		streamer:  streamer,
+		summaries: summaries,
+		orders:    orders,
+		signals:   signals,
+		trader:    trader,
+		logger:    logger,
+	}
+}
+
+// Start listens on cfg.Listen and serves in the background. A certificate and token are generated
+// under ~/.kronos/remote unless cfg points at existing ones.
+func (s *Server) Start(cfg live.RemoteConfig) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.listener != nil {
+		return fmt.Errorf("server already started")
+	}
+	if cfg.Listen == "" {
+		return fmt.Errorf("no listen address")
+	}
+
+	if cfg.CertFile == "" && cfg.KeyFile == "" {
+		cfg.CertFile = filepath.Join(DefaultDir(), "cert.pem")
+		cfg.KeyFile = filepath.Join(DefaultDir(), "key.pem")
+	}
+	if cfg.TokenFile == "" {
+		cfg.TokenFile = filepath.Join(DefaultDir(), "token")
+	}
+
+	host, _, err := net.SplitHostPort(cfg.Listen)
+	if err != nil {
+		return fmt.Errorf("invalid listen address %s: %w", cfg.Listen, err)
+	}
+	cert, err := LoadOrCreateCertificate(cfg.CertFile, cfg.KeyFile, host)
+	if err != nil {
+		return err
+	}
+	token, err := LoadOrCreateToken(cfg.TokenFile)
+	if err != nil {
+		return err
+	}
+
+	listener, err := tls.Listen("tcp", cfg.Listen, &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", cfg.Listen, err)
+	}
+
+	httpServer := &http.Server{
+		Handler:           s.authenticate(token, s.routes()),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	s.listener = listener
+	s.httpServer = httpServer
+	s.fingerprint = Fingerprint(cert.Certificate[0])
+	s.tokenFile = cfg.TokenFile
+
+	go func() {
+		if err := httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.logger.Error("Node API stopped", "error", err)
+		}
+	}()
+
+	return nil
+}
+
+// Addr returns the address the server listens on, with the port resolved
+func (s *Server) Addr() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.listener == nil {
+		return ""
+	}
+	return s.listener.Addr().String()
+}
+
+// Fingerprint returns the fingerprint of the certificate the server presents
+func (s *Server) Fingerprint() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.fingerprint
+}
+
+// TokenFile returns the file holding the token clients must present
+func (s *Server) TokenFile() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tokenFile
+}
+
+// Stop shuts the server down, letting requests in flight finish until ctx expires
+func (s *Server) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.httpServer == nil {
+		return nil
+	}
+	err := s.httpServer.Shutdown(ctx)
+	s.httpServer = nil
+	s.listener = nil
+	return err
+}
+
+// authenticate rejects requests without the node's bearer token
+func (s *Server) authenticate(token string, next http.Handler) http.Handler {
+	expected := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) != 1 {
+			s.logger.Warn("Rejected unauthenticated request", "remote", r.RemoteAddr, "path", r.URL.Path)
+			writeError(w, http.StatusUnauthorized, fmt.Errorf("invalid or missing token"))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) routes() http.Handler {
+	mux := http.NewServeMux()
+	handle := func(pattern string, handler http.HandlerFunc) {
+		mux.HandleFunc(pattern, checkNames(pattern, handler))
+	}
+
+	handle("GET /v1/node", func(w http.ResponseWriter, r *http.Request) {
+		hostname, _ := os.Hostname()
+		writeJSON(w, NodeInfo{Name: hostname, Version: version.Version})
+	})
+
+	// Monitoring sockets, as ViewQuerier sees them
+	handle("GET /v1/instances", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusBadGateway)(s.querier.ListInstances())
+	})
+	handle("GET /v1/summaries", func(w http.ResponseWriter, r *http.Request) {
+		ids := r.URL.Query()["id"]
+		for _, id := range ids {
+			if !validName(id) {
+				writeError(w, http.StatusBadRequest, fmt.Errorf("invalid id %q", id))
+				return
+			}
+		}
+		writeJSON(w, s.summaries.QueryInstanceSummary(r.Context(), ids))
+	})
+	handle("GET /v1/instances/{id}/pnl", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusBadGateway)(s.querier.QueryPnL(r.PathValue("id")))
+	})
+	handle("GET /v1/instances/{id}/positions", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusBadGateway)(s.querier.QueryPositions(r.PathValue("id")))
+	})
+	handle("GET /v1/instances/{id}/orderbook", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		reply(w, http.StatusBadGateway)(s.querier.QueryOrderbook(r.PathValue("id"), query.Get("asset"), query.Get("exchange")))
+	})
+	handle("GET /v1/instances/{id}/assets", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusBadGateway)(s.querier.QueryAvailableAssets(r.PathValue("id")))
+	})
+	handle("GET /v1/instances/{id}/trades", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusBadGateway)(s.querier.QueryRecentTrades(r.PathValue("id"), intParam(r, "limit")))
+	})
+	handle("GET /v1/instances/{id}/orders", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusBadGateway)(s.orders.QueryOpenOrders(r.PathValue("id")))
+	})
+	handle("GET /v1/instances/{id}/signals", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusBadGateway)(s.signals.QuerySignals(r.PathValue("id"), intParam(r, "limit")))
+	})
+	handle("GET /v1/instances/{id}/metrics", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusBadGateway)(s.querier.QueryMetrics(r.PathValue("id")))
+	})
+	handle("GET /v1/instances/{id}/health", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusBadGateway)(struct{}{}, s.querier.HealthCheck(r.PathValue("id")))
+	})
+	handle("GET /v1/instances/{id}/profiling/stats", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusBadGateway)(s.querier.QueryProfilingStats(r.PathValue("id")))
+	})
+	handle("GET /v1/instances/{id}/profiling/executions", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusBadGateway)(s.querier.QueryRecentExecutions(r.PathValue("id"), intParam(r, "limit")))
+	})
+	handle("GET /v1/instances/{id}/stream", func(w http.ResponseWriter, r *http.Request) {
+		req, err := live.ParseStreamRequest(r.URL.Query())
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		updates, err := s.streamer.Subscribe(r.Context(), r.PathValue("id"), req)
+		if errors.Is(err, live.ErrStreamUnsupported) {
+			writeError(w, http.StatusNotImplemented, err)
+			return
+		}
+		if err != nil {
+			writeError(w, http.StatusBadGateway, err)
+			return
+		}
+		monitoringService.WriteStream(w, r, updates)
+	})
+	handle("POST /v1/instances/{id}/shutdown", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusBadGateway)(struct{}{}, s.querier.Shutdown(r.PathValue("id")))
+	})
+
+	// Instances the node's supervisor tracks
+	handle("GET /v1/managed", func(w http.ResponseWriter, r *http.Request) {
+		status := live.InstanceStatus(r.URL.Query().Get("status"))
+		reply(w, http.StatusInternalServerError)(s.manager.List(status))
+	})
+	handle("GET /v1/managed/{id}", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusNotFound)(s.manager.Get(r.PathValue("id")))
+	})
+	handle("POST /v1/managed/{id}/stop", func(w http.ResponseWriter, r *http.Request) {
+		s.logger.Info("Stopping instance for remote client", "instance", r.PathValue("id"), "remote", r.RemoteAddr)
+		reply(w, http.StatusInternalServerError)(struct{}{}, s.manager.Stop(clientContext(r), r.PathValue("id")))
+	})
+	handle("POST /v1/managed/{id}/kill", func(w http.ResponseWriter, r *http.Request) {
+		s.logger.Info("Killing instance for remote client", "instance", r.PathValue("id"), "remote", r.RemoteAddr)
+		reply(w, http.StatusInternalServerError)(struct{}{}, s.manager.Kill(clientContext(r), r.PathValue("id")))
+	})
+	handle("POST /v1/strategies/{name}/stop", func(w http.ResponseWriter, r *http.Request) {
+		var opts live.StopOptions
+		if err := json.NewDecoder(r.Body).Decode(&opts); err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid stop options: %w", err))
+			return
+		}
+		opts.Actor = clientActor(r)
+		s.logger.Info("Stopping strategy for remote client", "strategy", r.PathValue("name"), "remote", r.RemoteAddr)
+		reply(w, http.StatusInternalServerError)(s.manager.StopStrategy(clientContext(r), r.PathValue("name"), opts))
+	})
+	handle("POST /v1/strategies/{name}/terminate", func(w http.ResponseWriter, r *http.Request) {
+		s.logger.Info("Terminating strategy for remote client", "strategy", r.PathValue("name"), "remote", r.RemoteAddr)
+		reply(w, http.StatusInternalServerError)(struct{}{}, s.manager.StopByStrategyName(clientContext(r), r.PathValue("name")))
+	})
+	handle("POST /v1/strategies/{name}/actions", func(w http.ResponseWriter, r *http.Request) {
+		var action live.TradingAction
+		if err := json.NewDecoder(r.Body).Decode(&action); err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid action: %w", err))
+			return
+		}
+		if err := action.Validate(); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		s.logger.Info("Trading action for remote client", "strategy", r.PathValue("name"), "action", action.Description(), "remote", r.RemoteAddr)
+		reply(w, http.StatusInternalServerError)(s.trader.Act(clientContext(r), r.PathValue("name"), action))
+	})
+	handle("GET /v1/strategies/{name}/paused", func(w http.ResponseWriter, r *http.Request) {
+		paused, err := s.trader.Paused(r.PathValue("name"))
+		reply(w, http.StatusInternalServerError)(struct {
+			Paused bool `json:"paused"`
+		}{paused}, err)
+	})
+
+	return mux
+}
+
+// checkNames rejects requests whose instance or strategy name could reach outside ~/.kronos.
+// The names become monitoring and control socket paths, and path values arrive unescaped, so "../x" is possible.
+func checkNames(pattern string, next http.HandlerFunc) http.HandlerFunc {
+	var keys []string
+	for _, key := range []string{"id", "name"} {
+		if strings.Contains(pattern, "{"+key+"}") {
+			keys = append(keys, key)
+		}
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		for _, key := range keys {
+			if name := r.PathValue(key); !validName(name) {
+				writeError(w, http.StatusBadRequest, fmt.Errorf("invalid %s %q", key, name))
+				return
+			}
+		}
+		next(w, r)
+	}
+}
+
+// validName reports whether name can be used as a single path element
+func validName(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.ContainsAny(name, "/\\\x00")
+}
+
+// clientActor attributes an operation to the remote client that requested it, by its address.
+// Every client presents the same node token, so the address is what tells them apart.
+func clientActor(r *http.Request) live.Actor {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return live.ActorRemote + live.Actor(":"+host)
+}
+
+// clientContext carries the remote client as the actor. It doesn't end with the request,
+// so a stop runs to completion even if the client goes away before it does.
+func clientContext(r *http.Request) context.Context {
+	return live.WithActor(context.WithoutCancel(r.Context()), clientActor(r))
+}
+
+// reply writes a result as JSON, or err with errStatus
+func reply(w http.ResponseWriter, errStatus int) func(interface{}, error) {
+	return func(result interface{}, err error) {
+		if err != nil {
+			writeError(w, errStatus, err)
+			return
+		}
+		writeJSON(w, result)
+	}
+}
+
+func intParam(r *http.Request, name string) int {
+	value, _ := strconv.Atoi(r.URL.Query().Get(name))
+	return value
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(errorReply{Error: err.Error()})
+}
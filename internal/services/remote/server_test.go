@@ -0,0 +1,318 @@
+package remote_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/backtesting-org/kronos-cli/internal/services/remote"
+	livemocks "github.com/backtesting-org/kronos-cli/mocks/github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	monitoringmocks "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/config"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+)
+
+var _ = Describe("Node API", func() {
+	var (
+		dir       string
+		manager   *livemocks.InstanceManager
+		querier   *monitoringmocks.ViewQuerier
+		streamer  *livemocks.ViewStreamer
+		summaries *livemocks.SummaryQuerier
+		orders    *livemocks.OrderQuerier
+		signals   *livemocks.SignalQuerier
+		trader    *livemocks.Trader
+		server    *remote.Server
+		hc        *live.HostContext
+	)
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		manager = livemocks.NewInstanceManager(GinkgoT())
+		querier = monitoringmocks.NewViewQuerier(GinkgoT())
+		streamer = livemocks.NewViewStreamer(GinkgoT())
+		summaries = livemocks.NewSummaryQuerier(GinkgoT())
+		orders = livemocks.NewOrderQuerier(GinkgoT())
+		signals = livemocks.NewSignalQuerier(GinkgoT())
+		trader = livemocks.NewTrader(GinkgoT())
+		server = remote.NewServer(manager, querier, streamer, summaries, orders, signals, trader, &logging.NoOpLogger{})
+
+		Expect(server.Start(live.RemoteConfig{
+			Listen:    "127.0.0.1:0",
+			CertFile:  filepath.Join(dir, "cert.pem"),
+			KeyFile:   filepath.Join(dir, "key.pem"),
+			TokenFile: filepath.Join(dir, "token"),
+		})).To(Succeed())
+		DeferCleanup(func() {
+			_ = server.Stop(context.Background())
+		})
+
+		token, err := os.ReadFile(filepath.Join(dir, "token"))
+		Expect(err).NotTo(HaveOccurred())
+
+		hc = &live.HostContext{
+			Name:        "node",
+			Host:        server.Addr(),
+			Token:       string(token),
+			Fingerprint: server.Fingerprint(),
+		}
+	})
+
+	dial := func() *remote.Node {
+		node, err := remote.Dial(hc)
+		Expect(err).NotTo(HaveOccurred())
+		return node
+	}
+
+	// byClient matches contexts attributing the operation to the test's remote client
+	byClient := mock.MatchedBy(func(ctx context.Context) bool {
+		return live.ActorFromContext(ctx) == "remote:127.0.0.1"
+	})
+
+	It("identifies the node to clients with the token and pinned certificate", func() {
+		info, err := dial().Info(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Version).NotTo(BeEmpty())
+	})
+
+	It("rejects clients without the token", func() {
+		hc.Token = "wrong"
+		_, err := dial().Info(context.Background())
+		Expect(err).To(MatchError(ContainSubstring("invalid or missing token")))
+	})
+
+	It("refuses nodes whose certificate doesn't match the pinned fingerprint", func() {
+		hc.Fingerprint = "sha256:00"
+		_, err := dial().Info(context.Background())
+		Expect(err).To(MatchError(ContainSubstring("doesn't match the pinned")))
+	})
+
+	It("keeps the generated certificate and token across restarts", func() {
+		fingerprint := server.Fingerprint()
+		Expect(server.Stop(context.Background())).To(Succeed())
+
+		restarted := remote.NewServer(manager, querier, streamer, summaries, orders, signals, trader, &logging.NoOpLogger{})
+		Expect(restarted.Start(live.RemoteConfig{
+			Listen:    "127.0.0.1:0",
+			CertFile:  filepath.Join(dir, "cert.pem"),
+			KeyFile:   filepath.Join(dir, "key.pem"),
+			TokenFile: filepath.Join(dir, "token"),
+		})).To(Succeed())
+		defer func() { _ = restarted.Stop(context.Background()) }()
+
+		Expect(restarted.Fingerprint()).To(Equal(fingerprint))
+	})
+
+	Describe("querier", func() {
+		It("lists and queries the node's instances", func() {
+			querier.EXPECT().ListInstances().Return([]string{"momentum"}, nil).Once()
+			querier.EXPECT().HealthCheck("momentum").Return(nil).Once()
+			querier.EXPECT().QueryMetrics("momentum").Return(&monitoring.StrategyMetrics{StrategyName: "momentum"}, nil).Once()
+
+			q := dial().Querier()
+
+			instances, err := q.ListInstances()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(instances).To(Equal([]string{"momentum"}))
+
+			Expect(q.HealthCheck("momentum")).To(Succeed())
+
+			metrics, err := q.QueryMetrics("momentum")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(metrics.StrategyName).To(Equal("momentum"))
+		})
+
+		It("passes query parameters through", func() {
+			querier.EXPECT().QueryRecentTrades("momentum", 25).Return(nil, nil).Once()
+
+			_, err := dial().Querier().QueryRecentTrades("momentum", 25)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("lists the instance's working orders", func() {
+			orders.EXPECT().QueryOpenOrders("momentum").Return(&live.OpenOrders{
+				Orders: []live.WorkingOrder{{Exchange: "paradex", Asset: "BTC"}},
+			}, nil).Once()
+
+			result, err := dial().Orders().QueryOpenOrders("momentum")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Orders).To(HaveLen(1))
+			Expect(result.Orders[0].Asset).To(Equal("BTC"))
+		})
+
+		It("relays the instance's signals", func() {
+			signals.EXPECT().QuerySignals("momentum", 50).Return([]live.SignalRecord{
+				{ID: "s-1", Outcome: live.SignalRejected, Reason: "max position exceeded"},
+			}, nil).Once()
+
+			result, err := dial().Signals().QuerySignals("momentum", 50)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(HaveLen(1))
+			Expect(result[0].Outcome).To(Equal(live.SignalRejected))
+		})
+
+		It("refuses names that would reach outside the socket directory", func() {
+			err := dial().Querier().HealthCheck("../../tmp/evil")
+			Expect(err).To(MatchError(ContainSubstring("invalid id")))
+
+			_, err = dial().Orders().QueryOpenOrders(`..\evil`)
+			Expect(err).To(MatchError(ContainSubstring("invalid id")))
+
+			_, err = dial().Trader().Paused("../evil")
+			Expect(err).To(MatchError(ContainSubstring("invalid name")))
+
+			result := dial().Summaries().QueryInstanceSummary(context.Background(), []string{"momentum", "../evil"})
+			Expect(result).To(HaveLen(2))
+			Expect(result[1].Error).To(ContainSubstring("invalid id"))
+		})
+
+		It("reports the node's errors", func() {
+			querier.EXPECT().HealthCheck("gone").Return(errors.New("instance gone not found (socket does not exist)")).Once()
+
+			err := dial().Querier().HealthCheck("gone")
+			Expect(err).To(MatchError("node: instance gone not found (socket does not exist)"))
+		})
+	})
+
+	Describe("summaries", func() {
+		It("summarizes the node's instances in one request", func() {
+			summaries.EXPECT().QueryInstanceSummary(mock.Anything, []string{"momentum", "hung"}).Return([]*live.InstanceSummary{
+				{InstanceID: "momentum", Healthy: true, Metrics: &monitoring.StrategyMetrics{Status: "running"}},
+				{InstanceID: "hung", Error: "context deadline exceeded"},
+			}).Once()
+
+			result := dial().Summaries().QueryInstanceSummary(context.Background(), []string{"momentum", "hung"})
+			Expect(result).To(HaveLen(2))
+			Expect(result[0].Healthy).To(BeTrue())
+			Expect(result[0].Metrics.Status).To(Equal("running"))
+			Expect(result[1].Healthy).To(BeFalse())
+			Expect(result[1].Error).To(Equal("context deadline exceeded"))
+		})
+
+		It("reports every instance as unreachable when the node is", func() {
+			hc.Token = "wrong"
+
+			result := dial().Summaries().QueryInstanceSummary(context.Background(), []string{"momentum"})
+			Expect(result).To(HaveLen(1))
+			Expect(result[0].InstanceID).To(Equal("momentum"))
+			Expect(result[0].Error).To(ContainSubstring("invalid or missing token"))
+		})
+	})
+
+	Describe("streamer", func() {
+		It("relays the instance's stream", func() {
+			updates := make(chan live.StreamUpdate, 1)
+			updates <- live.StreamUpdate{Topic: live.StreamPnL, PnL: &monitoring.PnLView{StrategyName: "momentum"}}
+			req := live.StreamRequest{Topics: []live.StreamTopic{live.StreamPnL}, TradeLimit: live.DefaultStreamTradeLimit}
+			streamer.EXPECT().Subscribe(mock.Anything, "momentum", req).Return(updates, nil).Once()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			received, err := dial().Streamer().Subscribe(ctx, "momentum", live.StreamRequest{Topics: []live.StreamTopic{live.StreamPnL}})
+			Expect(err).NotTo(HaveOccurred())
+
+			var update live.StreamUpdate
+			Eventually(received).Should(Receive(&update))
+			Expect(update.Topic).To(Equal(live.StreamPnL))
+			Expect(update.PnL.StrategyName).To(Equal("momentum"))
+
+			close(updates)
+			Eventually(received).Should(BeClosed())
+		})
+
+		It("tells clients when the instance can't stream", func() {
+			streamer.EXPECT().Subscribe(mock.Anything, "old", mock.Anything).Return(nil, live.ErrStreamUnsupported).Once()
+
+			_, err := dial().Streamer().Subscribe(context.Background(), "old", live.StreamRequest{Topics: []live.StreamTopic{live.StreamTrades}})
+			Expect(errors.Is(err, live.ErrStreamUnsupported)).To(BeTrue())
+		})
+	})
+
+	Describe("manager", func() {
+		It("stops strategies with the client's options", func() {
+			opts := live.StopOptions{Mode: live.StopModeFlatten, DrainTimeout: time.Second}
+			attributed := opts
+			attributed.Actor = "remote:127.0.0.1"
+			manager.EXPECT().StopStrategy(byClient, "momentum", attributed).Return(&live.StopResult{StrategyName: "momentum", Mode: live.StopModeFlatten}, nil).Once()
+
+			result, err := dial().Manager().StopStrategy(context.Background(), "momentum", opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.StrategyName).To(Equal("momentum"))
+			Expect(result.Mode).To(Equal(live.StopModeFlatten))
+		})
+
+		It("attributes stops and kills to the remote client", func() {
+			manager.EXPECT().Stop(byClient, "momentum-1").Return(nil).Once()
+			manager.EXPECT().Kill(byClient, "momentum-2").Return(nil).Once()
+			manager.EXPECT().StopByStrategyName(byClient, "momentum").Return(nil).Once()
+
+			node := dial()
+			Expect(node.Manager().Stop(context.Background(), "momentum-1")).To(Succeed())
+			Expect(node.Manager().Kill(context.Background(), "momentum-2")).To(Succeed())
+			Expect(node.Manager().StopByStrategyName(context.Background(), "momentum")).To(Succeed())
+		})
+
+		It("lists the node's instances by status", func() {
+			manager.EXPECT().List(live.StatusRunning).Return([]*live.Instance{{ID: "momentum-1", StrategyName: "momentum", PID: 42}}, nil).Once()
+
+			instances, err := dial().Manager().List(live.StatusRunning)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(instances).To(HaveLen(1))
+			Expect(instances[0].PID).To(Equal(42))
+		})
+
+		It("doesn't start strategies remotely", func() {
+			_, err := dial().Manager().Start(context.Background(), &config.Strategy{Name: "momentum"}, "/project")
+			Expect(errors.Is(err, remote.ErrUnsupported)).To(BeTrue())
+		})
+	})
+
+	Describe("trader", func() {
+		It("acts on the node's strategies", func() {
+			action := live.TradingAction{Type: live.ActionCancelOrders, Exchange: "paradex", Asset: "BTC"}
+			trader.EXPECT().Act(byClient, "momentum", action).Return(&live.ActionResult{StrategyName: "momentum", Action: action, CancelledOrders: 3}, nil).Once()
+			trader.EXPECT().Paused("momentum").Return(true, nil).Once()
+
+			result, err := dial().Trader().Act(context.Background(), "momentum", action)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.CancelledOrders).To(Equal(3))
+
+			paused, err := dial().Trader().Paused("momentum")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(paused).To(BeTrue())
+		})
+
+		It("refuses invalid actions before reaching the node", func() {
+			_, err := dial().Trader().Act(context.Background(), "momentum", live.TradingAction{Type: live.ActionClosePosition})
+			Expect(err).To(MatchError(ContainSubstring("needs an exchange and an asset")))
+		})
+	})
+
+	Describe("Target", func() {
+		It("routes to the selected node and back", func() {
+			local := monitoringmocks.NewViewQuerier(GinkgoT())
+			local.EXPECT().ListInstances().Return([]string{"local-only"}, nil).Twice()
+			querier.EXPECT().ListInstances().Return([]string{"remote-only"}, nil).Once()
+
+			target := remote.NewTarget()
+			routed := remote.RouteQuerier(local, target)
+
+			Expect(routed.ListInstances()).To(Equal([]string{"local-only"}))
+
+			target.Use(dial())
+			Expect(routed.ListInstances()).To(Equal([]string{"remote-only"}))
+
+			target.Use(nil)
+			Expect(routed.ListInstances()).To(Equal([]string{"local-only"}))
+		})
+	})
+})
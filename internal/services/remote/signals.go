@@ -0,0 +1,43 @@
+package remote
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+// signals implements SignalQuerier against the instances of a remote node
+type signals struct {
+	node *Node
+}
+
+// Signals returns a SignalQuerier for the instances running on the node
+func (n *Node) Signals() live.SignalQuerier {
+	return &signals{node: n}
+}
+
+func (s *signals) QuerySignals(instanceID string, limit int) ([]live.SignalRecord, error) {
+	var result []live.SignalRecord
+	if err := s.node.get(fmt.Sprintf("%s?limit=%d", instancePath(instanceID, "signals"), limit), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// routingSignals fetches the signals of the selected node's instances, or this machine's
+type routingSignals struct {
+	local  live.SignalQuerier
+	target *Target
+}
+
+// RouteSignals decorates the local SignalQuerier so it follows the Target
+func RouteSignals(local live.SignalQuerier, target *Target) live.SignalQuerier {
+	return &routingSignals{local: local, target: target}
+}
+
+func (s *routingSignals) QuerySignals(instanceID string, limit int) ([]live.SignalRecord, error) {
+	if node := s.target.Node(); node != nil {
+		return node.Signals().QuerySignals(instanceID, limit)
+	}
+	return s.local.QuerySignals(instanceID, limit)
+}
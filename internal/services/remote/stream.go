@@ -0,0 +1,66 @@
+package remote
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	monitoringService "github.com/backtesting-org/kronos-cli/internal/services/monitoring"
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+// streamer implements ViewStreamer against the instances of a remote node; the node relays their streams
+type streamer struct {
+	node *Node
+}
+
+// Streamer returns a ViewStreamer for the instances running on the node
+func (n *Node) Streamer() live.ViewStreamer {
+	return &streamer{node: n}
+}
+
+func (s *streamer) Subscribe(ctx context.Context, instanceID string, req live.StreamRequest) (<-chan live.StreamUpdate, error) {
+	n := s.node
+
+	// No timeout: the response lasts as long as the subscription, ctx ends it
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+n.host+instancePath(instanceID, "stream")+"?"+req.Values().Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+n.token)
+
+	resp, err := n.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach %s: %w", n.name, err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return monitoringService.ReadStream(ctx, resp.Body), nil
+	case http.StatusNotImplemented, http.StatusNotFound:
+		// 404: a node running a kronos that predates streaming
+		resp.Body.Close()
+		return nil, fmt.Errorf("%s instance %s: %w", n.name, instanceID, live.ErrStreamUnsupported)
+	default:
+		defer resp.Body.Close()
+		return nil, n.responseError(resp)
+	}
+}
+
+// routingStreamer subscribes to the selected node's instances, or this machine's
+type routingStreamer struct {
+	local  live.ViewStreamer
+	target *Target
+}
+
+// RouteStreamer decorates the local ViewStreamer so it follows the Target
+func RouteStreamer(local live.ViewStreamer, target *Target) live.ViewStreamer {
+	return &routingStreamer{local: local, target: target}
+}
+
+func (s *routingStreamer) Subscribe(ctx context.Context, instanceID string, req live.StreamRequest) (<-chan live.StreamUpdate, error) {
+	if node := s.target.Node(); node != nil {
+		return node.Streamer().Subscribe(ctx, instanceID, req)
+	}
+	return s.local.Subscribe(ctx, instanceID, req)
+}
@@ -0,0 +1,51 @@
+package remote
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+// summaries implements SummaryQuerier against a remote node, which fans out to its instances itself
+type summaries struct {
+	node *Node
+}
+
+// Summaries returns a SummaryQuerier for the instances running on the node
+func (n *Node) Summaries() live.SummaryQuerier {
+	return &summaries{node: n}
+}
+
+func (s *summaries) QueryInstanceSummary(ctx context.Context, instanceIDs []string) []*live.InstanceSummary {
+	query := url.Values{"id": instanceIDs}
+
+	var result []*live.InstanceSummary
+	if err := s.node.do(ctx, http.MethodGet, "/v1/summaries?"+query.Encode(), nil, &result, requestTimeout); err != nil {
+		// The node couldn't be asked, which is the same as none of its instances answering
+		result = make([]*live.InstanceSummary, len(instanceIDs))
+		for i, instanceID := range instanceIDs {
+			result[i] = &live.InstanceSummary{InstanceID: instanceID, Error: err.Error()}
+		}
+	}
+	return result
+}
+
+// routingSummaries summarizes the selected node's instances, or this machine's
+type routingSummaries struct {
+	local  live.SummaryQuerier
+	target *Target
+}
+
+// RouteSummaries decorates the local SummaryQuerier so it follows the Target
+func RouteSummaries(local live.SummaryQuerier, target *Target) live.SummaryQuerier {
+	return &routingSummaries{local: local, target: target}
+}
+
+func (s *routingSummaries) QueryInstanceSummary(ctx context.Context, instanceIDs []string) []*live.InstanceSummary {
+	if node := s.target.Node(); node != nil {
+		return node.Summaries().QueryInstanceSummary(ctx, instanceIDs)
+	}
+	return s.local.QueryInstanceSummary(ctx, instanceIDs)
+}
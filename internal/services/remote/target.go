@@ -0,0 +1,179 @@
+package remote
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/config"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+)
+
+// Annotation is the cobra annotation telling how a command treats a remote node.
+// Commands without it refuse to run while one is selected, so nothing acts on this machine by surprise.
+const Annotation = "kronos.remote"
+
+const (
+	// Capable commands act on the selected node
+	Capable = "capable"
+
+	// Local commands always act on this machine, whatever node is selected
+	Local = "local"
+)
+
+// Target is the node commands act on. It is decided once the command line is parsed;
+// until a node is selected, and after Use(nil), it is this machine.
+type Target struct {
+	mu   sync.RWMutex
+	node *Node
+}
+
+// NewTarget creates a Target pointing at this machine
+func NewTarget() *Target {
+	return &Target{}
+}
+
+// Use points commands at node, or back at this machine with nil
+func (t *Target) Use(node *Node) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.node = node
+}
+
+// Node returns the selected remote node, or nil for this machine
+func (t *Target) Node() *Node {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.node
+}
+
+// routingQuerier sends queries to the selected node's instances, or this machine's
+type routingQuerier struct {
+	local  monitoring.ViewQuerier
+	target *Target
+}
+
+// RouteQuerier decorates the local ViewQuerier so it follows the Target
+func RouteQuerier(local monitoring.ViewQuerier, target *Target) monitoring.ViewQuerier {
+	return &routingQuerier{local: local, target: target}
+}
+
+func (q *routingQuerier) pick() monitoring.ViewQuerier {
+	if node := q.target.Node(); node != nil {
+		return node.Querier()
+	}
+	return q.local
+}
+
+func (q *routingQuerier) QueryPnL(instanceID string) (*monitoring.PnLView, error) {
+	return q.pick().QueryPnL(instanceID)
+}
+
+func (q *routingQuerier) QueryPositions(instanceID string) (*strategy.StrategyExecution, error) {
+	return q.pick().QueryPositions(instanceID)
+}
+
+func (q *routingQuerier) QueryOrderbook(instanceID, asset, exchange string) (*connector.OrderBook, error) {
+	return q.pick().QueryOrderbook(instanceID, asset, exchange)
+}
+
+func (q *routingQuerier) QueryRecentTrades(instanceID string, limit int) ([]connector.Trade, error) {
+	return q.pick().QueryRecentTrades(instanceID, limit)
+}
+
+func (q *routingQuerier) QueryMetrics(instanceID string) (*monitoring.StrategyMetrics, error) {
+	return q.pick().QueryMetrics(instanceID)
+}
+
+func (q *routingQuerier) QueryAvailableAssets(instanceID string) ([]monitoring.AssetExchange, error) {
+	return q.pick().QueryAvailableAssets(instanceID)
+}
+
+func (q *routingQuerier) HealthCheck(instanceID string) error {
+	return q.pick().HealthCheck(instanceID)
+}
+
+func (q *routingQuerier) Shutdown(instanceID string) error {
+	return q.pick().Shutdown(instanceID)
+}
+
+func (q *routingQuerier) ListInstances() ([]string, error) {
+	return q.pick().ListInstances()
+}
+
+func (q *routingQuerier) QueryProfilingStats(instanceID string) (*monitoring.ProfilingStats, error) {
+	return q.pick().QueryProfilingStats(instanceID)
+}
+
+func (q *routingQuerier) QueryRecentExecutions(instanceID string, limit int) ([]monitoring.ProfilingMetrics, error) {
+	return q.pick().QueryRecentExecutions(instanceID, limit)
+}
+
+// routingManager sends instance operations to the selected node's supervisor, or this machine's
+type routingManager struct {
+	local  live.InstanceManager
+	target *Target
+}
+
+// RouteManager decorates the local InstanceManager so it follows the Target
+func RouteManager(local live.InstanceManager, target *Target) live.InstanceManager {
+	return &routingManager{local: local, target: target}
+}
+
+func (m *routingManager) pick() live.InstanceManager {
+	if node := m.target.Node(); node != nil {
+		return node.Manager()
+	}
+	return m.local
+}
+
+func (m *routingManager) Start(ctx context.Context, strategy *config.Strategy, frameworkRoot string) (*live.Instance, error) {
+	return m.pick().Start(ctx, strategy, frameworkRoot)
+}
+
+func (m *routingManager) Stop(ctx context.Context, instanceID string) error {
+	return m.pick().Stop(ctx, instanceID)
+}
+
+func (m *routingManager) StopByStrategyName(ctx context.Context, strategyName string) error {
+	return m.pick().StopByStrategyName(ctx, strategyName)
+}
+
+func (m *routingManager) StopStrategy(ctx context.Context, strategyName string, opts live.StopOptions) (*live.StopResult, error) {
+	return m.pick().StopStrategy(ctx, strategyName, opts)
+}
+
+func (m *routingManager) StartStandby(ctx context.Context, strategy *config.Strategy, frameworkRoot string) (*live.Instance, error) {
+	return m.pick().StartStandby(ctx, strategy, frameworkRoot)
+}
+
+func (m *routingManager) Promote(instanceID string) error {
+	return m.pick().Promote(instanceID)
+}
+
+func (m *routingManager) Kill(ctx context.Context, instanceID string) error {
+	return m.pick().Kill(ctx, instanceID)
+}
+
+func (m *routingManager) Get(instanceID string) (*live.Instance, error) {
+	return m.pick().Get(instanceID)
+}
+
+func (m *routingManager) List(status live.InstanceStatus) ([]*live.Instance, error) {
+	return m.pick().List(status)
+}
+
+func (m *routingManager) LoadRunning(ctx context.Context) error {
+	return m.pick().LoadRunning(ctx)
+}
+
+func (m *routingManager) SaveState() error {
+	return m.pick().SaveState()
+}
+
+func (m *routingManager) Shutdown(ctx context.Context, timeout time.Duration) error {
+	return m.pick().Shutdown(ctx, timeout)
+}
@@ -0,0 +1,67 @@
+package remote
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+// trader implements Trader against a remote node, which records the actions in its own event log
+type trader struct {
+	node *Node
+}
+
+// Trader returns a Trader for the strategies running on the node
+func (n *Node) Trader() live.Trader {
+	return &trader{node: n}
+}
+
+func (t *trader) Act(ctx context.Context, strategyName string, action live.TradingAction) (*live.ActionResult, error) {
+	if err := action.Validate(); err != nil {
+		return nil, err
+	}
+
+	var result live.ActionResult
+	path := fmt.Sprintf("/v1/strategies/%s/actions", url.PathEscape(strategyName))
+	if err := t.node.post(path, action, &result, stopGrace); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (t *trader) Paused(strategyName string) (bool, error) {
+	var state struct {
+		Paused bool `json:"paused"`
+	}
+	if err := t.node.get(fmt.Sprintf("/v1/strategies/%s/paused", url.PathEscape(strategyName)), &state); err != nil {
+		return false, err
+	}
+	return state.Paused, nil
+}
+
+// routingTrader acts on the selected node's strategies, or this machine's
+type routingTrader struct {
+	local  live.Trader
+	target *Target
+}
+
+// RouteTrader decorates the local Trader so it follows the Target
+func RouteTrader(local live.Trader, target *Target) live.Trader {
+	return &routingTrader{local: local, target: target}
+}
+
+func (t *routingTrader) Act(ctx context.Context, strategyName string, action live.TradingAction) (*live.ActionResult, error) {
+	if node := t.target.Node(); node != nil {
+		return node.Trader().Act(ctx, strategyName, action)
+	}
+	return t.local.Act(ctx, strategyName, action)
+}
+
+func (t *routingTrader) Paused(strategyName string) (bool, error) {
+	if node := t.target.Node(); node != nil {
+		return node.Trader().Paused(strategyName)
+	}
+	return t.local.Paused(strategyName)
+}
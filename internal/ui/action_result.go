@@ -0,0 +1,55 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+// RenderActionResult formats what a strategy did about a trading action
+func RenderActionResult(result *live.ActionResult) string {
+	var b strings.Builder
+
+	title := "✓ " + capitalize(result.Action.Description())
+	if len(result.Errors) > 0 {
+		title = "⚠ " + capitalize(result.Action.Description())
+	}
+	b.WriteString(TitleStyle.Render(title))
+	b.WriteString("\n")
+
+	row := func(label, value string) {
+		b.WriteString(ConfirmFieldStyle.Render(fmt.Sprintf("%-18s", label)))
+		b.WriteString(ConfirmValueStyle.Render(value))
+		b.WriteString("\n")
+	}
+
+	switch result.Action.Type {
+	case live.ActionCancelOrders:
+		row("Cancelled orders:", fmt.Sprintf("%d", result.CancelledOrders))
+	case live.ActionClosePosition:
+		if len(result.OrderIDs) > 0 {
+			row("Order placed:", strings.Join(result.OrderIDs, ", "))
+		}
+	}
+
+	signals := "executed"
+	if result.Paused {
+		signals = "paused"
+	}
+	row("Signals:", signals)
+
+	for _, err := range result.Errors {
+		b.WriteString(StatusErrorStyle.Render("✗ " + err))
+		b.WriteString("\n")
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,38 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+// FormatAlert renders an alert notification as a single log line
+func FormatAlert(alert *live.Alert) string {
+	status := strings.ToUpper(string(alert.Severity))
+	if alert.Status == live.AlertResolved {
+		status = "RESOLVED"
+	}
+
+	subject := alert.StrategyName
+	if alert.Asset != "" {
+		subject += "/" + alert.Asset
+	}
+
+	line := fmt.Sprintf("%s  %-9s %-20s %-20s %s",
+		alert.Time.Local().Format("2006-01-02 15:04:05"),
+		status,
+		alert.Rule,
+		subject,
+		alert.Message,
+	)
+
+	switch {
+	case alert.Status == live.AlertResolved:
+		return StatusReadyStyle.Render(line)
+	case alert.Severity == live.SeverityCritical:
+		return StatusDangerStyle.Render(line)
+	default:
+		return StatusRunningStyle.Render(line)
+	}
+}
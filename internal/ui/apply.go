@@ -0,0 +1,65 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/pterm/pterm"
+)
+
+// DisplayPlan shows the steps of an apply plan in the order they run and, once applied, how each went
+func DisplayPlan(plan *live.Plan, applied bool) {
+	header := []string{"#", "Action", "Instance", "Strategy", "Reason"}
+	if applied {
+		header = append(header, "Result")
+	}
+	data := pterm.TableData{header}
+
+	for i, step := range plan.Steps {
+		strategy := step.Strategy
+		if strategy == "" || strategy == step.Name {
+			strategy = "-"
+		}
+
+		row := []string{
+			fmt.Sprintf("%d", i+1),
+			planAction(step.Action),
+			step.Name,
+			strategy,
+			step.Reason,
+		}
+		if applied {
+			row = append(row, stepResult(step))
+		}
+		data = append(data, row)
+	}
+
+	Section(fmt.Sprintf("Plan: %d change(s)", plan.Changes()))
+	_ = pterm.DefaultTable.WithHasHeader().WithData(data).Render()
+}
+
+func planAction(action live.PlanAction) string {
+	switch action {
+	case live.PlanStart:
+		return pterm.Green(string(action))
+	case live.PlanRestart:
+		return pterm.Yellow(string(action))
+	case live.PlanStop:
+		return pterm.Red(string(action))
+	default:
+		return pterm.Gray(string(action))
+	}
+}
+
+func stepResult(step *live.PlanStep) string {
+	switch {
+	case step.Action == live.PlanKeep:
+		return pterm.Gray("-")
+	case step.Error != "":
+		return pterm.Red(step.Error)
+	case step.Done:
+		return pterm.Green("done")
+	default:
+		return pterm.Gray("skipped")
+	}
+}
@@ -0,0 +1,136 @@
+package ui
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// chartBlocks fill a cell from the bottom in eighths
+var chartBlocks = []string{" ", "▁", "▂", "▃", "▄", "▅", "▆", "▇", "█"}
+
+// chartLabelWidth is the width of the value axis left of a chart
+const chartLabelWidth = 11
+
+var chartAxisStyle = lipgloss.NewStyle().Foreground(ColorMuted)
+
+// AreaChart renders values, oldest first, as a filled area of width columns and height rows with its range
+// on the left. More values than columns are bucketed by their last value, which suits an equity curve.
+func AreaChart(values []float64, width, height int, color lipgloss.Color) string {
+	columns := resample(values, width, func(bucket []float64) float64 { return bucket[len(bucket)-1] })
+	if len(columns) == 0 {
+		return ""
+	}
+
+	low, high := columns[0], columns[0]
+	for _, v := range columns {
+		low, high = math.Min(low, v), math.Max(high, v)
+	}
+	span := high - low
+
+	style := lipgloss.NewStyle().Foreground(color)
+	rows := make([]string, height)
+	for row := range rows {
+		// Rows are built top down; level is how many eighths lie below this row
+		level := (height - row - 1) * 8
+
+		var line strings.Builder
+		for _, v := range columns {
+			// A flat series fills half the chart rather than none of it
+			fill := height * 4
+			if span > 0 {
+				fill = int(math.Round((v-low)/span*float64(height*8-1))) + 1
+			}
+			line.WriteString(chartBlocks[min(max(fill-level, 0), 8)])
+		}
+		rows[row] = chartAxis(row, height, high, low) + style.Render(line.String())
+	}
+	return strings.Join(rows, "\n")
+}
+
+// DrawdownChart renders drawdowns, oldest first and zero or negative, as bars hanging down from zero.
+// More values than columns are bucketed by their deepest value.
+func DrawdownChart(values []float64, width, height int, color lipgloss.Color) string {
+	columns := resample(values, width, func(bucket []float64) float64 {
+		deepest := bucket[0]
+		for _, v := range bucket {
+			deepest = math.Min(deepest, v)
+		}
+		return deepest
+	})
+	if len(columns) == 0 {
+		return ""
+	}
+
+	deepest := 0.0
+	for _, v := range columns {
+		deepest = math.Min(deepest, v)
+	}
+
+	style := lipgloss.NewStyle().Foreground(color)
+	rows := make([]string, height)
+	for row := range rows {
+		// Only full and upper half blocks exist, so bars hang in halves of a row
+		level := row * 2
+
+		var line strings.Builder
+		for _, v := range columns {
+			fill := 0
+			if deepest < 0 && v < 0 {
+				fill = int(math.Round(v/deepest*float64(height*2-1))) + 1
+			}
+			switch {
+			case fill-level >= 2:
+				line.WriteString("█")
+			case fill-level == 1:
+				line.WriteString("▀")
+			default:
+				line.WriteString(" ")
+			}
+		}
+		rows[row] = chartAxis(row, height, 0, deepest) + style.Render(line.String())
+	}
+	return strings.Join(rows, "\n")
+}
+
+// ChartTimeAxis labels the first and last column of a chart of width columns, aligned under it
+func ChartTimeAxis(first, last string, width int) string {
+	gap := max(width-len(first)-len(last), 1)
+	return strings.Repeat(" ", chartLabelWidth) + chartAxisStyle.Render(first+strings.Repeat(" ", gap)+last)
+}
+
+// chartAxis labels the top row with high and the bottom row with low
+func chartAxis(row, height int, high, low float64) string {
+	label := ""
+	switch row {
+	case 0:
+		label = formatChartValue(high)
+	case height - 1:
+		label = formatChartValue(low)
+	}
+	return chartAxisStyle.Render(fmt.Sprintf("%*s ┤", chartLabelWidth-2, label))
+}
+
+func formatChartValue(v float64) string {
+	if math.Abs(v) >= 100000 {
+		return fmt.Sprintf("%.0fk", v/1000)
+	}
+	return fmt.Sprintf("%.2f", v)
+}
+
+// resample reduces values to at most n, each the pick of an equal run of consecutive values
+func resample(values []float64, n int, pick func([]float64) float64) []float64 {
+	if len(values) <= n || n <= 0 {
+		return values
+	}
+
+	out := make([]float64, n)
+	for i := range out {
+		from := i * len(values) / n
+		to := (i + 1) * len(values) / n
+		out[i] = pick(values[from:to])
+	}
+	return out
+}
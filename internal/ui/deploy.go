@@ -0,0 +1,61 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/pterm/pterm"
+)
+
+// DisplayDeployment shows each member of a deployment in start order and the aggregate state
+func DisplayDeployment(status *live.DeploymentStatus) {
+	data := pterm.TableData{
+		{"#", "Strategy", "State", "PID", "Uptime", "Detail"},
+	}
+
+	for i, member := range status.Members {
+		pid, uptime := "-", "-"
+		if member.PID > 0 {
+			pid = fmt.Sprintf("%d", member.PID)
+		}
+		if member.Uptime > 0 {
+			uptime = FormatSessionDuration(member.Uptime)
+		}
+
+		data = append(data, []string{
+			fmt.Sprintf("%d", i+1),
+			member.Strategy,
+			memberState(member.State),
+			pid,
+			uptime,
+			member.Detail,
+		})
+	}
+
+	Section(fmt.Sprintf("Deployment %s: %s", status.Name, deploymentState(status.State)))
+	_ = pterm.DefaultTable.WithHasHeader().WithData(data).Render()
+}
+
+func memberState(state live.MemberState) string {
+	switch state {
+	case live.MemberRunning:
+		return pterm.Green(string(state))
+	case live.MemberUnhealthy:
+		return pterm.Yellow(string(state))
+	case live.MemberCrashed:
+		return pterm.Red(string(state))
+	default:
+		return pterm.Gray(string(state))
+	}
+}
+
+func deploymentState(state live.DeploymentState) string {
+	switch state {
+	case live.DeploymentUp:
+		return pterm.Green(string(state))
+	case live.DeploymentDegraded:
+		return pterm.Yellow(string(state))
+	default:
+		return pterm.Gray(string(state))
+	}
+}
@@ -0,0 +1,36 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+// FormatEvent renders a lifecycle event as a single log line
+func FormatEvent(event *live.Event) string {
+	instance := "-"
+	if event.InstanceID != "" {
+		instance = event.InstanceID
+		if len(instance) > 8 {
+			instance = instance[:8]
+		}
+	}
+
+	line := fmt.Sprintf("%s  %-12s %-16s %-20s %-8s",
+		event.Time.Local().Format("2006-01-02 15:04:05"),
+		event.Actor,
+		event.Type,
+		event.StrategyName,
+		instance,
+	)
+	if event.Reason != "" {
+		line += "  " + event.Reason
+	}
+
+	switch event.Type {
+	case live.EventCrashed, live.EventStartFailed, live.EventKilled:
+		return StatusErrorStyle.Render(line)
+	default:
+		return line
+	}
+}
@@ -0,0 +1,117 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/pterm/pterm"
+)
+
+// DisplaySessionHistory shows archived sessions in a table, newest first
+func DisplaySessionHistory(sessions []*live.Session) {
+	data := pterm.TableData{
+		{"Strategy", "Started", "Duration", "Outcome", "Restarts", "Trades", "PnL", "Fees", "Build"},
+	}
+
+	for _, session := range sessions {
+		trades, pnl, fees := "-", "-", "-"
+		if session.Stats != nil {
+			trades = fmt.Sprintf("%d", session.Stats.TradeCount)
+			if session.Stats.PnL != nil {
+				pnl = session.Stats.PnL.TotalPnL.StringFixed(2)
+				fees = session.Stats.PnL.TotalFees.StringFixed(2)
+			}
+		}
+
+		data = append(data, []string{
+			session.StrategyName,
+			session.StartedAt.Local().Format("2006-01-02 15:04"),
+			FormatSessionDuration(session.Duration()),
+			SessionOutcome(session),
+			fmt.Sprintf("%d", session.Restarts),
+			trades,
+			pnl,
+			fees,
+			shortHash(session.Binary),
+		})
+	}
+
+	_ = pterm.DefaultTable.WithHasHeader().WithData(data).Render()
+}
+
+// RenderSession formats everything archived about a session. It is shared by the CLI and the monitor.
+func RenderSession(session *live.Session) string {
+	var b strings.Builder
+
+	b.WriteString(TitleStyle.Render(fmt.Sprintf("%s - %s", session.StrategyName, SessionOutcome(session))))
+	b.WriteString("\n")
+
+	row := func(label, value string) {
+		b.WriteString(ConfirmFieldStyle.Render(fmt.Sprintf("%-18s", label)))
+		b.WriteString(ConfirmValueStyle.Render(value))
+		b.WriteString("\n")
+	}
+
+	row("Session:", session.ID)
+	row("Started:", session.StartedAt.Local().Format("2006-01-02 15:04:05"))
+	row("Ended:", session.EndedAt.Local().Format("2006-01-02 15:04:05"))
+	row("Duration:", FormatSessionDuration(session.Duration()))
+	row("Restarts:", fmt.Sprintf("%d", session.Restarts))
+	if session.Error != "" {
+		row("Exit reason:", session.Error)
+	}
+
+	if session.Binary != nil {
+		row("Build:", session.Binary.Hash)
+		if session.Binary.Version != "" {
+			row("Version:", session.Binary.Version)
+		}
+	}
+
+	if session.Stats == nil {
+		b.WriteString(ConfirmWarningStyle.Render("No final figures - the instance couldn't be queried before it ended"))
+		b.WriteString("\n")
+		return strings.TrimRight(b.String(), "\n")
+	}
+
+	row("Trades:", fmt.Sprintf("%d", session.Stats.TradeCount))
+	if pnl := session.Stats.PnL; pnl != nil {
+		row("Realized PnL:", pnl.RealizedPnL.StringFixed(2))
+		row("Unrealized PnL:", pnl.UnrealizedPnL.StringFixed(2))
+		row("Total PnL:", pnl.TotalPnL.StringFixed(2))
+		row("Fees:", pnl.TotalFees.StringFixed(2))
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// SessionOutcome describes how a session ended
+func SessionOutcome(session *live.Session) string {
+	if session.Status == live.StatusCrashed {
+		if session.CrashReason != "" {
+			return fmt.Sprintf("crashed (%s)", session.CrashReason)
+		}
+		return "crashed"
+	}
+	if session.StopMode != "" {
+		return fmt.Sprintf("stopped (%s)", session.StopMode)
+	}
+	return "stopped"
+}
+
+// FormatSessionDuration rounds a session's duration for display
+func FormatSessionDuration(d time.Duration) string {
+	if d >= time.Hour {
+		return d.Round(time.Minute).String()
+	}
+	return d.Round(time.Second).String()
+}
+
+func shortHash(info *live.BinaryInfo) string {
+	if info == nil || len(info.Hash) < 12 {
+		return "-"
+	}
+	return info.Hash[:12]
+}
@@ -0,0 +1,57 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/pterm/pterm"
+)
+
+// DisplayInstances lists instances on a node with whether each answers health checks.
+// Instances with an empty ID were found by their monitoring socket only.
+func DisplayInstances(node string, instances []*live.Instance, healthy map[string]bool) {
+	data := pterm.TableData{
+		{"Strategy", "Instance", "Status", "PID", "Uptime", "Health"},
+	}
+
+	for _, instance := range instances {
+		id, pid, uptime := "-", "-", "-"
+		if instance.ID != "" {
+			id = instance.ID
+		}
+		if instance.PID > 0 {
+			pid = fmt.Sprintf("%d", instance.PID)
+		}
+		if !instance.StartedAt.IsZero() && instance.Status == live.StatusRunning {
+			uptime = FormatSessionDuration(time.Since(instance.StartedAt))
+		}
+
+		health := pterm.Gray("-")
+		if instance.Status == live.StatusRunning || instance.Status == live.StatusStandby {
+			if healthy[instance.StrategyName] {
+				health = pterm.Green("healthy")
+			} else {
+				health = pterm.Red("unreachable")
+			}
+		}
+
+		data = append(data, []string{instance.StrategyName, id, instanceStatus(instance.Status), pid, uptime, health})
+	}
+
+	Section(fmt.Sprintf("Instances on %s", node))
+	_ = pterm.DefaultTable.WithHasHeader().WithData(data).Render()
+}
+
+func instanceStatus(status live.InstanceStatus) string {
+	switch status {
+	case live.StatusRunning:
+		return pterm.Green(string(status))
+	case live.StatusStandby, live.StatusRestarting:
+		return pterm.Yellow(string(status))
+	case live.StatusCrashed:
+		return pterm.Red(string(status))
+	default:
+		return pterm.Gray(string(status))
+	}
+}
@@ -0,0 +1,124 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+// RenderPanicReport formats the summary of an emergency stop. It is shared by the CLI and the monitor.
+func RenderPanicReport(report *live.PanicReport) string {
+	var b strings.Builder
+
+	title := fmt.Sprintf("✓ Panic complete: %d instances stopped in %s",
+		len(report.Stops), report.Duration.Round(100*time.Millisecond))
+	if !report.Clean() {
+		title = fmt.Sprintf("⚠ Panic finished with problems after %s - check the exchanges",
+			report.Duration.Round(100*time.Millisecond))
+	}
+	b.WriteString(TitleStyle.Render(title))
+	b.WriteString("\n")
+
+	row := func(label, value string) {
+		b.WriteString(ConfirmFieldStyle.Render(fmt.Sprintf("%-18s", label)))
+		b.WriteString(ConfirmValueStyle.Render(value))
+		b.WriteString("\n")
+	}
+
+	row("Mode:", report.Mode.Description())
+
+	if len(report.Stops) == 0 {
+		b.WriteString(StrategyMetaStyle.Render("No instances were running"))
+		b.WriteString("\n")
+	}
+	for _, stop := range report.Stops {
+		line := fmt.Sprintf("  %-20s %s", stop.StrategyName, panicStopOutcome(stop))
+		switch {
+		case stop.Error != "":
+			b.WriteString(StatusErrorStyle.Render("✗" + line))
+		case stop.Killed && !stop.Standby:
+			b.WriteString(ConfirmWarningStyle.Render("⚠" + line))
+		default:
+			b.WriteString(StatusRunningStyle.Render("✓" + line))
+		}
+		b.WriteString("\n")
+	}
+
+	for _, exchange := range report.Exchanges {
+		if exchange.Error != "" {
+			b.WriteString(StatusErrorStyle.Render(fmt.Sprintf("✗ %s not swept: %s", exchange.Name, exchange.Error)))
+			b.WriteString("\n")
+		}
+	}
+
+	if sweep := report.Sweep; sweep != nil {
+		var swept []string
+		for _, exchange := range report.Exchanges {
+			if exchange.Error == "" {
+				swept = append(swept, exchange.Name)
+			}
+		}
+		row("Swept:", strings.Join(swept, ", "))
+		row("Cancelled orders:", fmt.Sprintf("%d", sweep.CancelledOrders))
+		row("Closed positions:", fmt.Sprintf("%d", sweep.ClosedPositions))
+
+		if !sweep.Snapshot {
+			b.WriteString(ConfirmWarningStyle.Render("Some exchanges couldn't be queried - check them for open orders and positions"))
+			b.WriteString("\n")
+		} else {
+			row("Open orders:", fmt.Sprintf("%d", sweep.OpenOrders))
+			row("Open positions:", fmt.Sprintf("%d", len(sweep.Positions)))
+			for _, position := range sweep.Positions {
+				b.WriteString(StrategyMetaStyle.Render(fmt.Sprintf("  %s %s %s @ %s (uPnL %s)",
+					position.Exchange,
+					position.Symbol.Symbol(),
+					position.Size.String(),
+					position.EntryPrice.String(),
+					position.UnrealizedPnL.StringFixed(2),
+				)))
+				b.WriteString("\n")
+			}
+		}
+
+		for _, err := range sweep.Errors {
+			b.WriteString(StatusErrorStyle.Render("✗ " + err))
+			b.WriteString("\n")
+		}
+	}
+
+	for _, err := range report.Errors {
+		b.WriteString(StatusErrorStyle.Render("✗ " + err))
+		b.WriteString("\n")
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// panicStopOutcome describes what happened to one instance
+func panicStopOutcome(stop *live.PanicStop) string {
+	switch {
+	case stop.Error != "":
+		return stop.Error
+	case stop.Standby:
+		return "standby killed"
+	case stop.Killed:
+		return "killed - it didn't respond, so it couldn't drain"
+	case stop.Result == nil:
+		return "stopped through monitoring, final state unknown"
+	}
+
+	result := stop.Result
+	outcome := "stopped"
+	if result.Mode != "" && result.Mode != live.StopModeLeave {
+		outcome = fmt.Sprintf("stopped, %d orders cancelled, %d positions closed", result.CancelledOrders, result.ClosedPositions)
+	}
+	if result.Forced {
+		outcome += " (force killed after draining)"
+	}
+	if len(result.Errors) > 0 {
+		outcome += fmt.Sprintf(" - %d errors", len(result.Errors))
+	}
+	return outcome
+}
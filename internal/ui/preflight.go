@@ -0,0 +1,38 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/pterm/pterm"
+)
+
+// DisplayPreflight prints the pre-flight checklist of a strategy
+func DisplayPreflight(report *live.PreflightReport) {
+	Section(fmt.Sprintf("Pre-flight: %s", report.StrategyName))
+
+	for _, check := range report.Checks {
+		line := fmt.Sprintf("%s %-24s %s", preflightMark(check.Status), check.Name, pterm.Gray(check.Detail))
+		fmt.Println(line)
+	}
+	fmt.Println()
+
+	if failed := len(report.Failed()); failed > 0 {
+		Error(fmt.Sprintf("%d of %d checks failed", failed, len(report.Checks)))
+		return
+	}
+	Success("Ready to go live")
+}
+
+func preflightMark(status live.CheckStatus) string {
+	switch status {
+	case live.CheckPass:
+		return pterm.Green("✓")
+	case live.CheckWarn:
+		return pterm.Yellow("!")
+	case live.CheckFail:
+		return pterm.Red("✗")
+	default:
+		return pterm.Gray("-")
+	}
+}
@@ -0,0 +1,62 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+// RenderReloadResult formats the outcome of a strategy hot-swap
+func RenderReloadResult(result *live.ReloadResult) string {
+	var b strings.Builder
+
+	switch {
+	case result.RolledBack:
+		b.WriteString(StatusErrorStyle.Render(fmt.Sprintf("⚠ Reload of %s failed during %s, rolled back", result.StrategyName, result.FailedPhase)))
+	case result.FailedPhase != "":
+		b.WriteString(StatusErrorStyle.Render(fmt.Sprintf("✗ Reload of %s failed during %s", result.StrategyName, result.FailedPhase)))
+	default:
+		b.WriteString(TitleStyle.Render(fmt.Sprintf("✓ Reloaded %s", result.StrategyName)))
+	}
+	b.WriteString("\n")
+
+	row := func(label, value string) {
+		b.WriteString(ConfirmFieldStyle.Render(fmt.Sprintf("%-18s", label)))
+		b.WriteString(ConfirmValueStyle.Render(value))
+		b.WriteString("\n")
+	}
+
+	recompiled := "no (build was up to date)"
+	if result.Recompiled {
+		recompiled = "yes"
+	}
+	row("Recompiled:", recompiled)
+	row("Old PID:", fmt.Sprintf("%d", result.OldPID))
+	if result.NewPID > 0 {
+		row("New PID:", fmt.Sprintf("%d", result.NewPID))
+	}
+	if result.Handoff != nil && result.Handoff.Snapshot {
+		row("Handed over:", fmt.Sprintf("%d orders, %d positions", result.Handoff.OpenOrders, len(result.Handoff.Positions)))
+	}
+	if result.Adopted != nil {
+		row("Taken over:", fmt.Sprintf("%d orders, %d positions", result.Adopted.OpenOrders, len(result.Adopted.Positions)))
+	}
+	row("Took:", result.Duration.Round(100*time.Millisecond).String())
+
+	if result.FailedPhase != "" && !result.RolledBack {
+		b.WriteString(SubtitleStyle.Render("The running instance was left untouched"))
+		b.WriteString("\n")
+	}
+	if result.Error != "" {
+		b.WriteString(StatusErrorStyle.Render("✗ " + result.Error))
+		b.WriteString("\n")
+	}
+	for _, warning := range result.Warnings {
+		b.WriteString(ConfirmWarningStyle.Render("⚠ " + warning))
+		b.WriteString("\n")
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
@@ -0,0 +1,62 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+	"github.com/pterm/pterm"
+)
+
+// DisplaySchedules shows each scheduled strategy's window and next transition
+func DisplaySchedules(statuses []*live.ScheduleStatus) {
+	data := pterm.TableData{
+		{"Strategy", "Timezone", "Window", "Next"},
+	}
+
+	for _, status := range statuses {
+		window := "closed"
+		if status.Open {
+			window = "open"
+		}
+		next := FormatScheduleNext(status, time.Now())
+		if status.Error != "" {
+			window = "invalid"
+			next = status.Error
+		}
+
+		data = append(data, []string{status.StrategyName, status.Timezone, window, next})
+	}
+
+	_ = pterm.DefaultTable.WithHasHeader().WithData(data).Render()
+}
+
+// FormatScheduleNext describes a strategy's next scheduled transition, e.g. "stop Fri 17:00 EST (in 3h20m)"
+func FormatScheduleNext(status *live.ScheduleStatus, now time.Time) string {
+	if status.NextAt.IsZero() {
+		return "no further transitions"
+	}
+
+	in := status.NextAt.Sub(now).Round(time.Minute)
+	if in < time.Minute {
+		in = time.Minute
+	}
+	return fmt.Sprintf("%s %s (in %s)", status.NextAction, status.NextAt.Format("Mon 15:04 MST"), formatShortDuration(in))
+}
+
+// formatShortDuration drops the trailing zero units Duration.String adds, e.g. "3h20m" instead of "3h20m0s"
+func formatShortDuration(d time.Duration) string {
+	days := int(d / (24 * time.Hour))
+	d -= time.Duration(days) * 24 * time.Hour
+	hours := int(d / time.Hour)
+	minutes := int((d % time.Hour) / time.Minute)
+
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd%dh", days, hours)
+	case hours > 0:
+		return fmt.Sprintf("%dh%dm", hours, minutes)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
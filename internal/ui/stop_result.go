@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/backtesting-org/kronos-cli/pkg/live"
+)
+
+// RenderStopResult formats the final state of a stopped strategy. It is shared by the CLI and the monitor.
+func RenderStopResult(result *live.StopResult) string {
+	var b strings.Builder
+
+	title := fmt.Sprintf("✓ Stopped %s", result.StrategyName)
+	if result.Forced {
+		title = fmt.Sprintf("⚠ Stopped %s (force killed)", result.StrategyName)
+	}
+	b.WriteString(TitleStyle.Render(title))
+	b.WriteString("\n")
+
+	row := func(label, value string) {
+		b.WriteString(ConfirmFieldStyle.Render(fmt.Sprintf("%-18s", label)))
+		b.WriteString(ConfirmValueStyle.Render(value))
+		b.WriteString("\n")
+	}
+
+	if result.Mode != "" {
+		row("Mode:", result.Mode.Description())
+	}
+	if result.Duration > 0 {
+		row("Took:", result.Duration.Round(100*time.Millisecond).String())
+	}
+
+	if !result.Snapshot {
+		b.WriteString(ConfirmWarningStyle.Render("No final snapshot - check the exchange for open orders and positions"))
+		b.WriteString("\n")
+	} else {
+		row("Cancelled orders:", fmt.Sprintf("%d", result.CancelledOrders))
+		row("Closed positions:", fmt.Sprintf("%d", result.ClosedPositions))
+		row("Open orders:", fmt.Sprintf("%d", result.OpenOrders))
+		row("Open positions:", fmt.Sprintf("%d", len(result.Positions)))
+
+		for _, position := range result.Positions {
+			b.WriteString(StrategyMetaStyle.Render(fmt.Sprintf("  %s %s %s @ %s (uPnL %s)",
+				position.Exchange,
+				position.Symbol.Symbol(),
+				position.Size.String(),
+				position.EntryPrice.String(),
+				position.UnrealizedPnL.StringFixed(2),
+			)))
+			b.WriteString("\n")
+		}
+	}
+
+	if result.PnL != nil {
+		row("Realized PnL:", result.PnL.RealizedPnL.StringFixed(2))
+		row("Unrealized PnL:", result.PnL.UnrealizedPnL.StringFixed(2))
+		row("Total PnL:", result.PnL.TotalPnL.StringFixed(2))
+		row("Fees:", result.PnL.TotalFees.StringFixed(2))
+	}
+
+	// A timed out drain is reported among the errors
+	for _, err := range result.Errors {
+		b.WriteString(StatusErrorStyle.Render("✗ " + err))
+		b.WriteString("\n")
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
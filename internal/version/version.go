@@ -0,0 +1,5 @@
+package version
+
+// Version is the CLI release, overridable at build time with
+// -ldflags "-X github.com/backtesting-org/kronos-cli/internal/version.Version=v0.2.0"
+var Version = "v0.1.0"
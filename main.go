@@ -6,10 +6,16 @@ import (
 
 	"github.com/backtesting-org/kronos-cli/cmd"
 	"github.com/backtesting-org/kronos-cli/internal/app"
+	"github.com/backtesting-org/kronos-cli/internal/services/live/manager"
 	"go.uber.org/fx"
 )
 
 func main() {
+	// A spawned strategy takes on its resource limits before anything else runs
+	if err := manager.ExecWithLimits(); err != nil {
+		log.Fatalf("failed to apply resource limits: %v", err)
+	}
+
 	fxApp := fx.New(
 		app.Module,
 		cmd.Module, // Use the command module
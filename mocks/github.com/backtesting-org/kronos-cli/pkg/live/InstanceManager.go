@@ -85,17 +85,17 @@ func (_c *InstanceManager_Get_Call) RunAndReturn(run func(string) (*live.Instanc
 	return _c
 }
 
-// Kill provides a mock function with given fields: instanceID
-func (_m *InstanceManager) Kill(instanceID string) error {
-	ret := _m.Called(instanceID)
+// Kill provides a mock function with given fields: ctx, instanceID
+func (_m *InstanceManager) Kill(ctx context.Context, instanceID string) error {
+	ret := _m.Called(ctx, instanceID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Kill")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(instanceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, instanceID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -109,14 +109,15 @@ type InstanceManager_Kill_Call struct {
 }
 
 // Kill is a helper method to define mock.On call
+//   - ctx context.Context
 //   - instanceID string
-func (_e *InstanceManager_Expecter) Kill(instanceID interface{}) *InstanceManager_Kill_Call {
-	return &InstanceManager_Kill_Call{Call: _e.mock.On("Kill", instanceID)}
+func (_e *InstanceManager_Expecter) Kill(ctx interface{}, instanceID interface{}) *InstanceManager_Kill_Call {
+	return &InstanceManager_Kill_Call{Call: _e.mock.On("Kill", ctx, instanceID)}
 }
 
-func (_c *InstanceManager_Kill_Call) Run(run func(instanceID string)) *InstanceManager_Kill_Call {
+func (_c *InstanceManager_Kill_Call) Run(run func(ctx context.Context, instanceID string)) *InstanceManager_Kill_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -126,7 +127,7 @@ func (_c *InstanceManager_Kill_Call) Return(_a0 error) *InstanceManager_Kill_Cal
 	return _c
 }
 
-func (_c *InstanceManager_Kill_Call) RunAndReturn(run func(string) error) *InstanceManager_Kill_Call {
+func (_c *InstanceManager_Kill_Call) RunAndReturn(run func(context.Context, string) error) *InstanceManager_Kill_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -235,6 +236,52 @@ func (_c *InstanceManager_LoadRunning_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// Promote provides a mock function with given fields: instanceID
+func (_m *InstanceManager) Promote(instanceID string) error {
+	ret := _m.Called(instanceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Promote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(instanceID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InstanceManager_Promote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Promote'
+type InstanceManager_Promote_Call struct {
+	*mock.Call
+}
+
+// Promote is a helper method to define mock.On call
+//   - instanceID string
+func (_e *InstanceManager_Expecter) Promote(instanceID interface{}) *InstanceManager_Promote_Call {
+	return &InstanceManager_Promote_Call{Call: _e.mock.On("Promote", instanceID)}
+}
+
+func (_c *InstanceManager_Promote_Call) Run(run func(instanceID string)) *InstanceManager_Promote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *InstanceManager_Promote_Call) Return(_a0 error) *InstanceManager_Promote_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InstanceManager_Promote_Call) RunAndReturn(run func(string) error) *InstanceManager_Promote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveState provides a mock function with no fields
 func (_m *InstanceManager) SaveState() error {
 	ret := _m.Called()
@@ -387,17 +434,77 @@ func (_c *InstanceManager_Start_Call) RunAndReturn(run func(context.Context, *co
 	return _c
 }
 
-// Stop provides a mock function with given fields: instanceID
-func (_m *InstanceManager) Stop(instanceID string) error {
-	ret := _m.Called(instanceID)
+// StartStandby provides a mock function with given fields: ctx, strategy, frameworkRoot
+func (_m *InstanceManager) StartStandby(ctx context.Context, strategy *config.Strategy, frameworkRoot string) (*live.Instance, error) {
+	ret := _m.Called(ctx, strategy, frameworkRoot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartStandby")
+	}
+
+	var r0 *live.Instance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *config.Strategy, string) (*live.Instance, error)); ok {
+		return rf(ctx, strategy, frameworkRoot)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *config.Strategy, string) *live.Instance); ok {
+		r0 = rf(ctx, strategy, frameworkRoot)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*live.Instance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *config.Strategy, string) error); ok {
+		r1 = rf(ctx, strategy, frameworkRoot)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InstanceManager_StartStandby_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartStandby'
+type InstanceManager_StartStandby_Call struct {
+	*mock.Call
+}
+
+// StartStandby is a helper method to define mock.On call
+//   - ctx context.Context
+//   - strategy *config.Strategy
+//   - frameworkRoot string
+func (_e *InstanceManager_Expecter) StartStandby(ctx interface{}, strategy interface{}, frameworkRoot interface{}) *InstanceManager_StartStandby_Call {
+	return &InstanceManager_StartStandby_Call{Call: _e.mock.On("StartStandby", ctx, strategy, frameworkRoot)}
+}
+
+func (_c *InstanceManager_StartStandby_Call) Run(run func(ctx context.Context, strategy *config.Strategy, frameworkRoot string)) *InstanceManager_StartStandby_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*config.Strategy), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *InstanceManager_StartStandby_Call) Return(_a0 *live.Instance, _a1 error) *InstanceManager_StartStandby_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InstanceManager_StartStandby_Call) RunAndReturn(run func(context.Context, *config.Strategy, string) (*live.Instance, error)) *InstanceManager_StartStandby_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with given fields: ctx, instanceID
+func (_m *InstanceManager) Stop(ctx context.Context, instanceID string) error {
+	ret := _m.Called(ctx, instanceID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Stop")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(instanceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, instanceID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -411,14 +518,15 @@ type InstanceManager_Stop_Call struct {
 }
 
 // Stop is a helper method to define mock.On call
+//   - ctx context.Context
 //   - instanceID string
-func (_e *InstanceManager_Expecter) Stop(instanceID interface{}) *InstanceManager_Stop_Call {
-	return &InstanceManager_Stop_Call{Call: _e.mock.On("Stop", instanceID)}
+func (_e *InstanceManager_Expecter) Stop(ctx interface{}, instanceID interface{}) *InstanceManager_Stop_Call {
+	return &InstanceManager_Stop_Call{Call: _e.mock.On("Stop", ctx, instanceID)}
 }
 
-func (_c *InstanceManager_Stop_Call) Run(run func(instanceID string)) *InstanceManager_Stop_Call {
+func (_c *InstanceManager_Stop_Call) Run(run func(ctx context.Context, instanceID string)) *InstanceManager_Stop_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -428,22 +536,22 @@ func (_c *InstanceManager_Stop_Call) Return(_a0 error) *InstanceManager_Stop_Cal
 	return _c
 }
 
-func (_c *InstanceManager_Stop_Call) RunAndReturn(run func(string) error) *InstanceManager_Stop_Call {
+func (_c *InstanceManager_Stop_Call) RunAndReturn(run func(context.Context, string) error) *InstanceManager_Stop_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// StopByStrategyName provides a mock function with given fields: strategyName
-func (_m *InstanceManager) StopByStrategyName(strategyName string) error {
-	ret := _m.Called(strategyName)
+// StopByStrategyName provides a mock function with given fields: ctx, strategyName
+func (_m *InstanceManager) StopByStrategyName(ctx context.Context, strategyName string) error {
+	ret := _m.Called(ctx, strategyName)
 
 	if len(ret) == 0 {
 		panic("no return value specified for StopByStrategyName")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(strategyName)
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, strategyName)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -457,14 +565,15 @@ type InstanceManager_StopByStrategyName_Call struct {
 }
 
 // StopByStrategyName is a helper method to define mock.On call
+//   - ctx context.Context
 //   - strategyName string
-func (_e *InstanceManager_Expecter) StopByStrategyName(strategyName interface{}) *InstanceManager_StopByStrategyName_Call {
-	return &InstanceManager_StopByStrategyName_Call{Call: _e.mock.On("StopByStrategyName", strategyName)}
+func (_e *InstanceManager_Expecter) StopByStrategyName(ctx interface{}, strategyName interface{}) *InstanceManager_StopByStrategyName_Call {
+	return &InstanceManager_StopByStrategyName_Call{Call: _e.mock.On("StopByStrategyName", ctx, strategyName)}
 }
 
-func (_c *InstanceManager_StopByStrategyName_Call) Run(run func(strategyName string)) *InstanceManager_StopByStrategyName_Call {
+func (_c *InstanceManager_StopByStrategyName_Call) Run(run func(ctx context.Context, strategyName string)) *InstanceManager_StopByStrategyName_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -474,7 +583,67 @@ func (_c *InstanceManager_StopByStrategyName_Call) Return(_a0 error) *InstanceMa
 	return _c
 }
 
-func (_c *InstanceManager_StopByStrategyName_Call) RunAndReturn(run func(string) error) *InstanceManager_StopByStrategyName_Call {
+func (_c *InstanceManager_StopByStrategyName_Call) RunAndReturn(run func(context.Context, string) error) *InstanceManager_StopByStrategyName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StopStrategy provides a mock function with given fields: ctx, strategyName, opts
+func (_m *InstanceManager) StopStrategy(ctx context.Context, strategyName string, opts live.StopOptions) (*live.StopResult, error) {
+	ret := _m.Called(ctx, strategyName, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopStrategy")
+	}
+
+	var r0 *live.StopResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, live.StopOptions) (*live.StopResult, error)); ok {
+		return rf(ctx, strategyName, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, live.StopOptions) *live.StopResult); ok {
+		r0 = rf(ctx, strategyName, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*live.StopResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, live.StopOptions) error); ok {
+		r1 = rf(ctx, strategyName, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InstanceManager_StopStrategy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopStrategy'
+type InstanceManager_StopStrategy_Call struct {
+	*mock.Call
+}
+
+// StopStrategy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - strategyName string
+//   - opts live.StopOptions
+func (_e *InstanceManager_Expecter) StopStrategy(ctx interface{}, strategyName interface{}, opts interface{}) *InstanceManager_StopStrategy_Call {
+	return &InstanceManager_StopStrategy_Call{Call: _e.mock.On("StopStrategy", ctx, strategyName, opts)}
+}
+
+func (_c *InstanceManager_StopStrategy_Call) Run(run func(ctx context.Context, strategyName string, opts live.StopOptions)) *InstanceManager_StopStrategy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(live.StopOptions))
+	})
+	return _c
+}
+
+func (_c *InstanceManager_StopStrategy_Call) Return(_a0 *live.StopResult, _a1 error) *InstanceManager_StopStrategy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InstanceManager_StopStrategy_Call) RunAndReturn(run func(context.Context, string, live.StopOptions) (*live.StopResult, error)) *InstanceManager_StopStrategy_Call {
 	_c.Call.Return(run)
 	return _c
 }
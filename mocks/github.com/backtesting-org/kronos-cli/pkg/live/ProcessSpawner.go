@@ -27,6 +27,52 @@ func (_m *ProcessSpawner) EXPECT() *ProcessSpawner_Expecter {
 	return &ProcessSpawner_Expecter{mock: &_m.Mock}
 }
 
+// ApplyLimits provides a mock function with given fields: instance
+func (_m *ProcessSpawner) ApplyLimits(instance *live.Instance) error {
+	ret := _m.Called(instance)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyLimits")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*live.Instance) error); ok {
+		r0 = rf(instance)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProcessSpawner_ApplyLimits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyLimits'
+type ProcessSpawner_ApplyLimits_Call struct {
+	*mock.Call
+}
+
+// ApplyLimits is a helper method to define mock.On call
+//   - instance *live.Instance
+func (_e *ProcessSpawner_Expecter) ApplyLimits(instance interface{}) *ProcessSpawner_ApplyLimits_Call {
+	return &ProcessSpawner_ApplyLimits_Call{Call: _e.mock.On("ApplyLimits", instance)}
+}
+
+func (_c *ProcessSpawner_ApplyLimits_Call) Run(run func(instance *live.Instance)) *ProcessSpawner_ApplyLimits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*live.Instance))
+	})
+	return _c
+}
+
+func (_c *ProcessSpawner_ApplyLimits_Call) Return(_a0 error) *ProcessSpawner_ApplyLimits_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProcessSpawner_ApplyLimits_Call) RunAndReturn(run func(*live.Instance) error) *ProcessSpawner_ApplyLimits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AttachMonitor provides a mock function with given fields: instance
 func (_m *ProcessSpawner) AttachMonitor(instance *live.Instance) error {
 	ret := _m.Called(instance)
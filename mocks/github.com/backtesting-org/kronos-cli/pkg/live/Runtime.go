@@ -17,6 +17,52 @@ func (_m *Runtime) EXPECT() *Runtime_Expecter {
 	return &Runtime_Expecter{mock: &_m.Mock}
 }
 
+// CheckPlugin provides a mock function with given fields: strategyDir
+func (_m *Runtime) CheckPlugin(strategyDir string) error {
+	ret := _m.Called(strategyDir)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckPlugin")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(strategyDir)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Runtime_CheckPlugin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckPlugin'
+type Runtime_CheckPlugin_Call struct {
+	*mock.Call
+}
+
+// CheckPlugin is a helper method to define mock.On call
+//   - strategyDir string
+func (_e *Runtime_Expecter) CheckPlugin(strategyDir interface{}) *Runtime_CheckPlugin_Call {
+	return &Runtime_CheckPlugin_Call{Call: _e.mock.On("CheckPlugin", strategyDir)}
+}
+
+func (_c *Runtime_CheckPlugin_Call) Run(run func(strategyDir string)) *Runtime_CheckPlugin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Runtime_CheckPlugin_Call) Return(_a0 error) *Runtime_CheckPlugin_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Runtime_CheckPlugin_Call) RunAndReturn(run func(string) error) *Runtime_CheckPlugin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Run provides a mock function with given fields: strategyDir
 func (_m *Runtime) Run(strategyDir string) error {
 	ret := _m.Called(strategyDir)
@@ -63,6 +109,52 @@ func (_c *Runtime_Run_Call) RunAndReturn(run func(string) error) *Runtime_Run_Ca
 	return _c
 }
 
+// RunStandby provides a mock function with given fields: strategyDir
+func (_m *Runtime) RunStandby(strategyDir string) error {
+	ret := _m.Called(strategyDir)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunStandby")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(strategyDir)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Runtime_RunStandby_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunStandby'
+type Runtime_RunStandby_Call struct {
+	*mock.Call
+}
+
+// RunStandby is a helper method to define mock.On call
+//   - strategyDir string
+func (_e *Runtime_Expecter) RunStandby(strategyDir interface{}) *Runtime_RunStandby_Call {
+	return &Runtime_RunStandby_Call{Call: _e.mock.On("RunStandby", strategyDir)}
+}
+
+func (_c *Runtime_RunStandby_Call) Run(run func(strategyDir string)) *Runtime_RunStandby_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Runtime_RunStandby_Call) Return(_a0 error) *Runtime_RunStandby_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Runtime_RunStandby_Call) RunAndReturn(run func(string) error) *Runtime_RunStandby_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewRuntime creates a new instance of Runtime. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRuntime(t interface {
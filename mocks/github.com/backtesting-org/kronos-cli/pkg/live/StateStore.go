@@ -20,6 +20,144 @@ func (_m *StateStore) EXPECT() *StateStore_Expecter {
 	return &StateStore_Expecter{mock: &_m.Mock}
 }
 
+// AppendEvent provides a mock function with given fields: event
+func (_m *StateStore) AppendEvent(event *live.Event) error {
+	ret := _m.Called(event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AppendEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*live.Event) error); ok {
+		r0 = rf(event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StateStore_AppendEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppendEvent'
+type StateStore_AppendEvent_Call struct {
+	*mock.Call
+}
+
+// AppendEvent is a helper method to define mock.On call
+//   - event *live.Event
+func (_e *StateStore_Expecter) AppendEvent(event interface{}) *StateStore_AppendEvent_Call {
+	return &StateStore_AppendEvent_Call{Call: _e.mock.On("AppendEvent", event)}
+}
+
+func (_c *StateStore_AppendEvent_Call) Run(run func(event *live.Event)) *StateStore_AppendEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*live.Event))
+	})
+	return _c
+}
+
+func (_c *StateStore_AppendEvent_Call) Return(_a0 error) *StateStore_AppendEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StateStore_AppendEvent_Call) RunAndReturn(run func(*live.Event) error) *StateStore_AppendEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AppendRestart provides a mock function with given fields: record
+func (_m *StateStore) AppendRestart(record *live.RestartRecord) error {
+	ret := _m.Called(record)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AppendRestart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*live.RestartRecord) error); ok {
+		r0 = rf(record)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StateStore_AppendRestart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppendRestart'
+type StateStore_AppendRestart_Call struct {
+	*mock.Call
+}
+
+// AppendRestart is a helper method to define mock.On call
+//   - record *live.RestartRecord
+func (_e *StateStore_Expecter) AppendRestart(record interface{}) *StateStore_AppendRestart_Call {
+	return &StateStore_AppendRestart_Call{Call: _e.mock.On("AppendRestart", record)}
+}
+
+func (_c *StateStore_AppendRestart_Call) Run(run func(record *live.RestartRecord)) *StateStore_AppendRestart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*live.RestartRecord))
+	})
+	return _c
+}
+
+func (_c *StateStore_AppendRestart_Call) Return(_a0 error) *StateStore_AppendRestart_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StateStore_AppendRestart_Call) RunAndReturn(run func(*live.RestartRecord) error) *StateStore_AppendRestart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AppendSession provides a mock function with given fields: session
+func (_m *StateStore) AppendSession(session *live.Session) error {
+	ret := _m.Called(session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AppendSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*live.Session) error); ok {
+		r0 = rf(session)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StateStore_AppendSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppendSession'
+type StateStore_AppendSession_Call struct {
+	*mock.Call
+}
+
+// AppendSession is a helper method to define mock.On call
+//   - session *live.Session
+func (_e *StateStore_Expecter) AppendSession(session interface{}) *StateStore_AppendSession_Call {
+	return &StateStore_AppendSession_Call{Call: _e.mock.On("AppendSession", session)}
+}
+
+func (_c *StateStore_AppendSession_Call) Run(run func(session *live.Session)) *StateStore_AppendSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*live.Session))
+	})
+	return _c
+}
+
+func (_c *StateStore_AppendSession_Call) Return(_a0 error) *StateStore_AppendSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StateStore_AppendSession_Call) RunAndReturn(run func(*live.Session) error) *StateStore_AppendSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPath provides a mock function with no fields
 func (_m *StateStore) GetPath() string {
 	ret := _m.Called()
@@ -65,6 +203,180 @@ func (_c *StateStore_GetPath_Call) RunAndReturn(run func() string) *StateStore_G
 	return _c
 }
 
+// ListEvents provides a mock function with given fields: filter
+func (_m *StateStore) ListEvents(filter live.HistoryFilter) ([]*live.Event, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEvents")
+	}
+
+	var r0 []*live.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(live.HistoryFilter) ([]*live.Event, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(live.HistoryFilter) []*live.Event); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*live.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(live.HistoryFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StateStore_ListEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEvents'
+type StateStore_ListEvents_Call struct {
+	*mock.Call
+}
+
+// ListEvents is a helper method to define mock.On call
+//   - filter live.HistoryFilter
+func (_e *StateStore_Expecter) ListEvents(filter interface{}) *StateStore_ListEvents_Call {
+	return &StateStore_ListEvents_Call{Call: _e.mock.On("ListEvents", filter)}
+}
+
+func (_c *StateStore_ListEvents_Call) Run(run func(filter live.HistoryFilter)) *StateStore_ListEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(live.HistoryFilter))
+	})
+	return _c
+}
+
+func (_c *StateStore_ListEvents_Call) Return(_a0 []*live.Event, _a1 error) *StateStore_ListEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StateStore_ListEvents_Call) RunAndReturn(run func(live.HistoryFilter) ([]*live.Event, error)) *StateStore_ListEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRestarts provides a mock function with given fields: filter
+func (_m *StateStore) ListRestarts(filter live.HistoryFilter) ([]*live.RestartRecord, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRestarts")
+	}
+
+	var r0 []*live.RestartRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(live.HistoryFilter) ([]*live.RestartRecord, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(live.HistoryFilter) []*live.RestartRecord); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*live.RestartRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(live.HistoryFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StateStore_ListRestarts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRestarts'
+type StateStore_ListRestarts_Call struct {
+	*mock.Call
+}
+
+// ListRestarts is a helper method to define mock.On call
+//   - filter live.HistoryFilter
+func (_e *StateStore_Expecter) ListRestarts(filter interface{}) *StateStore_ListRestarts_Call {
+	return &StateStore_ListRestarts_Call{Call: _e.mock.On("ListRestarts", filter)}
+}
+
+func (_c *StateStore_ListRestarts_Call) Run(run func(filter live.HistoryFilter)) *StateStore_ListRestarts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(live.HistoryFilter))
+	})
+	return _c
+}
+
+func (_c *StateStore_ListRestarts_Call) Return(_a0 []*live.RestartRecord, _a1 error) *StateStore_ListRestarts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StateStore_ListRestarts_Call) RunAndReturn(run func(live.HistoryFilter) ([]*live.RestartRecord, error)) *StateStore_ListRestarts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSessions provides a mock function with given fields: filter
+func (_m *StateStore) ListSessions(filter live.HistoryFilter) ([]*live.Session, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 []*live.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(live.HistoryFilter) ([]*live.Session, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(live.HistoryFilter) []*live.Session); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*live.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(live.HistoryFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StateStore_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type StateStore_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - filter live.HistoryFilter
+func (_e *StateStore_Expecter) ListSessions(filter interface{}) *StateStore_ListSessions_Call {
+	return &StateStore_ListSessions_Call{Call: _e.mock.On("ListSessions", filter)}
+}
+
+func (_c *StateStore_ListSessions_Call) Run(run func(filter live.HistoryFilter)) *StateStore_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(live.HistoryFilter))
+	})
+	return _c
+}
+
+func (_c *StateStore_ListSessions_Call) Return(_a0 []*live.Session, _a1 error) *StateStore_ListSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StateStore_ListSessions_Call) RunAndReturn(run func(live.HistoryFilter) ([]*live.Session, error)) *StateStore_ListSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Load provides a mock function with no fields
 func (_m *StateStore) Load() ([]*live.Instance, error) {
 	ret := _m.Called()
@@ -122,6 +434,64 @@ func (_c *StateStore_Load_Call) RunAndReturn(run func() ([]*live.Instance, error
 	return _c
 }
 
+// Query provides a mock function with given fields: filter
+func (_m *StateStore) Query(filter live.InstanceFilter) ([]*live.Instance, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Query")
+	}
+
+	var r0 []*live.Instance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(live.InstanceFilter) ([]*live.Instance, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(live.InstanceFilter) []*live.Instance); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*live.Instance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(live.InstanceFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StateStore_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
+type StateStore_Query_Call struct {
+	*mock.Call
+}
+
+// Query is a helper method to define mock.On call
+//   - filter live.InstanceFilter
+func (_e *StateStore_Expecter) Query(filter interface{}) *StateStore_Query_Call {
+	return &StateStore_Query_Call{Call: _e.mock.On("Query", filter)}
+}
+
+func (_c *StateStore_Query_Call) Run(run func(filter live.InstanceFilter)) *StateStore_Query_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(live.InstanceFilter))
+	})
+	return _c
+}
+
+func (_c *StateStore_Query_Call) Return(_a0 []*live.Instance, _a1 error) *StateStore_Query_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StateStore_Query_Call) RunAndReturn(run func(live.InstanceFilter) ([]*live.Instance, error)) *StateStore_Query_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Save provides a mock function with given fields: instances
 func (_m *StateStore) Save(instances []*live.Instance) error {
 	ret := _m.Called(instances)
@@ -168,6 +538,52 @@ func (_c *StateStore_Save_Call) RunAndReturn(run func([]*live.Instance) error) *
 	return _c
 }
 
+// Update provides a mock function with given fields: fn
+func (_m *StateStore) Update(fn func(instances []*live.Instance) ([]*live.Instance, error)) error {
+	ret := _m.Called(fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(func(instances []*live.Instance) ([]*live.Instance, error)) error); ok {
+		r0 = rf(fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StateStore_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type StateStore_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - fn func(instances []*live.Instance) ([]*live.Instance, error)
+func (_e *StateStore_Expecter) Update(fn interface{}) *StateStore_Update_Call {
+	return &StateStore_Update_Call{Call: _e.mock.On("Update", fn)}
+}
+
+func (_c *StateStore_Update_Call) Run(run func(fn func(instances []*live.Instance) ([]*live.Instance, error))) *StateStore_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func(instances []*live.Instance) ([]*live.Instance, error)))
+	})
+	return _c
+}
+
+func (_c *StateStore_Update_Call) Return(_a0 error) *StateStore_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StateStore_Update_Call) RunAndReturn(run func(func(instances []*live.Instance) ([]*live.Instance, error)) error) *StateStore_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewStateStore creates a new instance of StateStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewStateStore(t interface {
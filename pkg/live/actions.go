@@ -0,0 +1,116 @@
+package live
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ActionType names a manual trading action taken on a running instance
+type ActionType string
+
+const (
+	ActionCancelOrders  ActionType = "cancel_orders"  // cancel the open orders of an asset
+	ActionClosePosition ActionType = "close_position" // close or reduce the position in an asset
+	ActionPause         ActionType = "pause"          // stop acting on the strategy's signals
+	ActionResume        ActionType = "resume"         // act on the strategy's signals again
+)
+
+// TradingAction is an operator's order to a running instance, carried out by the strategy process itself
+type TradingAction struct {
+	Type     ActionType `json:"type"`
+	Exchange string     `json:"exchange,omitempty"`
+	Asset    string     `json:"asset,omitempty"`
+
+	// OrderIDs limits cancel_orders to these orders; every open order of the asset is cancelled when empty
+	OrderIDs []string `json:"order_ids,omitempty"`
+
+	// Fraction is the share of the position close_position closes, in (0, 1]; zero closes all of it
+	Fraction float64 `json:"fraction,omitempty"`
+
+	// Price makes close_position a limit order at this price; zero closes at market
+	Price float64 `json:"price,omitempty"`
+
+	// Actor is who took the action, for the event log; it stays in the supervisor
+	Actor Actor `json:"-"`
+}
+
+// Validate checks the action names what it acts on
+func (a TradingAction) Validate() error {
+	switch a.Type {
+	case ActionCancelOrders, ActionClosePosition:
+		if a.Exchange == "" || a.Asset == "" {
+			return fmt.Errorf("%s needs an exchange and an asset", a.Type)
+		}
+		if a.Fraction < 0 || a.Fraction > 1 {
+			return fmt.Errorf("fraction must be between 0 and 1, got %g", a.Fraction)
+		}
+		if a.Price < 0 {
+			return fmt.Errorf("price must not be negative, got %g", a.Price)
+		}
+		return nil
+	case ActionPause, ActionResume:
+		return nil
+	default:
+		return fmt.Errorf("unknown action %q", a.Type)
+	}
+}
+
+// Description returns a human readable label for the action
+func (a TradingAction) Description() string {
+	switch a.Type {
+	case ActionCancelOrders:
+		if len(a.OrderIDs) == 1 {
+			return fmt.Sprintf("cancel %s order %s on %s", a.Asset, a.OrderIDs[0], a.Exchange)
+		}
+		if len(a.OrderIDs) > 0 {
+			return fmt.Sprintf("cancel %d %s orders on %s", len(a.OrderIDs), a.Asset, a.Exchange)
+		}
+		return fmt.Sprintf("cancel all %s orders on %s", a.Asset, a.Exchange)
+	case ActionClosePosition:
+		size := "close"
+		if a.Fraction > 0 && a.Fraction < 1 {
+			size = fmt.Sprintf("reduce by %.0f%%", a.Fraction*100)
+		}
+		at := "at market"
+		if a.Price > 0 {
+			at = fmt.Sprintf("with a limit at %g", a.Price)
+		}
+		return fmt.Sprintf("%s %s position on %s %s", size, a.Asset, a.Exchange, at)
+	case ActionPause:
+		return "pause signal execution"
+	case ActionResume:
+		return "resume signal execution"
+	}
+	return string(a.Type)
+}
+
+// ActionResult is what a strategy did about a trading action
+type ActionResult struct {
+	StrategyName    string        `json:"strategy_name"`
+	Action          TradingAction `json:"action"`
+	CancelledOrders int           `json:"cancelled_orders"`
+	OrderIDs        []string      `json:"order_ids,omitempty"` // orders placed to close the position
+	Paused          bool          `json:"paused"`              // whether signals are paused once the action is done
+	Errors          []string      `json:"errors,omitempty"`
+	Time            time.Time     `json:"time"`
+}
+
+// ActionExecutor carries out trading actions inside the strategy process. While paused, the strategy keeps
+// running and generating signals but none of them is executed.
+type ActionExecutor interface {
+	// Execute acts on the strategy's ready connectors; failures are reported in the result
+	Execute(ctx context.Context, action TradingAction) *ActionResult
+
+	// Paused reports whether signal execution is paused
+	Paused() bool
+}
+
+// Trader sends trading actions to running instances and records each one in the event log
+type Trader interface {
+	// Act has the strategy carry out the action. Every attempt is recorded, including those that fail.
+	Act(ctx context.Context, strategyName string, action TradingAction) (*ActionResult, error)
+
+	// Paused reports whether the strategy's signal execution is paused
+	Paused(strategyName string) (bool, error)
+}
@@ -0,0 +1,144 @@
+package live
+
+import (
+	"context"
+	"time"
+)
+
+// AlertKind names the condition an alert rule watches for
+type AlertKind string
+
+const (
+	AlertDrawdown AlertKind = "drawdown"  // total PnL fell more than max_drawdown below its peak
+	AlertNoTrades AlertKind = "no_trades" // no trade for quiet_for
+	AlertHealth   AlertKind = "health"    // health check failing or reporting connector or data flow errors
+	AlertLatency  AlertKind = "latency"   // p99 execution time above max_p99
+	AlertCrashed  AlertKind = "crashed"   // an instance crashed
+	AlertPosition AlertKind = "position"  // net position in an asset beyond max_position
+)
+
+// AlertSeverity tells sinks how urgent an alert is
+type AlertSeverity string
+
+const (
+	SeverityWarning  AlertSeverity = "warning"
+	SeverityCritical AlertSeverity = "critical"
+)
+
+// AlertConfig is the alerts file: the rules, where notifications go, and when to hold them back
+type AlertConfig struct {
+	// Interval is how often the rules are evaluated (default 30s)
+	Interval time.Duration `yaml:"interval"`
+
+	// RepeatAfter re-sends an alert that is still firing after this long (default 1h, negative never repeats)
+	RepeatAfter time.Duration `yaml:"repeat_after"`
+
+	// SendResolved also notifies when a firing alert stops firing
+	SendResolved bool `yaml:"send_resolved"`
+
+	Rules    []AlertRule       `yaml:"rules"`
+	Sinks    []AlertSinkConfig `yaml:"sinks"`
+	Silences []AlertSilence    `yaml:"silences"`
+}
+
+// AlertRule is one condition to watch. Only the threshold of its kind applies.
+type AlertRule struct {
+	Name       string        `yaml:"name"`
+	Kind       AlertKind     `yaml:"kind"`
+	Severity   AlertSeverity `yaml:"severity"`   // default warning
+	Strategies []string      `yaml:"strategies"` // default every strategy
+	Sinks      []string      `yaml:"sinks"`      // default every sink
+
+	MaxDrawdown float64       `yaml:"max_drawdown"` // drawdown, in quote currency
+	QuietFor    time.Duration `yaml:"quiet_for"`    // no_trades
+	MaxP99      time.Duration `yaml:"max_p99"`      // latency
+	MaxPosition float64       `yaml:"max_position"` // position, in base asset quantity
+	Asset       string        `yaml:"asset"`        // position, default every asset
+}
+
+// AlertSinkType selects how a sink delivers notifications
+type AlertSinkType string
+
+const (
+	SinkWebhook AlertSinkType = "webhook" // POST the alert as JSON
+	SinkSMTP    AlertSinkType = "smtp"    // send an email
+	SinkDesktop AlertSinkType = "desktop" // show a desktop notification
+	SinkCommand AlertSinkType = "command" // run a shell command
+)
+
+// AlertSinkConfig configures a named notification sink. Only the fields of its type apply.
+type AlertSinkConfig struct {
+	Name string        `yaml:"name"`
+	Type AlertSinkType `yaml:"type"`
+
+	// Webhook
+	URL     string            `yaml:"url"`
+	Headers map[string]string `yaml:"headers"`
+
+	// SMTP; the password is read from the environment variable named by PasswordEnv
+	Host        string   `yaml:"host"` // host:port
+	Username    string   `yaml:"username"`
+	PasswordEnv string   `yaml:"password_env"`
+	From        string   `yaml:"from"`
+	To          []string `yaml:"to"`
+
+	// Command is run with sh -c, the alert in KRONOS_ALERT_* variables and as JSON on stdin
+	Command string `yaml:"command"`
+}
+
+// AlertSilence holds back notifications of matching alerts during a window. A silence without
+// rules or strategies matches every alert; one without until or schedule applies until removed.
+type AlertSilence struct {
+	Rules      []string  `yaml:"rules"`
+	Strategies []string  `yaml:"strategies"`
+	From       time.Time `yaml:"from"`
+	Until      time.Time `yaml:"until"`
+
+	// Schedule silences inside its windows, e.g. outside market hours
+	Schedule *Schedule `yaml:"schedule"`
+
+	Comment string `yaml:"comment"`
+}
+
+// AlertStatus tells whether a notification is about an alert starting or stopping
+type AlertStatus string
+
+const (
+	AlertFiring   AlertStatus = "firing"
+	AlertResolved AlertStatus = "resolved"
+)
+
+// Alert is a notification about a rule firing for an instance
+type Alert struct {
+	Rule         string        `json:"rule"`
+	Kind         AlertKind     `json:"kind"`
+	Severity     AlertSeverity `json:"severity"`
+	Status       AlertStatus   `json:"status"`
+	InstanceID   string        `json:"instance_id"`
+	StrategyName string        `json:"strategy_name"`
+	Asset        string        `json:"asset,omitempty"`
+	Message      string        `json:"message"`
+	Since        time.Time     `json:"since"` // when the alert started firing
+	Time         time.Time     `json:"time"`
+
+	// Sinks is where the alert goes, every sink if empty
+	Sinks []string `json:"-"`
+}
+
+// AlertSink delivers alert notifications
+type AlertSink interface {
+	Send(ctx context.Context, alert *Alert) error
+}
+
+// Alerter evaluates alert rules against running instances and notifies the sinks
+type Alerter interface {
+	// Watch evaluates the rules every interval until ctx is done and sends what is due, deduplicated
+	// and silenced as configured. Every notification is passed to onNotify with the error of each sink that failed.
+	Watch(ctx context.Context, cfg *AlertConfig, onNotify func(alert *Alert, errs map[string]error)) error
+
+	// Check evaluates the rules once and returns the alerts firing now, without sending anything
+	Check(ctx context.Context, cfg *AlertConfig) ([]*Alert, error)
+
+	// Test sends a test alert through the named sinks, or every sink, and returns the error of each
+	Test(ctx context.Context, cfg *AlertConfig, sinks []string) (map[string]error, error)
+}
@@ -0,0 +1,75 @@
+package live
+
+import "context"
+
+// DesiredState is the set of strategy instances that should be running, as declared in an apply file
+type DesiredState struct {
+	Instances []DesiredInstance `yaml:"instances"`
+}
+
+// DesiredInstance is one strategy instance that should be running
+type DesiredInstance struct {
+	// Name is the instance name, the directory under strategies/ it runs from. It defaults to Strategy;
+	// a different name makes a variant of Strategy with its own config.yml.
+	Name string `yaml:"name"`
+
+	// Strategy is the strategy under strategies/ whose code the instance runs
+	Strategy string `yaml:"strategy"`
+
+	// Parameters are merged over the parameters in the strategy's config.yml
+	Parameters map[string]interface{} `yaml:"parameters"`
+}
+
+// IsVariant reports whether the instance runs another strategy's code under its own name
+func (d DesiredInstance) IsVariant() bool {
+	return d.Name != "" && d.Name != d.Strategy
+}
+
+// PlanAction is what applying does to one instance
+type PlanAction string
+
+const (
+	PlanStart   PlanAction = "start"
+	PlanStop    PlanAction = "stop"
+	PlanRestart PlanAction = "restart"
+	PlanKeep    PlanAction = "keep" // already as declared
+)
+
+// PlanStep is one action of a plan and, once applied, its outcome
+type PlanStep struct {
+	Action   PlanAction `json:"action"`
+	Name     string     `json:"name"`
+	Strategy string     `json:"strategy,omitempty"`
+	Reason   string     `json:"reason"`
+	Done     bool       `json:"done"`
+	Error    string     `json:"error,omitempty"`
+
+	// Desired is what the instance is configured as before it starts; nil for stops
+	Desired *DesiredInstance `json:"-"`
+}
+
+// Plan is the set of actions that converge the running instances on a desired state, stops first
+type Plan struct {
+	Steps []*PlanStep `json:"steps"`
+}
+
+// Changes counts the steps that aren't keeps
+func (p *Plan) Changes() int {
+	changes := 0
+	for _, step := range p.Steps {
+		if step.Action != PlanKeep {
+			changes++
+		}
+	}
+	return changes
+}
+
+// Applier converges the running instances on a desired state through the InstanceManager
+type Applier interface {
+	// Plan diffs the desired state against the running instances without changing anything
+	Plan(desired *DesiredState) (*Plan, error)
+
+	// Apply carries out a plan, writing variant directories and parameters before the instances
+	// they belong to start. It carries on past failed steps and reports them on the steps.
+	Apply(ctx context.Context, plan *Plan, opts StopOptions) error
+}
@@ -0,0 +1,191 @@
+package live
+
+import "time"
+
+// SupervisorConfig holds CLI-side settings for supervising strategy instances.
+// It is read from ~/.kronos/config.yml and is independent of the SDK's kronos.yml.
+type SupervisorConfig struct {
+	// Executable overrides the kronos binary used to run strategies (defaults to the running executable)
+	Executable string `yaml:"executable"`
+
+	Logs      LogConfig       `yaml:"logs"`
+	Isolation IsolationConfig `yaml:"isolation"`
+
+	// Stop holds the defaults for stopping a strategy (CLI flags and the TUI can override them)
+	Stop StopOptions `yaml:"stop"`
+
+	// Reload holds the defaults for hot-swapping a running strategy
+	Reload ReloadOptions `yaml:"reload"`
+
+	// State selects where instance state, session history, events and restarts are kept
+	State StateConfig `yaml:"state"`
+
+	// Preflight tunes the checks run before a strategy goes live
+	Preflight PreflightConfig `yaml:"preflight"`
+
+	// Remote configures the listener other machines manage this node's instances through
+	Remote RemoteConfig `yaml:"remote"`
+
+	// Series controls the metrics history running strategies record
+	Series SeriesConfig `yaml:"series"`
+}
+
+// PreflightConfig tunes the pre-flight checks
+type PreflightConfig struct {
+	// TimeURL is fetched to compare the local clock against the Date header of its response
+	TimeURL string `yaml:"time_url"`
+
+	// MaxClockSkew fails the clock check beyond this difference
+	MaxClockSkew time.Duration `yaml:"max_clock_skew"`
+
+	// PluginTimeout bounds loading the strategy plugin in a separate process
+	PluginTimeout time.Duration `yaml:"plugin_timeout"`
+}
+
+// StateBackend selects a StateStore implementation
+type StateBackend string
+
+const (
+	// StateBackendFile keeps instances in a JSON file and history, events and restarts in JSONL files
+	StateBackendFile StateBackend = "file"
+
+	// StateBackendBolt keeps everything in an embedded transactional database with indexes
+	StateBackendBolt StateBackend = "bolt"
+)
+
+// StateConfig configures the state store
+type StateConfig struct {
+	Backend StateBackend `yaml:"backend"`
+
+	// Path overrides the state file (file backend) or database (bolt backend) location
+	Path string `yaml:"path"`
+}
+
+// LogConfig controls rotation and retention of instance stdout/stderr logs
+type LogConfig struct {
+	// MaxSizeMB rotates a log once it grows beyond this size (0 disables size-based rotation)
+	MaxSizeMB int `yaml:"max_size_mb"`
+
+	// RotateEvery rotates a non-empty log after this interval (0 disables time-based rotation)
+	RotateEvery time.Duration `yaml:"rotate_every"`
+
+	// MaxBackups is the number of rotated files kept per log (0 keeps all)
+	MaxBackups int `yaml:"max_backups"`
+
+	// MaxAge removes rotated files older than this (0 keeps them regardless of age)
+	MaxAge time.Duration `yaml:"max_age"`
+
+	// Compress gzips rotated files
+	Compress bool `yaml:"compress"`
+}
+
+// IsolationConfig controls how spawned strategy processes are sandboxed
+type IsolationConfig struct {
+	// CgroupRoot is the cgroup v2 directory under which per-strategy cgroups are created.
+	// It must be writable by the current user (e.g. a delegated systemd slice).
+	// Empty means a kronos subtree of the cgroup the supervisor itself runs in.
+	CgroupRoot string `yaml:"cgroup_root"`
+
+	// DefaultLimits apply to every strategy; a strategy's own limits override non-zero fields
+	DefaultLimits ResourceLimits `yaml:"default_limits"`
+}
+
+// ResourceLimits constrains a single strategy process so a runaway strategy can't starve the others
+type ResourceLimits struct {
+	// MaxMemoryMB caps memory via cgroup memory.max, or RLIMIT_AS when cgroups are unavailable
+	MaxMemoryMB int `yaml:"max_memory_mb"`
+
+	// CPUQuota is the number of CPUs the process may use (e.g. 0.5); requires cgroup v2
+	CPUQuota float64 `yaml:"cpu_quota"`
+
+	// Nice is the scheduling niceness (-20..19); 0 leaves it unchanged
+	Nice int `yaml:"nice"`
+
+	// MaxOpenFiles sets RLIMIT_NOFILE
+	MaxOpenFiles uint64 `yaml:"max_open_files"`
+
+	// WorkDir runs the process in a dedicated working directory instead of the project root
+	WorkDir string `yaml:"work_dir"`
+
+	// EnvAllowlist restricts the inherited environment to these variables ("PREFIX_*" wildcards allowed).
+	// Empty inherits everything.
+	EnvAllowlist []string `yaml:"env_allowlist"`
+}
+
+// IsZero reports whether no limit is configured
+func (l ResourceLimits) IsZero() bool {
+	return l.MaxMemoryMB == 0 && l.CPUQuota == 0 && l.Nice == 0 && l.MaxOpenFiles == 0 &&
+		l.WorkDir == "" && len(l.EnvAllowlist) == 0
+}
+
+// Merge returns l with zero fields filled in from defaults
+func (l ResourceLimits) Merge(defaults ResourceLimits) ResourceLimits {
+	if l.MaxMemoryMB == 0 {
+		l.MaxMemoryMB = defaults.MaxMemoryMB
+	}
+	if l.CPUQuota == 0 {
+		l.CPUQuota = defaults.CPUQuota
+	}
+	if l.Nice == 0 {
+		l.Nice = defaults.Nice
+	}
+	if l.MaxOpenFiles == 0 {
+		l.MaxOpenFiles = defaults.MaxOpenFiles
+	}
+	if l.WorkDir == "" {
+		l.WorkDir = defaults.WorkDir
+	}
+	if len(l.EnvAllowlist) == 0 {
+		l.EnvAllowlist = defaults.EnvAllowlist
+	}
+	return l
+}
+
+// StrategyOptions holds CLI-specific blocks of a strategy's config.yml that the SDK ignores
+type StrategyOptions struct {
+	Limits   ResourceLimits `yaml:"limits"`
+	Schedule *Schedule      `yaml:"schedule"`
+	Risk     RiskLimits     `yaml:"risk"`
+}
+
+// RiskLimits are the strategy's risk settings; pre-flight requires them before going live
+type RiskLimits struct {
+	MaxPositionSize float64 `yaml:"max_position_size"`
+	MaxDailyLoss    float64 `yaml:"max_daily_loss"`
+}
+
+// DefaultSupervisorConfig returns the settings used when no config file exists
+func DefaultSupervisorConfig() *SupervisorConfig {
+	return &SupervisorConfig{
+		Logs: LogConfig{
+			MaxSizeMB:   100,
+			RotateEvery: 24 * time.Hour,
+			MaxBackups:  7,
+			MaxAge:      30 * 24 * time.Hour,
+			Compress:    true,
+		},
+		Stop: StopOptions{
+			Mode:         StopModeLeave,
+			DrainTimeout: 30 * time.Second,
+		},
+		Reload: ReloadOptions{
+			ReadyTimeout:  time.Minute,
+			HealthTimeout: 30 * time.Second,
+		},
+		State: StateConfig{
+			Backend: StateBackendFile,
+		},
+		Preflight: PreflightConfig{
+			TimeURL:       "https://www.google.com",
+			MaxClockSkew:  2 * time.Second,
+			PluginTimeout: 30 * time.Second,
+		},
+		Remote: RemoteConfig{
+			Listen: "127.0.0.1:7443",
+		},
+		Series: SeriesConfig{
+			Interval:  time.Minute,
+			Retention: 90 * 24 * time.Hour,
+		},
+	}
+}
@@ -0,0 +1,71 @@
+package live
+
+import (
+	"context"
+	"time"
+)
+
+// Deployment is a group of strategies started and stopped together, e.g. a hedger and the market maker it covers
+type Deployment struct {
+	Name    string             `yaml:"-"`
+	Members []DeploymentMember `yaml:"strategies"`
+
+	// ReadyTimeout bounds how long a member may take to become healthy before the members started after it
+	ReadyTimeout time.Duration `yaml:"ready_timeout"`
+}
+
+// DeploymentMember is one strategy of a deployment
+type DeploymentMember struct {
+	Strategy string `yaml:"strategy"`
+
+	// After names members that must be up before this one starts; they are stopped after it
+	After []string `yaml:"after"`
+}
+
+// MemberState is where one member of a deployment stands
+type MemberState string
+
+const (
+	MemberRunning   MemberState = "running"
+	MemberUnhealthy MemberState = "unhealthy" // the process runs but doesn't answer health checks
+	MemberStopped   MemberState = "stopped"
+	MemberCrashed   MemberState = "crashed"
+)
+
+// DeploymentState is the aggregate status of a deployment
+type DeploymentState string
+
+const (
+	DeploymentUp       DeploymentState = "up"       // every member is running and healthy
+	DeploymentDown     DeploymentState = "down"     // no member is running
+	DeploymentDegraded DeploymentState = "degraded" // anything in between
+)
+
+// MemberStatus is the status of one member of a deployment
+type MemberStatus struct {
+	Strategy string        `json:"strategy"`
+	State    MemberState   `json:"state"`
+	PID      int           `json:"pid,omitempty"`
+	Uptime   time.Duration `json:"uptime,omitempty"`
+	Detail   string        `json:"detail,omitempty"` // what the last action did, or why the member crashed
+}
+
+// DeploymentStatus is the status of every member of a deployment, in start order
+type DeploymentStatus struct {
+	Name    string          `json:"name"`
+	State   DeploymentState `json:"state"`
+	Members []*MemberStatus `json:"members"`
+}
+
+// Deployer applies deployments through the InstanceManager
+type Deployer interface {
+	// Up starts the members that aren't running in dependency order, waiting for each to become healthy
+	// before starting those after it. It stops at the first member that fails to come up.
+	Up(ctx context.Context, deployment *Deployment) (*DeploymentStatus, error)
+
+	// Down stops the running members in reverse dependency order
+	Down(ctx context.Context, deployment *Deployment, opts StopOptions) (*DeploymentStatus, error)
+
+	// Status reports every member and the aggregate state
+	Status(deployment *Deployment) (*DeploymentStatus, error)
+}
@@ -0,0 +1,80 @@
+package live
+
+import (
+	"context"
+	"time"
+)
+
+// EventType names a lifecycle event of a strategy instance
+type EventType string
+
+const (
+	EventStarted         EventType = "started"          // instance spawned and trading
+	EventStandbyStarted  EventType = "standby_started"  // new build loaded next to the running instance
+	EventPromoted        EventType = "promoted"         // standby took over trading
+	EventStopped         EventType = "stopped"          // stopped on request
+	EventKilled          EventType = "killed"           // force killed
+	EventExited          EventType = "exited"           // exited cleanly on its own
+	EventCrashed         EventType = "crashed"          // exited unexpectedly or vanished
+	EventRestarted       EventType = "restarted"        // started again after its previous instance crashed
+	EventConfigChanged   EventType = "config_changed"   // started with a different config.yml than the previous instance
+	EventStartFailed     EventType = "start_failed"     // could not be validated, compiled or spawned
+	EventDrained         EventType = "drained"          // the strategy process drained its orders and positions before exiting
+	EventOrdersCancelled EventType = "orders_cancelled" // an operator cancelled orders of a running instance
+	EventPositionClosed  EventType = "position_closed"  // an operator closed or reduced a position of a running instance
+	EventPaused          EventType = "paused"           // an operator paused signal execution
+	EventResumed         EventType = "resumed"          // an operator resumed signal execution
+)
+
+// Actor identifies who caused an event
+type Actor string
+
+const (
+	ActorTUI         Actor = "tui"          // the interactive TUI
+	ActorCLI         Actor = "cli"          // a kronos command
+	ActorDaemon      Actor = "daemon"       // a running strategy process acting on its own
+	ActorAutoRestart Actor = "auto-restart" // kronos restarting a strategy by itself, e.g. a reload rollback
+	ActorScheduler   Actor = "scheduler"    // a strategy's schedule opening or closing a trading window
+	ActorRemote      Actor = "remote"       // a client of the node API, recorded as remote:<client address>
+)
+
+// Event is a single entry in the lifecycle record of strategy instances
+type Event struct {
+	Time         time.Time `json:"time"`
+	Type         EventType `json:"type"`
+	Actor        Actor     `json:"actor"`
+	StrategyName string    `json:"strategy_name"`
+	InstanceID   string    `json:"instance_id,omitempty"`
+	Reason       string    `json:"reason,omitempty"`
+}
+
+// EventBus publishes lifecycle events to the audit log and to subscribers in this process
+type EventBus interface {
+	// Publish stamps the event with the time and, unless set, the actor, records it and delivers it to subscribers
+	Publish(event Event)
+
+	// Subscribe returns a channel receiving events published from now on; cancel stops delivery and closes it
+	Subscribe() (events <-chan Event, cancel func())
+
+	// SetActor sets the actor of events that don't name one; it is decided once the command line is parsed
+	SetActor(actor Actor)
+}
+
+// InstanceIDEnv is set in the environment of spawned strategies so their own events name their instance
+const InstanceIDEnv = "KRONOS_INSTANCE_ID"
+
+type actorKey struct{}
+
+// WithActor returns a context attributing the operations started with it to actor
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor set with WithActor, or "" if there is none
+func ActorFromContext(ctx context.Context) Actor {
+	if ctx == nil {
+		return ""
+	}
+	actor, _ := ctx.Value(actorKey{}).(Actor)
+	return actor
+}
@@ -0,0 +1,9 @@
+package live
+
+import "github.com/backtesting-org/kronos-sdk/pkg/types/monitoring/health"
+
+// HealthQuerier fetches the health report of an instance, where ViewQuerier.HealthCheck only tells whether it answered
+type HealthQuerier interface {
+	// QueryHealth returns the connector and data flow state of a running instance
+	QueryHealth(instanceID string) (*health.SystemHealthReport, error)
+}
@@ -0,0 +1,52 @@
+package live
+
+import (
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+)
+
+// Session is the archived record of one run of a strategy instance, from start until it stopped or crashed
+type Session struct {
+	ID           string         `json:"id"`
+	StrategyName string         `json:"strategy_name"`
+	StartedAt    time.Time      `json:"started_at"`
+	EndedAt      time.Time      `json:"ended_at"`
+	Status       InstanceStatus `json:"status"` // stopped or crashed
+	CrashReason  CrashReason    `json:"crash_reason,omitempty"`
+	Error        string         `json:"error,omitempty"`
+	Restarts     int            `json:"restarts"`
+	StopMode     StopMode       `json:"stop_mode,omitempty"` // set when stopped through the stop protocol
+	Binary       *BinaryInfo    `json:"binary,omitempty"`
+
+	// Stats is nil when the instance couldn't be queried before it ended (e.g. it crashed)
+	Stats *SessionStats `json:"stats,omitempty"`
+}
+
+// Duration returns how long the session ran
+func (s *Session) Duration() time.Duration {
+	return s.EndedAt.Sub(s.StartedAt)
+}
+
+// SessionStats are the final figures of a session, captured just before the instance stopped
+type SessionStats struct {
+	PnL        *monitoring.PnLView `json:"pnl,omitempty"`
+	TradeCount int                 `json:"trade_count"`
+	CapturedAt time.Time           `json:"captured_at"`
+}
+
+// HistoryFilter narrows down archived sessions, events and restarts; zero values match everything
+type HistoryFilter struct {
+	StrategyName string
+	Since        time.Time
+	Limit        int // newest sessions first
+}
+
+// HistoryStore archives finished sessions. It is backed by the configured StateStore.
+type HistoryStore interface {
+	// Append archives a finished session
+	Append(session *Session) error
+
+	// List returns archived sessions matching filter, newest first
+	List(filter HistoryFilter) ([]*Session, error)
+}
@@ -17,8 +17,28 @@ const (
 	StatusStopped    InstanceStatus = "stopped"
 	StatusCrashed    InstanceStatus = "crashed"
 	StatusRestarting InstanceStatus = "restarting"
+	StatusStandby    InstanceStatus = "standby" // loaded but not trading, waiting to take over from a reload
 )
 
+// CrashReason classifies why an instance exited unexpectedly
+type CrashReason string
+
+const (
+	CrashReasonNone        CrashReason = ""
+	CrashReasonExit        CrashReason = "exit"         // non-zero exit code
+	CrashReasonSignal      CrashReason = "signal"       // killed by a signal
+	CrashReasonMemoryLimit CrashReason = "memory_limit" // cgroup OOM kill or RLIMIT_AS exhaustion
+	CrashReasonFileLimit   CrashReason = "file_limit"   // RLIMIT_NOFILE exhaustion
+	CrashReasonLost        CrashReason = "lost"         // process vanished while reattached
+)
+
+// BinaryInfo identifies the kronos executable an instance was started from
+type BinaryInfo struct {
+	Path    string `json:"path"`
+	Version string `json:"version,omitempty"`
+	Hash    string `json:"hash"` // sha256 of the executable
+}
+
 // Instance represents a running strategy instance
 type Instance struct {
 	ID              string             `json:"id"`
@@ -31,6 +51,11 @@ type Instance struct {
 	LastStatusCheck time.Time          `json:"last_status_check"`
 	Restarts        int                `json:"restarts"`
 	Error           string             `json:"error"`
+	CrashReason     CrashReason        `json:"crash_reason,omitempty"`
+	Limits          *ResourceLimits    `json:"limits,omitempty"`
+	CgroupPath      string             `json:"cgroup_path,omitempty"`
+	Binary          *BinaryInfo        `json:"binary,omitempty"`
+	ConfigHash      string             `json:"config_hash,omitempty"` // sha256 of the strategy's config.yml when it started
 	Context         context.Context    `json:"-"`
 	Cancel          context.CancelFunc `json:"-"`
 	Cmd             *exec.Cmd          `json:"-"`
@@ -42,13 +67,23 @@ type InstanceManager interface {
 	Start(ctx context.Context, strategy *config.Strategy, frameworkRoot string) (*Instance, error)
 
 	// Stop gracefully terminates an instance by ID
-	Stop(instanceID string) error
+	Stop(ctx context.Context, instanceID string) error
 
 	// StopByStrategyName gracefully terminates an instance by strategy name
-	StopByStrategyName(strategyName string) error
+	StopByStrategyName(ctx context.Context, strategyName string) error
+
+	// StopStrategy runs the graceful stop protocol and returns the strategy's final state.
+	// Ending ctx abandons the stop, leaving the process as it is for the caller to kill.
+	StopStrategy(ctx context.Context, strategyName string, opts StopOptions) (*StopResult, error)
+
+	// StartStandby spawns a second instance of a strategy that waits to take over from the running one
+	StartStandby(ctx context.Context, strategy *config.Strategy, frameworkRoot string) (*Instance, error)
+
+	// Promote marks a standby instance as the running one once it has taken over
+	Promote(instanceID string) error
 
 	// Kill forcefully terminates an instance
-	Kill(instanceID string) error
+	Kill(ctx context.Context, instanceID string) error
 
 	// Get retrieves a specific instance
 	Get(instanceID string) (*Instance, error)
@@ -71,18 +106,68 @@ type ProcessSpawner interface {
 	// Spawn creates a new kronos run-strategy process
 	Spawn(ctx context.Context, strategy *config.Strategy) (*exec.Cmd, error)
 
+	// ApplyLimits enforces the strategy's resource limits on a started instance
+	ApplyLimits(instance *Instance) error
+
 	// AttachMonitor starts monitoring process for crashes
 	AttachMonitor(instance *Instance) error
 }
 
+// InstanceFilter narrows down persisted instances; zero values match everything
+type InstanceFilter struct {
+	StrategyName string
+	Status       InstanceStatus
+}
+
+// Matches reports whether instance passes the filter
+func (f InstanceFilter) Matches(instance *Instance) bool {
+	return (f.StrategyName == "" || instance.StrategyName == f.StrategyName) &&
+		(f.Status == "" || instance.Status == f.Status)
+}
+
+// RestartRecord logs a strategy being started again after its previous instance crashed
+type RestartRecord struct {
+	Time         time.Time   `json:"time"`
+	StrategyName string      `json:"strategy_name"`
+	InstanceID   string      `json:"instance_id"`
+	PreviousID   string      `json:"previous_id"`
+	Attempt      int         `json:"attempt"`
+	Reason       CrashReason `json:"reason,omitempty"` // why the previous instance crashed
+}
+
 // StateStore persists and recovers instance state across CLI invocations
 type StateStore interface {
 	// Load reads persisted state from disk
 	Load() ([]*Instance, error)
 
-	// Save writes current state to disk
+	// Save writes current state to disk, replacing what is there
 	Save(instances []*Instance) error
 
+	// Update reads the current state, passes it to fn and writes back what fn returns.
+	// Other processes can't write in between, so fn can merge its changes into theirs.
+	Update(fn func(instances []*Instance) ([]*Instance, error)) error
+
+	// Query returns the persisted instances matching filter
+	Query(filter InstanceFilter) ([]*Instance, error)
+
+	// AppendSession archives a finished session
+	AppendSession(session *Session) error
+
+	// ListSessions returns archived sessions matching filter, newest first
+	ListSessions(filter HistoryFilter) ([]*Session, error)
+
+	// AppendEvent records a lifecycle event
+	AppendEvent(event *Event) error
+
+	// ListEvents returns recorded events matching filter, newest first
+	ListEvents(filter HistoryFilter) ([]*Event, error)
+
+	// AppendRestart records a restart of a strategy
+	AppendRestart(record *RestartRecord) error
+
+	// ListRestarts returns recorded restarts matching filter, newest first
+	ListRestarts(filter HistoryFilter) ([]*RestartRecord, error)
+
 	// GetPath returns the path to the state file
 	GetPath() string
 }
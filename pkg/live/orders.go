@@ -0,0 +1,81 @@
+package live
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+const (
+	// StaleOrderAge is how long an order can rest before the monitor flags it as stale
+	StaleOrderAge = 5 * time.Minute
+
+	// StaleOrderDistance is how far from mid, in percent, an order can rest before the monitor flags it as stale
+	StaleOrderDistance = 2.0
+)
+
+// WorkingOrder is an order resting on an exchange, with the mid price of its asset when the order was listed
+type WorkingOrder struct {
+	connector.Order
+	Exchange string            `json:"exchange"`
+	Asset    string            `json:"asset"` // the strategy's asset, where Symbol is the exchange's name for it
+	Mid      numerical.Decimal `json:"mid"`   // zero when the orderbook isn't available
+}
+
+// FilledPercent is how much of the order has filled, 0-100
+func (o WorkingOrder) FilledPercent() float64 {
+	quantity := o.Quantity.InexactFloat64()
+	if quantity <= 0 {
+		return 0
+	}
+	return o.FilledQty.InexactFloat64() / quantity * 100
+}
+
+// Age is how long the order has been resting at now; zero when the exchange didn't report when it was placed
+func (o WorkingOrder) Age(now time.Time) time.Duration {
+	if o.CreatedAt.IsZero() || now.Before(o.CreatedAt) {
+		return 0
+	}
+	return now.Sub(o.CreatedAt)
+}
+
+// DistanceFromMid is how far the order's price is from mid in percent; false when either is unknown
+func (o WorkingOrder) DistanceFromMid() (float64, bool) {
+	mid := o.Mid.InexactFloat64()
+	price := o.Price.InexactFloat64()
+	if mid <= 0 || price <= 0 {
+		return 0, false
+	}
+	return math.Abs(price-mid) / mid * 100, true
+}
+
+// Stale reports whether the order has rested longer than StaleOrderAge or drifted further than
+// StaleOrderDistance from mid, which usually means the strategy has lost track of it
+func (o WorkingOrder) Stale(now time.Time) bool {
+	if o.Age(now) > StaleOrderAge {
+		return true
+	}
+	distance, ok := o.DistanceFromMid()
+	return ok && distance > StaleOrderDistance
+}
+
+// OpenOrders is every working order of a strategy across its connectors
+type OpenOrders struct {
+	Orders []WorkingOrder `json:"orders"`
+	Errors []string       `json:"errors,omitempty"` // connectors that couldn't be queried
+	Time   time.Time      `json:"time"`
+}
+
+// OrderSource lists the working orders of the strategy in this process
+type OrderSource interface {
+	OpenOrders(ctx context.Context) *OpenOrders
+}
+
+// OrderQuerier fetches the working orders of a running instance
+type OrderQuerier interface {
+	// QueryOpenOrders returns the orders the instance has resting on its exchanges
+	QueryOpenOrders(instanceID string) (*OpenOrders, error)
+}
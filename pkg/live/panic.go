@@ -0,0 +1,75 @@
+package live
+
+import (
+	"context"
+	"time"
+)
+
+// PanicOptions configures an emergency stop of every instance
+type PanicOptions struct {
+	// Mode is passed to each strategy's own drain. Unless it is leave, it is then also applied directly
+	// on every enabled exchange, covering strategies that couldn't drain themselves.
+	Mode StopMode
+
+	// DrainTimeout bounds each strategy's drain and the exchange sweep; a strategy that hasn't
+	// stopped shortly after is killed
+	DrainTimeout time.Duration
+
+	// Actor is who pulled the panic, for the event log
+	Actor Actor
+}
+
+// PanicStop is what happened to one instance during a panic
+type PanicStop struct {
+	StrategyName string      `json:"strategy_name"`
+	InstanceID   string      `json:"instance_id,omitempty"` // empty for instances only found by their socket
+	Standby      bool        `json:"standby,omitempty"`
+	Result       *StopResult `json:"result,omitempty"` // nil when the instance didn't report its final state
+	Killed       bool        `json:"killed"`
+	Error        string      `json:"error,omitempty"`
+}
+
+// PanicExchange is the state of one exchange connector during the sweep
+type PanicExchange struct {
+	Name  string `json:"name"`
+	Error string `json:"error,omitempty"` // the connector couldn't be set up, so the exchange wasn't swept
+}
+
+// PanicReport summarises an emergency stop
+type PanicReport struct {
+	Mode      StopMode         `json:"mode"`
+	StartedAt time.Time        `json:"started_at"`
+	Duration  time.Duration    `json:"duration"`
+	Stops     []*PanicStop     `json:"stops"`
+	Exchanges []*PanicExchange `json:"exchanges,omitempty"` // swept exchanges; empty in leave mode
+	Sweep     *StopResult      `json:"sweep,omitempty"`     // what the sweep did and what is left; nil in leave mode
+	Errors    []string         `json:"errors,omitempty"`
+}
+
+// Clean reports whether every instance stopped and, if there was a sweep, nothing is left open
+func (r *PanicReport) Clean() bool {
+	if len(r.Errors) > 0 {
+		return false
+	}
+	for _, stop := range r.Stops {
+		if stop.Error != "" {
+			return false
+		}
+	}
+	for _, exchange := range r.Exchanges {
+		if exchange.Error != "" {
+			return false
+		}
+	}
+	if r.Sweep != nil {
+		return r.Sweep.Snapshot && !r.Sweep.TimedOut && len(r.Sweep.Errors) == 0
+	}
+	return true
+}
+
+// EmergencyStop halts all live trading at once
+type EmergencyStop interface {
+	// Panic stops every instance in parallel, killing those that don't respond in time, then sweeps the
+	// exchanges according to the mode. Failures are reported, not returned.
+	Panic(ctx context.Context, opts PanicOptions) *PanicReport
+}
@@ -0,0 +1,75 @@
+package live
+
+import (
+	"context"
+	"time"
+)
+
+// Portfolio adds up the PnL and positions of every running instance
+type Portfolio struct {
+	Time time.Time `json:"time"`
+
+	RealizedPnL   float64 `json:"realized_pnl"`
+	UnrealizedPnL float64 `json:"unrealized_pnl"`
+	TotalPnL      float64 `json:"total_pnl"`
+	Fees          float64 `json:"fees"`
+
+	// Strategies are ordered by total PnL, best first
+	Strategies []StrategyContribution `json:"strategies"`
+
+	// Assets and Exchanges are ordered by gross exposure, largest first
+	Assets    []AssetExposure    `json:"assets"`
+	Exchanges []ExchangeExposure `json:"exchanges"`
+
+	// Largest are the biggest positions held by any instance, by notional
+	Largest []Holding `json:"largest"`
+}
+
+// StrategyContribution is one instance's part of the portfolio
+type StrategyContribution struct {
+	InstanceID    string  `json:"instance_id"`
+	RealizedPnL   float64 `json:"realized_pnl"`
+	UnrealizedPnL float64 `json:"unrealized_pnl"`
+	TotalPnL      float64 `json:"total_pnl"`
+	Fees          float64 `json:"fees"`
+	Positions     int     `json:"positions"`
+
+	// Share is the instance's total PnL over the sum of every instance's absolute total PnL, between -1 and 1
+	Share float64 `json:"share"`
+
+	// Error is why the instance couldn't be queried; it counts for nothing then
+	Error string `json:"error,omitempty"`
+}
+
+// AssetExposure is the net position in an asset across every strategy and exchange
+type AssetExposure struct {
+	Asset    string  `json:"asset"`
+	Quantity float64 `json:"quantity"` // negative when net short
+	Notional float64 `json:"notional"` // signed, in quote currency
+	Gross    float64 `json:"gross"`    // sum of the absolute notional of each holding
+}
+
+// ExchangeExposure is the exposure held on an exchange across every strategy and asset
+type ExchangeExposure struct {
+	Exchange  string  `json:"exchange"`
+	Net       float64 `json:"net"`   // long minus short notional
+	Gross     float64 `json:"gross"` // long plus short notional
+	Positions int     `json:"positions"`
+}
+
+// Holding is one instance's net position in an asset on an exchange
+type Holding struct {
+	InstanceID string  `json:"instance_id"`
+	Exchange   string  `json:"exchange"`
+	Asset      string  `json:"asset"`
+	Quantity   float64 `json:"quantity"`
+	Price      float64 `json:"price"` // the latest fill the instance saw
+	Notional   float64 `json:"notional"`
+}
+
+// PortfolioQuerier combines every running instance into one portfolio
+type PortfolioQuerier interface {
+	// QueryPortfolio queries the PnL and positions of every instance concurrently. An instance that
+	// doesn't answer is listed with its error and left out of the totals.
+	QueryPortfolio(ctx context.Context) (*Portfolio, error)
+}
@@ -0,0 +1,53 @@
+package live
+
+import (
+	"sort"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+)
+
+// NetPosition is the quantity of an asset a strategy holds on an exchange, negative when short
+type NetPosition struct {
+	Exchange string
+	Symbol   string
+	Quantity numerical.Decimal
+}
+
+// NetPositions adds up the trades of a positions view into the net quantity held per exchange and symbol.
+// Flat positions are left out; the rest are ordered by exchange and symbol.
+func NetPositions(execution *strategy.StrategyExecution) []NetPosition {
+	if execution == nil {
+		return nil
+	}
+
+	type key struct{ exchange, symbol string }
+	net := make(map[key]numerical.Decimal)
+	for _, trade := range execution.Trades {
+		k := key{string(trade.Exchange), trade.Symbol}
+		quantity := trade.Quantity
+		if trade.Side == connector.OrderSideSell {
+			quantity = quantity.Neg()
+		}
+		if held, ok := net[k]; ok {
+			quantity = held.Add(quantity)
+		}
+		net[k] = quantity
+	}
+
+	positions := make([]NetPosition, 0, len(net))
+	for k, quantity := range net {
+		if quantity.IsZero() {
+			continue
+		}
+		positions = append(positions, NetPosition{Exchange: k.exchange, Symbol: k.symbol, Quantity: quantity})
+	}
+	sort.Slice(positions, func(i, j int) bool {
+		if positions[i].Exchange != positions[j].Exchange {
+			return positions[i].Exchange < positions[j].Exchange
+		}
+		return positions[i].Symbol < positions[j].Symbol
+	})
+	return positions
+}
@@ -0,0 +1,52 @@
+package live
+
+import (
+	"context"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/config"
+)
+
+// CheckStatus is the outcome of a single pre-flight check
+type CheckStatus string
+
+const (
+	CheckPass    CheckStatus = "pass"
+	CheckWarn    CheckStatus = "warn" // couldn't be verified, or worth a look, but doesn't block going live
+	CheckFail    CheckStatus = "fail"
+	CheckSkipped CheckStatus = "skipped" // depends on a check that failed
+)
+
+// PreflightCheck is one line of the pre-flight checklist
+type PreflightCheck struct {
+	Name   string      `json:"name"`
+	Status CheckStatus `json:"status"`
+	Detail string      `json:"detail,omitempty"`
+}
+
+// PreflightReport is the checklist for one strategy
+type PreflightReport struct {
+	StrategyName string           `json:"strategy_name"`
+	Checks       []PreflightCheck `json:"checks"`
+}
+
+// Passed reports whether no check failed
+func (r *PreflightReport) Passed() bool {
+	return len(r.Failed()) == 0
+}
+
+// Failed returns the checks that failed
+func (r *PreflightReport) Failed() []PreflightCheck {
+	var failed []PreflightCheck
+	for _, check := range r.Checks {
+		if check.Status == CheckFail {
+			failed = append(failed, check)
+		}
+	}
+	return failed
+}
+
+// Preflight checks that a strategy is ready to go live
+type Preflight interface {
+	// Run compiles the strategy if needed and runs every check; failures are reported, not returned
+	Run(ctx context.Context, strategy *config.Strategy) *PreflightReport
+}
@@ -0,0 +1,61 @@
+package live
+
+import (
+	"context"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+)
+
+// ReloadOptions configures a hot-swap of a running strategy
+type ReloadOptions struct {
+	// ReadyTimeout bounds how long the standby instance may take to load the new build and connect
+	ReadyTimeout time.Duration `yaml:"ready_timeout"`
+
+	// HealthTimeout is how long the promoted instance must stay healthy before the old build is discarded
+	HealthTimeout time.Duration `yaml:"health_timeout"`
+}
+
+// ReloadPhase names a step of the hot-swap, used to report where a reload failed
+type ReloadPhase string
+
+const (
+	ReloadPhaseCompile ReloadPhase = "compile"
+	ReloadPhaseStandby ReloadPhase = "standby"
+	ReloadPhaseHandoff ReloadPhase = "handoff"
+	ReloadPhasePromote ReloadPhase = "promote"
+	ReloadPhaseHealth  ReloadPhase = "health"
+)
+
+// Exposure is the open orders and positions a strategy sees on its connectors
+type Exposure struct {
+	OpenOrders int                  `json:"open_orders"`
+	Positions  []connector.Position `json:"positions"`
+	Complete   bool                 `json:"complete"` // false when a connector couldn't be queried
+}
+
+// ReloadResult describes how a hot-swap went
+type ReloadResult struct {
+	StrategyName string `json:"strategy_name"`
+	Recompiled   bool   `json:"recompiled"`
+	OldPID       int    `json:"old_pid"`
+	NewPID       int    `json:"new_pid"`
+
+	// Handoff is the old instance's state when it stopped trading
+	Handoff *StopResult `json:"handoff,omitempty"`
+
+	// Adopted is what the new instance found on the exchange when it took over
+	Adopted *Exposure `json:"adopted,omitempty"`
+
+	// RolledBack is set when the new build failed and the previous one was restored
+	RolledBack  bool          `json:"rolled_back"`
+	FailedPhase ReloadPhase   `json:"failed_phase,omitempty"`
+	Error       string        `json:"error,omitempty"`
+	Warnings    []string      `json:"warnings,omitempty"`
+	Duration    time.Duration `json:"duration"`
+}
+
+// Reloader replaces a running strategy with a freshly compiled build without leaving its positions unmanaged
+type Reloader interface {
+	Reload(ctx context.Context, strategyName string, opts ReloadOptions) (*ReloadResult, error)
+}
@@ -0,0 +1,35 @@
+package live
+
+// RemoteConfig configures the TCP/TLS listener `kronos serve` exposes instances on
+type RemoteConfig struct {
+	// Listen is the address served on, e.g. 0.0.0.0:7443
+	Listen string `yaml:"listen"`
+
+	// CertFile and KeyFile are the server certificate; without them a self-signed one is generated
+	// under ~/.kronos/remote and clients pin its fingerprint
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+
+	// TokenFile holds the bearer token clients must present (generated if missing, default ~/.kronos/remote/token)
+	TokenFile string `yaml:"token_file"`
+}
+
+// HostContext names a kronos node commands can be pointed at with --context or `kronos context use`
+type HostContext struct {
+	Name string `yaml:"-"`
+
+	// Host is the node's address, host:port
+	Host string `yaml:"host"`
+
+	// Token authenticates to the node; TokenFile is read instead when Token is empty
+	Token     string `yaml:"token,omitempty"`
+	TokenFile string `yaml:"token_file,omitempty"`
+
+	// Fingerprint pins the node's certificate (sha256:<hex>); CAFile verifies it against a CA instead.
+	// With neither, the system roots are used.
+	Fingerprint string `yaml:"fingerprint,omitempty"`
+	CAFile      string `yaml:"ca_file,omitempty"`
+}
+
+// LocalContext is the context name that always means this machine
+const LocalContext = "local"
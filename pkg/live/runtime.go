@@ -3,4 +3,10 @@ package live
 // Runtime is the interface for the live trading startup
 type Runtime interface {
 	Run(strategyDir string) error
+
+	// RunStandby loads the strategy without trading and waits to be promoted over the control socket
+	RunStandby(strategyDir string) error
+
+	// CheckPlugin loads the strategy plugin as Run would, then returns without trading
+	CheckPlugin(strategyDir string) error
 }
@@ -0,0 +1,48 @@
+package live
+
+import (
+	"context"
+	"time"
+)
+
+// Schedule limits a strategy to trading windows. Each rule opens a window when its start expression fires
+// and closes it when its stop expression fires; whichever start or stop fired last decides.
+type Schedule struct {
+	// Timezone the rules are evaluated in, an IANA name like Europe/London (default UTC)
+	Timezone string         `yaml:"timezone"`
+	Rules    []ScheduleRule `yaml:"rules"`
+}
+
+// ScheduleRule is a pair of five-field cron expressions, e.g. "0 9 * * mon-fri" and "0 17 * * mon-fri"
+type ScheduleRule struct {
+	Start string `yaml:"start"`
+	Stop  string `yaml:"stop"`
+}
+
+// ScheduleAction is what the scheduler does at a transition
+type ScheduleAction string
+
+const (
+	ScheduleStart ScheduleAction = "start"
+	ScheduleStop  ScheduleAction = "stop"
+)
+
+// ScheduleStatus is where a scheduled strategy stands in its schedule
+type ScheduleStatus struct {
+	StrategyName string
+	Timezone     string
+	Open         bool           // inside a trading window
+	NextAction   ScheduleAction // what happens at NextAt
+	NextAt       time.Time      // zero if the schedule never changes state again
+	Error        string         // set when the schedule can't be parsed; the strategy is left alone
+}
+
+// Scheduler starts and stops strategies on their schedules through the InstanceManager
+type Scheduler interface {
+	// Run applies schedules until ctx is done. Only one kronos process drives schedules at a time;
+	// the others stand by and take over if it exits.
+	Run(ctx context.Context) error
+
+	// Status returns every strategy that has a schedule, ordered by next transition
+	Status() ([]*ScheduleStatus, error)
+}
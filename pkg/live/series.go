@@ -0,0 +1,56 @@
+package live
+
+import (
+	"context"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+)
+
+// SeriesConfig controls the metrics every running strategy records for historical charts and analysis
+type SeriesConfig struct {
+	// Interval is how often a running strategy records a sample (0 disables recording)
+	Interval time.Duration `yaml:"interval"`
+
+	// Retention removes samples older than this (0 keeps them all)
+	Retention time.Duration `yaml:"retention"`
+
+	// Dir overrides where samples are kept (defaults to ~/.kronos/series)
+	Dir string `yaml:"dir"`
+}
+
+// MetricSample is a strategy's PnL, positions, fees and latency at one point in time.
+// PnL figures are those of the instance that recorded it, so they restart from zero with every instance.
+type MetricSample struct {
+	Time       time.Time `json:"time"`
+	InstanceID string    `json:"instance_id,omitempty"`
+
+	RealizedPnL   float64 `json:"realized_pnl"`
+	UnrealizedPnL float64 `json:"unrealized_pnl"`
+	TotalPnL      float64 `json:"total_pnl"`
+	Fees          float64 `json:"fees"`
+
+	Positions []NetPosition `json:"positions,omitempty"`
+
+	// Execution latency over the profiling window; zero before the strategy ran
+	AvgLatency time.Duration `json:"avg_latency,omitempty"`
+	P99Latency time.Duration `json:"p99_latency,omitempty"`
+}
+
+// SeriesStore keeps the samples of each strategy, across instances and restarts
+type SeriesStore interface {
+	// Append records a sample of the strategy
+	Append(strategyName string, sample *MetricSample) error
+
+	// Query returns the strategy's samples taken in [from, until), oldest first. A zero until means now.
+	Query(strategyName string, from, until time.Time) ([]*MetricSample, error)
+
+	// Prune removes samples of every strategy taken before cutoff
+	Prune(cutoff time.Time) error
+}
+
+// SeriesRecorder samples a strategy's own views into the series store while it runs
+type SeriesRecorder interface {
+	// Record samples views every configured interval until ctx is done
+	Record(ctx context.Context, strategyName, instanceID string, views monitoring.ViewRegistry)
+}
@@ -0,0 +1,95 @@
+package live
+
+import (
+	"strings"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+)
+
+// DefaultSignalLimit is how many recent signals are fetched unless asked otherwise
+const DefaultSignalLimit = 200
+
+// SignalOutcome is what became of a signal the strategy generated
+type SignalOutcome string
+
+const (
+	SignalExecuted            SignalOutcome = "executed"
+	SignalRejected            SignalOutcome = "rejected" // blocked by an execution hook, e.g. a risk check
+	SignalPaused              SignalOutcome = "paused"   // held back while signal execution was paused or the instance on standby
+	SignalInsufficientBalance SignalOutcome = "insufficient_balance"
+	SignalExchangeError       SignalOutcome = "exchange_error"
+)
+
+// SignalAction is one trade a signal asked for
+type SignalAction struct {
+	Action   strategy.Action   `json:"action"`
+	Exchange string            `json:"exchange"`
+	Asset    string            `json:"asset"`
+	Quantity numerical.Decimal `json:"quantity"`
+	Price    numerical.Decimal `json:"price"`
+}
+
+// SignalRecord is a signal the strategy generated and why it was or wasn't executed
+type SignalRecord struct {
+	ID       string         `json:"id"`
+	Time     time.Time      `json:"time"`
+	Actions  []SignalAction `json:"actions"`
+	Outcome  SignalOutcome  `json:"outcome"`
+	Reason   string         `json:"reason,omitempty"`    // the error that stopped it, empty when executed
+	OrderIDs []string       `json:"order_ids,omitempty"` // orders placed for it
+}
+
+// Executed reports whether every action of the signal went through
+func (r SignalRecord) Executed() bool {
+	return r.Outcome == SignalExecuted
+}
+
+// Assets lists the assets the signal trades, in the order of its actions
+func (r SignalRecord) Assets() []string {
+	var assets []string
+	seen := make(map[string]bool)
+	for _, action := range r.Actions {
+		if !seen[action.Asset] {
+			seen[action.Asset] = true
+			assets = append(assets, action.Asset)
+		}
+	}
+	return assets
+}
+
+// Matches reports whether the record has the outcome (any when empty) and contains search
+// in its assets, exchanges, actions, reason or order IDs, ignoring case
+func (r SignalRecord) Matches(outcome SignalOutcome, search string) bool {
+	if outcome != "" && r.Outcome != outcome {
+		return false
+	}
+	if search == "" {
+		return true
+	}
+
+	search = strings.ToLower(search)
+	fields := append([]string{r.ID, r.Reason}, r.OrderIDs...)
+	for _, action := range r.Actions {
+		fields = append(fields, action.Asset, action.Exchange, string(action.Action))
+	}
+	for _, field := range fields {
+		if strings.Contains(strings.ToLower(field), search) {
+			return true
+		}
+	}
+	return false
+}
+
+// SignalJournal keeps the latest signals of the strategy in this process
+type SignalJournal interface {
+	// Recent returns up to limit signals, oldest first
+	Recent(limit int) []SignalRecord
+}
+
+// SignalQuerier fetches the latest signals of a running instance
+type SignalQuerier interface {
+	// QuerySignals returns up to limit of the instance's most recent signals, oldest first
+	QuerySignals(instanceID string, limit int) ([]SignalRecord, error)
+}
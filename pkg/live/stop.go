@@ -0,0 +1,104 @@
+package live
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+)
+
+// StopMode decides what happens to a strategy's exchange exposure when it stops
+type StopMode string
+
+const (
+	StopModeLeave        StopMode = "leave"         // leave orders and positions as they are
+	StopModeCancelOrders StopMode = "cancel_orders" // cancel open orders, keep positions
+	StopModeFlatten      StopMode = "flatten"       // cancel open orders and close positions at market
+)
+
+// ParseStopMode accepts the mode names as well as their dashed forms (cancel-orders)
+func ParseStopMode(s string) (StopMode, error) {
+	switch StopMode(strings.ReplaceAll(strings.ToLower(strings.TrimSpace(s)), "-", "_")) {
+	case StopModeLeave, "":
+		return StopModeLeave, nil
+	case StopModeCancelOrders, "cancel":
+		return StopModeCancelOrders, nil
+	case StopModeFlatten:
+		return StopModeFlatten, nil
+	default:
+		return "", fmt.Errorf("unknown stop mode %q (want leave, cancel-orders or flatten)", s)
+	}
+}
+
+// Description returns a human readable label for the mode
+func (m StopMode) Description() string {
+	switch m {
+	case StopModeCancelOrders:
+		return "cancel open orders"
+	case StopModeFlatten:
+		return "flatten positions"
+	default:
+		return "leave as is"
+	}
+}
+
+// StopOptions configures a graceful stop
+type StopOptions struct {
+	Mode StopMode `yaml:"mode" json:"mode"`
+
+	// DrainTimeout bounds how long cancelling/flattening may take before the strategy exits anyway
+	DrainTimeout time.Duration `yaml:"drain_timeout" json:"drain_timeout"`
+
+	// Actor is who asked for the stop, for the event log; it stays in the supervisor
+	Actor Actor `yaml:"-" json:"-"`
+}
+
+// StopResult is the strategy's final state, reported back to whoever stopped it
+type StopResult struct {
+	StrategyName    string               `json:"strategy_name"`
+	Mode            StopMode             `json:"mode"`
+	CancelledOrders int                  `json:"cancelled_orders"`
+	ClosedPositions int                  `json:"closed_positions"`
+	OpenOrders      int                  `json:"open_orders"` // still open after draining
+	Positions       []connector.Position `json:"positions"`   // still open after draining
+	PnL             *monitoring.PnLView  `json:"pnl,omitempty"`
+	Errors          []string             `json:"errors,omitempty"`
+	TimedOut        bool                 `json:"timed_out"` // the drain timeout expired before confirmation
+	Forced          bool                 `json:"forced"`    // the process had to be killed
+	Snapshot        bool                 `json:"snapshot"`  // false when the strategy couldn't report its final state
+	StoppedAt       time.Time            `json:"stopped_at"`
+	Duration        time.Duration        `json:"duration"`
+}
+
+// Drainer brings a strategy's exchange exposure in line with a stop mode and snapshots what is left.
+// It runs inside the strategy process.
+type Drainer interface {
+	Drain(ctx context.Context, mode StopMode) *StopResult
+
+	// Exposure reports the open orders and non-zero positions on the strategy's connectors
+	Exposure() *Exposure
+}
+
+// InstanceController sends commands to a strategy process over its control socket
+type InstanceController interface {
+	// Stop asks the strategy to drain and exit, returning its final state; ending ctx stops waiting for it
+	Stop(ctx context.Context, strategyName string, opts StopOptions) (*StopResult, error)
+
+	// Available reports whether the strategy's control socket exists
+	Available(strategyName string) bool
+
+	// StandbyAvailable reports whether a standby instance of the strategy is loaded and waiting
+	StandbyAvailable(strategyName string) bool
+
+	// Promote tells the standby instance to start trading and returns the exposure it took over
+	Promote(strategyName string) (*Exposure, error)
+
+	// Act has the strategy carry out a trading action
+	Act(strategyName string, action TradingAction) (*ActionResult, error)
+
+	// Paused reports whether the strategy's signal execution is paused
+	Paused(strategyName string) (bool, error)
+}
@@ -0,0 +1,117 @@
+package live
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+)
+
+// StreamTopic names a view a running instance can push to subscribers
+type StreamTopic string
+
+const (
+	StreamPnL       StreamTopic = "pnl"
+	StreamPositions StreamTopic = "positions"
+	StreamOrderbook StreamTopic = "orderbook" // needs StreamRequest.Asset
+	StreamTrades    StreamTopic = "trades"
+)
+
+// DefaultStreamTradeLimit is how many recent trades a trades update carries unless the request says otherwise
+const DefaultStreamTradeLimit = 20
+
+// ErrStreamUnsupported is returned when an instance can't push updates, e.g. it was started by an older kronos.
+// Callers fall back to polling the ViewQuerier.
+var ErrStreamUnsupported = errors.New("instance does not stream updates")
+
+// StreamRequest selects the views a subscription receives
+type StreamRequest struct {
+	Topics     []StreamTopic
+	Asset      string // orderbook asset
+	Exchange   string // orderbook exchange
+	TradeLimit int    // recent trades per trades update, DefaultStreamTradeLimit if unset
+}
+
+// Values encodes the request as URL query parameters
+func (r StreamRequest) Values() url.Values {
+	values := url.Values{}
+	topics := make([]string, len(r.Topics))
+	for i, topic := range r.Topics {
+		topics[i] = string(topic)
+	}
+	values.Set("topics", strings.Join(topics, ","))
+	if r.Asset != "" {
+		values.Set("asset", r.Asset)
+	}
+	if r.Exchange != "" {
+		values.Set("exchange", r.Exchange)
+	}
+	if r.TradeLimit > 0 {
+		values.Set("limit", strconv.Itoa(r.TradeLimit))
+	}
+	return values
+}
+
+// ParseStreamRequest decodes a request encoded with Values, rejecting unknown topics
+// and an orderbook subscription without an asset
+func ParseStreamRequest(values url.Values) (StreamRequest, error) {
+	req := StreamRequest{
+		Asset:      values.Get("asset"),
+		Exchange:   values.Get("exchange"),
+		TradeLimit: DefaultStreamTradeLimit,
+	}
+
+	for _, name := range strings.Split(values.Get("topics"), ",") {
+		topic := StreamTopic(strings.TrimSpace(name))
+		switch topic {
+		case "":
+			continue
+		case StreamPnL, StreamPositions, StreamTrades:
+		case StreamOrderbook:
+			if req.Asset == "" {
+				return req, fmt.Errorf("the orderbook topic needs an asset")
+			}
+		default:
+			return req, fmt.Errorf("unknown topic %q", topic)
+		}
+		req.Topics = append(req.Topics, topic)
+	}
+	if len(req.Topics) == 0 {
+		return req, fmt.Errorf("no topics requested")
+	}
+
+	if limit := values.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n <= 0 {
+			return req, fmt.Errorf("invalid trade limit %q", limit)
+		}
+		req.TradeLimit = n
+	}
+
+	return req, nil
+}
+
+// StreamUpdate carries the latest value of one view; only the field matching Topic is set
+type StreamUpdate struct {
+	Topic     StreamTopic                 `json:"topic"`
+	Time      time.Time                   `json:"time"`
+	PnL       *monitoring.PnLView         `json:"pnl,omitempty"`
+	Positions *strategy.StrategyExecution `json:"positions,omitempty"`
+	Orderbook *connector.OrderBook        `json:"orderbook,omitempty"`
+	Trades    []connector.Trade           `json:"trades,omitempty"`
+}
+
+// ViewStreamer subscribes to views pushed by running strategy instances, as an alternative to polling the ViewQuerier
+type ViewStreamer interface {
+	// Subscribe streams the requested views of an instance, sending each one whenever it changes.
+	// The channel is closed once ctx is done or the instance goes away.
+	// Returns an error wrapping ErrStreamUnsupported if the instance can't stream.
+	Subscribe(ctx context.Context, instanceID string, req StreamRequest) (<-chan StreamUpdate, error)
+}
@@ -0,0 +1,24 @@
+package live
+
+import (
+	"context"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/monitoring"
+)
+
+// InstanceSummary is what the monitor lists for a running instance: its metrics, PnL and health.
+// Metrics and PnL are nil if the instance didn't answer for them in time.
+type InstanceSummary struct {
+	InstanceID string                      `json:"instance_id"`
+	Metrics    *monitoring.StrategyMetrics `json:"metrics,omitempty"`
+	PnL        *monitoring.PnLView         `json:"pnl,omitempty"`
+	Healthy    bool                        `json:"healthy"`
+	Error      string                      `json:"error,omitempty"` // why the health check failed
+}
+
+// SummaryQuerier fetches the summaries of many instances in one call
+type SummaryQuerier interface {
+	// QueryInstanceSummary queries every instance concurrently, each under its own timeout,
+	// so a hung instance only costs its own entry. Summaries are returned in the order of instanceIDs.
+	QueryInstanceSummary(ctx context.Context, instanceIDs []string) []*InstanceSummary
+}